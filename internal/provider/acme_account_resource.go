@@ -0,0 +1,337 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AcmeAccountResource{}
+var _ resource.ResourceWithImportState = &AcmeAccountResource{}
+
+// seconds to wait for acme account tasks, they talk to the acme directory
+const acmeTaskTimeout = 300
+
+const acmeLetsEncryptDirectory = "https://acme-v02.api.letsencrypt.org/directory"
+
+var acmeAccountNameRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_\-]*$`)
+
+func NewAcmeAccountResource() resource.Resource {
+	return &AcmeAccountResource{}
+}
+
+// AcmeAccountResource defines the resource implementation.
+type AcmeAccountResource struct {
+	cloudInventory CloudInventory
+}
+
+// AcmeAccountResourceModel describes the resource data model.
+type AcmeAccountResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Contact    types.String `tfsdk:"contact"`
+	Directory  types.String `tfsdk:"directory"`
+	AcceptTos  types.Bool   `tfsdk:"accept_tos"`
+	EabKid     types.String `tfsdk:"eab_kid"`
+	EabHmacKey types.String `tfsdk:"eab_hmac_key"`
+	Location   types.String `tfsdk:"location"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// AcmeAccount is the subset of pvesh get /cluster/acme/account/{name} we manage.
+type AcmeAccount struct {
+	Account struct {
+		Contact []string `json:"contact"`
+	} `json:"account"`
+	Directory string `json:"directory"`
+	Location  string `json:"location"`
+}
+
+func (r *AcmeAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_account"
+}
+
+func (r *AcmeAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers an acme account of the cluster (`/cluster/acme/account`), used by `pxc_node_acme_certificate` to order the certificates of the web interface. " +
+			"Destroying the resource deactivates the account at the acme directory. Import with `<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the account in proxmox.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(acmeAccountNameRe, "must start with a letter and only contain letters, digits, - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contact": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Contact email of the account, the acme directory sends expiry notices to it.",
+			},
+			"directory": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(acmeLetsEncryptDirectory),
+				MarkdownDescription: "Url of the acme directory, defaults to the let's encrypt production directory.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accept_tos": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Accepts the terms of service of the directory, has to be true for directories that have them.",
+			},
+			"eab_kid": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Key id for external account binding, required by some commercial directories.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("eab_hmac_key")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"eab_hmac_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Base64url encoded hmac key for external account binding.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("eab_kid")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Url of the account at the acme directory.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *AcmeAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// acmeTosUrl returns the terms of service url of an acme directory, empty if it has none.
+func acmeTosUrl(ctx context.Context, client pb.CloudServiceClient, targetPve string, directory string) (string, error) {
+	var tos *string
+	err := getPveApiJsonArgs(ctx, client, targetPve, "/cluster/acme/tos", map[string]string{"--directory": directory}, &tos)
+	if err != nil || tos == nil {
+		return "", err
+	}
+	return *tos, nil
+}
+
+// readAccount reads the account, nil if it doesn't exist.
+func (r *AcmeAccountResource) readAccount(ctx context.Context, client pb.CloudServiceClient, name string) (*AcmeAccount, error) {
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/acme/account", "name", name)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	var account AcmeAccount
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/acme/account/"+name, &account)
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (r *AcmeAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AcmeAccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{
+		"--name":      data.Name.ValueString(),
+		"--contact":   data.Contact.ValueString(),
+		"--directory": data.Directory.ValueString(),
+	}
+	if !data.EabKid.IsNull() {
+		createArgs["--eab-kid"] = data.EabKid.ValueString()
+		createArgs["--eab-hmac-key"] = data.EabHmacKey.ValueString()
+	}
+
+	tosUrl, err := acmeTosUrl(ctx, client, r.cloudInventory.TargetPve, data.Directory.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch the terms of service of %s, got error: %s", data.Directory.ValueString(), err))
+		return
+	}
+	if tosUrl != "" {
+		if !data.AcceptTos.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("accept_tos"), "Terms Of Service Not Accepted", fmt.Sprintf("The directory requires accepting its terms of service at %s.", tosUrl))
+			return
+		}
+		createArgs["--tos_url"] = tosUrl
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", "/cluster/acme/account", createArgs, true, acmeTaskTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error registering acme account, got error: %s", err))
+		return
+	}
+
+	account, err := r.readAccount(ctx, client, data.Name.ValueString())
+	if err != nil || account == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read registered acme account, got error: %v", err))
+		return
+	}
+	data.Location = types.StringValue(account.Location)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AcmeAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	account, err := r.readAccount(ctx, client, data.Name.ValueString())
+	if removeIfMissing(ctx, account != nil, err, "acme account", resp) {
+		return
+	}
+
+	// contacts are stored as mailto urls
+	if len(account.Account.Contact) > 0 {
+		data.Contact = types.StringValue(strings.TrimPrefix(account.Account.Contact[0], "mailto:"))
+	}
+	data.Directory = types.StringValue(account.Directory)
+	data.Location = types.StringValue(account.Location)
+	if data.AcceptTos.IsNull() {
+		data.AcceptTos = types.BoolValue(true) // imported, registered accounts accepted the terms
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AcmeAccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// only the contact can change at the directory, accept_tos only matters on registration
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "PUT", "/cluster/acme/account/"+data.Name.ValueString(), map[string]string{"--contact": data.Contact.ValueString()}, true, acmeTaskTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating acme account, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AcmeAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", "/cluster/acme/account/"+data.Name.ValueString(), nil, true, acmeTaskTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deactivating acme account, got error: %s", err))
+		return
+	}
+}
+
+func (r *AcmeAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/agessh"
+	"filippo.io/age/armor"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// env var holding the passphrase for passphrase protected identities
+const ageIdentityPassphraseEnv = "CLOUD_AGE_IDENTITY_PASSPHRASE"
+
+// loadProviderAgeIdentities collects the identities configured on the provider for
+// decrypting age secrets. Keys from ~/.ssh are tried on a best effort basis, the
+// identity files, the inline keys and the CLOUD_AGE_SSH_KEY_FILE env var have to be loadable.
+func loadProviderAgeIdentities(files []string, keys []string) ([]age.Identity, error) {
+	identities := []age.Identity{}
+
+	// try decode the secret value with keyfiles from ~/.ssh
+	home, _ := os.UserHomeDir()
+	sshDir := filepath.Join(home, ".ssh")
+
+	sshFiles, _ := os.ReadDir(sshDir)
+	for _, file := range sshFiles {
+		if strings.HasPrefix(file.Name(), "id_") && !strings.HasSuffix(file.Name(), ".pub") {
+			keyPath := filepath.Join(sshDir, file.Name())
+
+			pemBytes, err := os.ReadFile(keyPath)
+			if err != nil {
+				continue
+			}
+
+			identity, err := parseSshIdentity(keyPath, pemBytes)
+			if err == nil {
+				identities = append(identities, identity)
+			}
+		}
+	}
+
+	// additionally a env var can be passed to specific custom location (e.g. e2e usecase)
+	ageSshKey := os.Getenv("CLOUD_AGE_SSH_KEY_FILE")
+	if ageSshKey != "" {
+		files = append(files, ageSshKey)
+	}
+
+	fileIdentities, err := loadAgeIdentityFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	identities = append(identities, fileIdentities...)
+
+	for i, key := range keys {
+		keyIdentities, err := parseAgeIdentity(fmt.Sprintf("inline key %d", i), []byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("error loading inline key %d: %w", i, err)
+		}
+		identities = append(identities, keyIdentities...)
+	}
+
+	return identities, nil
+}
+
+// loadAgeIdentityFiles parses the passed identity files, all of them have to be loadable.
+func loadAgeIdentityFiles(identityPaths []string) ([]age.Identity, error) {
+	identities := []age.Identity{}
+	for _, identityPath := range identityPaths {
+		content, err := os.ReadFile(identityPath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading identity %s: %w", identityPath, err)
+		}
+
+		fileIdentities, err := parseAgeIdentity(identityPath, content)
+		if err != nil {
+			return nil, fmt.Errorf("error loading identity %s: %w", identityPath, err)
+		}
+		identities = append(identities, fileIdentities...)
+	}
+
+	return identities, nil
+}
+
+// parseAgeIdentity parses identity content which can be a native age identity
+// file (AGE-SECRET-KEY-...), a passphrase encrypted age identity file or a ssh private key.
+// The name is used to find the .pub file of older passphrase protected ssh keys.
+func parseAgeIdentity(name string, content []byte) ([]age.Identity, error) {
+	// identity file encrypted with age -p
+	if bytes.HasPrefix(content, []byte("age-encryption.org/")) {
+		passphrase := os.Getenv(ageIdentityPassphraseEnv)
+		if passphrase == "" {
+			return nil, fmt.Errorf("identity file is passphrase protected, set %s", ageIdentityPassphraseEnv)
+		}
+
+		scryptIdentity, err := age.NewScryptIdentity(passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		decrypted, err := age.Decrypt(bytes.NewReader(content), scryptIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		return age.ParseIdentities(decrypted)
+	}
+
+	if bytes.Contains(content, []byte("AGE-SECRET-KEY-")) {
+		return age.ParseIdentities(bytes.NewReader(content))
+	}
+
+	identity, err := parseSshIdentity(name, content)
+	if err != nil {
+		return nil, err
+	}
+	return []age.Identity{identity}, nil
+}
+
+// parseSshIdentity parses a ssh private key, passphrase protected keys are
+// unlocked with the passphrase from the env.
+func parseSshIdentity(keyPath string, pemBytes []byte) (age.Identity, error) {
+	identity, err := agessh.ParseIdentity(pemBytes)
+
+	var missingErr *ssh.PassphraseMissingError
+	if !errors.As(err, &missingErr) {
+		return identity, err
+	}
+
+	pubKey := missingErr.PublicKey
+	if pubKey == nil {
+		// older key formats don't embed the public key
+		pubBytes, err := os.ReadFile(keyPath + ".pub")
+		if err != nil {
+			return nil, fmt.Errorf("passphrase protected key without public key: %w", err)
+		}
+		pubKey, _, _, _, err = ssh.ParseAuthorizedKey(pubBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return agessh.NewEncryptedSSHIdentity(pubKey, pemBytes, func() ([]byte, error) {
+		passphrase := os.Getenv(ageIdentityPassphraseEnv)
+		if passphrase == "" {
+			return nil, fmt.Errorf("ssh key %s is passphrase protected, set %s", keyPath, ageIdentityPassphraseEnv)
+		}
+		return []byte(passphrase), nil
+	})
+}
+
+// stanzaRecorder is an age identity that only records the recipient stanzas of a header.
+type stanzaRecorder struct {
+	stanzas []*age.Stanza
+}
+
+func (s *stanzaRecorder) Unwrap(stanzas []*age.Stanza) ([]byte, error) {
+	s.stanzas = append(s.stanzas, stanzas...)
+	return nil, age.ErrIncorrectIdentity
+}
+
+// sshAgentDecryptHint checks if the ciphertext is encrypted to keys that are only
+// held by the ssh-agent at SSH_AUTH_SOCK. The agent protocol only supports signing,
+// it can't unwrap age file keys, so all we can do is tell the user which key to expose.
+func sshAgentDecryptHint(ciphertext []byte) string {
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	if socket == "" {
+		return "SSH_AUTH_SOCK is not set, no ssh-agent keys to check"
+	}
+
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return fmt.Sprintf("unable to connect to ssh-agent: %s", err)
+	}
+	defer conn.Close()
+
+	agentKeys, err := agent.NewClient(conn).List()
+	if err != nil {
+		return fmt.Sprintf("unable to list ssh-agent keys: %s", err)
+	}
+
+	recorder := &stanzaRecorder{}
+	_, _ = age.Decrypt(bytes.NewReader(ciphertext), recorder)
+
+	matches := []string{}
+	for _, agentKey := range agentKeys {
+		hash := sha256.Sum256(agentKey.Marshal())
+		tag := base64.RawStdEncoding.EncodeToString(hash[:4])
+		for _, stanza := range recorder.stanzas {
+			if (stanza.Type == "ssh-ed25519" || stanza.Type == "ssh-rsa") && len(stanza.Args) > 0 && stanza.Args[0] == tag {
+				matches = append(matches, agentKey.Comment)
+			}
+		}
+	}
+
+	if len(matches) == 0 {
+		return "none of the ssh-agent keys is a recipient of the secret"
+	}
+	return fmt.Sprintf("the secret is encrypted to ssh-agent keys %s, but age can't decrypt through the agent. Pass the private key via the provider age_identities or identity_paths", strings.Join(matches, ", "))
+}
+
+// parseAgeRecipients parses native age (age1...) and ssh public key recipients.
+func parseAgeRecipients(recipients []string) ([]age.Recipient, error) {
+	parsed := []age.Recipient{}
+	for _, recipient := range recipients {
+		if strings.HasPrefix(recipient, "ssh-") {
+			sshRecipient, err := agessh.ParseRecipient(recipient)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ssh recipient %s: %w", recipient, err)
+			}
+			parsed = append(parsed, sshRecipient)
+			continue
+		}
+
+		ageRecipients, err := age.ParseRecipients(strings.NewReader(recipient))
+		if err != nil {
+			return nil, fmt.Errorf("invalid age recipient %s: %w", recipient, err)
+		}
+		parsed = append(parsed, ageRecipients...)
+	}
+	return parsed, nil
+}
+
+// encryptAge encrypts plaintext to the recipients and returns the b64 encoded ciphertext.
+func encryptAge(plaintext []byte, recipients []string) (string, error) {
+	parsed, err := parseAgeRecipients(recipients)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	w, err := age.Encrypt(&out, parsed...)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(out.Bytes()), nil
+}
+
+// ageRecipientsMatch checks if the ciphertext is encrypted to exactly the declared
+// recipients. Ssh recipients are identified by their key tag, native age stanzas
+// don't identify their recipient so only their count is compared.
+func ageRecipientsMatch(ciphertext []byte, recipients []string) (bool, error) {
+	recorder := &stanzaRecorder{}
+	_, _ = age.Decrypt(bytes.NewReader(ciphertext), recorder)
+
+	sshTags := map[string]bool{}
+	nativeStanzas := 0
+	for _, stanza := range recorder.stanzas {
+		switch stanza.Type {
+		case "ssh-ed25519", "ssh-rsa":
+			if len(stanza.Args) > 0 {
+				sshTags[stanza.Args[0]] = true
+			}
+		case "X25519", "mlkem768x25519":
+			nativeStanzas++
+		}
+	}
+
+	sshRecipients := 0
+	for _, recipient := range recipients {
+		if !strings.HasPrefix(recipient, "ssh-") {
+			continue
+		}
+		sshRecipients++
+
+		pubKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(recipient))
+		if err != nil {
+			return false, fmt.Errorf("invalid ssh recipient %s: %w", recipient, err)
+		}
+		hash := sha256.Sum256(pubKey.Marshal())
+		if !sshTags[base64.RawStdEncoding.EncodeToString(hash[:4])] {
+			return false, nil
+		}
+	}
+
+	return sshRecipients == len(sshTags) && len(recipients)-sshRecipients == nativeStanzas, nil
+}
+
+// decodeAgePayload auto detects the encoding of an age payload, either ascii
+// armored (age -a) or base64 of the binary format, and returns the binary ciphertext.
+func decodeAgePayload(payload string) ([]byte, error) {
+	trimmed := strings.TrimSpace(payload)
+	if strings.HasPrefix(trimmed, armor.Header) {
+		return io.ReadAll(armor.NewReader(strings.NewReader(trimmed + "\n")))
+	}
+
+	return base64.StdEncoding.DecodeString(trimmed)
+}
@@ -0,0 +1,516 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupJobResource{}
+var _ resource.ResourceWithImportState = &BackupJobResource{}
+
+func NewBackupJobResource() resource.Resource {
+	return &BackupJobResource{}
+}
+
+// BackupJobResource defines the resource implementation.
+type BackupJobResource struct {
+	cloudInventory CloudInventory
+}
+
+// BackupJobResourceModel describes the resource data model.
+type BackupJobResourceModel struct {
+	JobId            types.String          `tfsdk:"job_id"`
+	Schedule         types.String          `tfsdk:"schedule"`
+	VmIds            []int64               `tfsdk:"vmids"`
+	Pool             types.String          `tfsdk:"pool"`
+	All              types.Bool            `tfsdk:"all"`
+	Exclude          []int64               `tfsdk:"exclude"`
+	Node             types.String          `tfsdk:"node"`
+	Storage          types.String          `tfsdk:"storage"`
+	Mode             types.String          `tfsdk:"mode"`
+	Compress         types.String          `tfsdk:"compress"`
+	Enabled          types.Bool            `tfsdk:"enabled"`
+	Comment          types.String          `tfsdk:"comment"`
+	Retention        *BackupRetentionModel `tfsdk:"retention"`
+	NotificationMode types.String          `tfsdk:"notification_mode"`
+	Mailto           []string              `tfsdk:"mailto"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// BackupRetentionModel describes the prune-backups settings of the job.
+type BackupRetentionModel struct {
+	KeepLast    types.Int64 `tfsdk:"keep_last"`
+	KeepHourly  types.Int64 `tfsdk:"keep_hourly"`
+	KeepDaily   types.Int64 `tfsdk:"keep_daily"`
+	KeepWeekly  types.Int64 `tfsdk:"keep_weekly"`
+	KeepMonthly types.Int64 `tfsdk:"keep_monthly"`
+	KeepYearly  types.Int64 `tfsdk:"keep_yearly"`
+}
+
+// BackupJob is the subset of pvesh get /cluster/backup/{id} we manage.
+type BackupJob struct {
+	Schedule         string          `json:"schedule"`
+	VmId             string          `json:"vmid"`
+	Pool             string          `json:"pool"`
+	All              pveBool         `json:"all"`
+	Exclude          string          `json:"exclude"`
+	Node             string          `json:"node"`
+	Storage          string          `json:"storage"`
+	Mode             string          `json:"mode"`
+	Compress         string          `json:"compress"`
+	Enabled          *pveBool        `json:"enabled"`
+	Comment          string          `json:"comment"`
+	PruneBackups     json.RawMessage `json:"prune-backups"`
+	NotificationMode string          `json:"notification-mode"`
+	Mailto           string          `json:"mailto"`
+}
+
+// prune-backups keys in the order of the retention attributes
+var backupRetentionKeys = []string{"keep-last", "keep-hourly", "keep-daily", "keep-weekly", "keep-monthly", "keep-yearly"}
+
+func (r *BackupJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_job"
+}
+
+func (r *BackupJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	keepAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: description,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a scheduled vzdump backup job of the cluster (`/cluster/backup`). Import with `<job_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique id of the backup job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox calendar event schedule, e.g. `*-*-* 02:00` or `sun 01:00`.",
+			},
+			"vmids": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "Guests to back up. Exactly one of vmids, pool or all has to be set.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ExactlyOneOf(path.MatchRoot("pool"), path.MatchRoot("all")),
+				},
+			},
+			"pool": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Backs up all guests of the pool, guests added later are included automatically.",
+			},
+			"all": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Backs up all guests of the cluster (or of node) except the excluded ones.",
+			},
+			"exclude": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "Guests to skip when all is set.",
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("all")),
+				},
+			},
+			"node": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only runs the job for guests on this node.",
+			},
+			"storage": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Storage the backups are written to.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("snapshot"),
+				MarkdownDescription: "Backup mode, one of snapshot, suspend or stop.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("snapshot", "suspend", "stop"),
+				},
+			},
+			"compress": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("zstd"),
+				MarkdownDescription: "Compression of the backups, one of 0 (none), gzip, lzo or zstd. Ignored by pbs storages.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("0", "gzip", "lzo", "zstd"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the job is scheduled.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the job.",
+			},
+			"retention": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Backups to keep (prune-backups), the retention of the storage applies if not set.",
+				Attributes: map[string]schema.Attribute{
+					"keep_last":    keepAttribute("Number of most recent backups to keep."),
+					"keep_hourly":  keepAttribute("Number of hourly backups to keep."),
+					"keep_daily":   keepAttribute("Number of daily backups to keep."),
+					"keep_weekly":  keepAttribute("Number of weekly backups to keep."),
+					"keep_monthly": keepAttribute("Number of monthly backups to keep."),
+					"keep_yearly":  keepAttribute("Number of yearly backups to keep."),
+				},
+			},
+			"notification_mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How job results are reported, one of auto, legacy-sendmail or notification-system. Proxmox uses auto if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "legacy-sendmail", "notification-system"),
+				},
+			},
+			"mailto": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Mail recipients of the job results, only used by the legacy-sendmail notification mode.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *BackupJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// joinVmIds joins vmids to the comma separated list pve expects.
+func joinVmIds(vmIds []int64) string {
+	parts := make([]string, 0, len(vmIds))
+	for _, vmId := range vmIds {
+		parts = append(parts, strconv.FormatInt(vmId, 10))
+	}
+	return strings.Join(parts, ",")
+}
+
+// splitVmIds parses a comma separated vmid list, nil if empty.
+func splitVmIds(vmIds string) []int64 {
+	var parsed []int64
+	for _, part := range strings.Split(vmIds, ",") {
+		if vmId, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64); err == nil {
+			parsed = append(parsed, vmId)
+		}
+	}
+	return parsed
+}
+
+// pruneSpec builds the prune-backups property string.
+func (retention *BackupRetentionModel) pruneSpec() string {
+	values := []types.Int64{retention.KeepLast, retention.KeepHourly, retention.KeepDaily, retention.KeepWeekly, retention.KeepMonthly, retention.KeepYearly}
+	parts := []string{}
+	for i, value := range values {
+		if !value.IsNull() {
+			parts = append(parts, fmt.Sprintf("%s=%d", backupRetentionKeys[i], value.ValueInt64()))
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseBackupRetention reads prune-backups, which pve returns either as object or property string.
+func parseBackupRetention(raw json.RawMessage) *BackupRetentionModel {
+	props := parsePveObject(raw)
+	if len(props) == 0 {
+		return nil
+	}
+
+	values := make([]types.Int64, len(backupRetentionKeys))
+	for i, key := range backupRetentionKeys {
+		values[i] = types.Int64Null()
+		if value, err := strconv.ParseInt(props[key], 10, 64); err == nil {
+			values[i] = types.Int64Value(value)
+		}
+	}
+	return &BackupRetentionModel{
+		KeepLast:    values[0],
+		KeepHourly:  values[1],
+		KeepDaily:   values[2],
+		KeepWeekly:  values[3],
+		KeepMonthly: values[4],
+		KeepYearly:  values[5],
+	}
+}
+
+// jobArgs returns the pvesh args of the job without the id, together with the
+// optional keys that are unset.
+func (data BackupJobResourceModel) jobArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--schedule": data.Schedule.ValueString(),
+		"--storage":  data.Storage.ValueString(),
+		"--mode":     data.Mode.ValueString(),
+		"--compress": data.Compress.ValueString(),
+		"--enabled":  boolToPve(data.Enabled.ValueBool()),
+	}
+	deletes := []string{}
+
+	optional := map[string]string{}
+	if len(data.VmIds) > 0 {
+		optional["vmid"] = joinVmIds(data.VmIds)
+	}
+	if !data.Pool.IsNull() {
+		optional["pool"] = data.Pool.ValueString()
+	}
+	if data.All.ValueBool() {
+		optional["all"] = "1"
+	}
+	if len(data.Exclude) > 0 {
+		optional["exclude"] = joinVmIds(data.Exclude)
+	}
+	if !data.Node.IsNull() {
+		optional["node"] = data.Node.ValueString()
+	}
+	if !data.Comment.IsNull() {
+		optional["comment"] = data.Comment.ValueString()
+	}
+	if data.Retention != nil && data.Retention.pruneSpec() != "" {
+		optional["prune-backups"] = data.Retention.pruneSpec()
+	}
+	if !data.NotificationMode.IsNull() {
+		optional["notification-mode"] = data.NotificationMode.ValueString()
+	}
+	if len(data.Mailto) > 0 {
+		optional["mailto"] = strings.Join(data.Mailto, ",")
+	}
+
+	for _, key := range []string{"vmid", "pool", "all", "exclude", "node", "comment", "prune-backups", "notification-mode", "mailto"} {
+		if value, ok := optional[key]; ok {
+			args["--"+key] = value
+		} else {
+			deletes = append(deletes, key)
+		}
+	}
+
+	return args, deletes
+}
+
+// readJob takes the managed values of the job over into the model.
+func (data *BackupJobResourceModel) readJob(job BackupJob) {
+	data.Schedule = types.StringValue(job.Schedule)
+	data.Storage = types.StringValue(job.Storage)
+	if job.Mode != "" {
+		data.Mode = types.StringValue(job.Mode)
+	}
+	if job.Compress != "" {
+		data.Compress = types.StringValue(job.Compress)
+	}
+	// jobs without the key are enabled
+	data.Enabled = types.BoolValue(job.Enabled == nil || bool(*job.Enabled))
+
+	vmIds := splitVmIds(job.VmId)
+	if !slices.Equal(sortedInt64s(vmIds), sortedInt64s(data.VmIds)) {
+		data.VmIds = vmIds
+	}
+	exclude := splitVmIds(job.Exclude)
+	if !slices.Equal(sortedInt64s(exclude), sortedInt64s(data.Exclude)) {
+		data.Exclude = exclude
+	}
+	data.Pool = optionalString(job.Pool)
+	if bool(job.All) || !data.All.IsNull() {
+		data.All = types.BoolValue(bool(job.All))
+	}
+	data.Node = optionalString(job.Node)
+	data.Comment = optionalString(job.Comment)
+	data.Retention = parseBackupRetention(job.PruneBackups)
+	data.NotificationMode = optionalString(job.NotificationMode)
+
+	mailto := strings.FieldsFunc(job.Mailto, func(c rune) bool { return c == ',' || c == ';' || c == ' ' })
+	if len(mailto) > 0 || data.Mailto != nil {
+		data.Mailto = mailto
+	}
+}
+
+// sortedInt64s returns a sorted copy, sets of vmids are compared order independent.
+func sortedInt64s(values []int64) []int64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted
+}
+
+func (r *BackupJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BackupJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.jobArgs()
+	createArgs["--id"] = data.JobId.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/backup", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating backup job, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BackupJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/backup", "id", data.JobId.ValueString())
+	if removeIfMissing(ctx, exists, err, "backup job", resp) {
+		return
+	}
+
+	var job BackupJob
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/backup/%s", data.JobId.ValueString()), &job)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup job, got error: %s", err))
+		return
+	}
+	data.readJob(job)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BackupJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// unset optional values have to be deleted explicitly, e.g. vmid when switching to a pool
+	setArgs, deletes := data.jobArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/backup/%s", data.JobId.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating backup job, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BackupJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/backup/%s", data.JobId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting backup job, got error: %s", err))
+		return
+	}
+}
+
+func (r *BackupJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("job_id"), req, resp)
+}
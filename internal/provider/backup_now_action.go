@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &BackupNowAction{}
+var _ action.ActionWithConfigure = &BackupNowAction{}
+
+func NewBackupNowAction() action.Action {
+	return &BackupNowAction{}
+}
+
+// BackupNowAction defines the action implementation.
+type BackupNowAction struct {
+	cloudInventory CloudInventory
+}
+
+// BackupNowActionModel describes the action data model.
+type BackupNowActionModel struct {
+	VmId     types.Int64  `tfsdk:"vmid"`
+	Storage  types.String `tfsdk:"storage"`
+	Mode     types.String `tfsdk:"mode"`
+	Compress types.String `tfsdk:"compress"`
+	Notes    types.String `tfsdk:"notes"`
+	Protect  types.Bool   `tfsdk:"protected"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *BackupNowAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_now"
+}
+
+func (a *BackupNowAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Backs up a vm or lxc container immediately with vzdump and waits for the backup task.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the guest, the node is looked up in the cluster resources.",
+			},
+			"storage": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Storage the backup is written to.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Backup mode, one of snapshot, suspend or stop. Defaults to snapshot.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("snapshot", "suspend", "stop"),
+				},
+			},
+			"compress": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Compression of the backup, one of 0 (none), gzip, lzo or zstd. Defaults to zstd, ignored by pbs storages.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("0", "gzip", "lzo", "zstd"),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Notes template of the backup, supports the vzdump variables like `{{guestname}}`.",
+			},
+			"protected": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Protects the backup from pruning and removal. Defaults to false.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Seconds to wait for the backup to finish, defaults to 3600.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *BackupNowAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *BackupNowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data BackupNowActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	guest, err := findPveGuest(ctx, client, a.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guests, got error: %s", err))
+		return
+	}
+	if guest == nil {
+		resp.Diagnostics.AddError("Guest not found", fmt.Sprintf("No vm or lxc container with vmid %d exists.", data.VmId.ValueInt64()))
+		return
+	}
+
+	args := map[string]string{
+		"--vmid":     fmt.Sprint(guest.VmId),
+		"--storage":  data.Storage.ValueString(),
+		"--mode":     "snapshot",
+		"--compress": "zstd",
+	}
+	if !data.Mode.IsNull() {
+		args["--mode"] = data.Mode.ValueString()
+	}
+	if !data.Compress.IsNull() {
+		args["--compress"] = data.Compress.ValueString()
+	}
+	if !data.Notes.IsNull() {
+		args["--notes-template"] = data.Notes.ValueString()
+	}
+	if data.Protect.ValueBool() {
+		args["--protected"] = "1"
+	}
+
+	// vzdump has to run on the node of the guest
+	output, err := pveApiCall(ctx, client, a.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/vzdump", guest.Node), args)
+	if err != nil {
+		resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to back up guest %d, got error: %s", guest.VmId, err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started backup task %s", output)})
+
+	timeout := int64(3600)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+	err = pveWaitForTask(ctx, client, a.cloudInventory.TargetPve, output, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Task Error", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Guest %d backed up to %s", guest.VmId, data.Storage.ValueString())})
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &BackupRestoreAction{}
+var _ action.ActionWithConfigure = &BackupRestoreAction{}
+
+func NewBackupRestoreAction() action.Action {
+	return &BackupRestoreAction{}
+}
+
+// BackupRestoreAction defines the action implementation.
+type BackupRestoreAction struct {
+	cloudInventory CloudInventory
+}
+
+// BackupRestoreActionModel describes the action data model.
+type BackupRestoreActionModel struct {
+	Archive   types.String `tfsdk:"archive"`
+	VmId      types.Int64  `tfsdk:"vmid"`
+	Node      types.String `tfsdk:"node"`
+	Storage   types.String `tfsdk:"storage"`
+	GuestType types.String `tfsdk:"guest_type"`
+	Unique    types.Bool   `tfsdk:"unique"`
+	Start     types.Bool   `tfsdk:"start"`
+	Timeout   types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *BackupRestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_restore"
+}
+
+func (a *BackupRestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a vzdump or pbs backup into a new guest and waits for the restore task. Existing guests are never overwritten, the vmid has to be free.",
+
+		Attributes: map[string]schema.Attribute{
+			"archive": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Volume id of the backup, e.g. `local:backup/vzdump-qemu-100-2025_01_01-02_00_00.vma.zst` or `pbs:backup/vm/100/2025-01-01T02:00:00Z`.",
+			},
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the restored guest.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+			},
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Node the guest is restored on.",
+			},
+			"storage": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Storage for the disks of the restored guest, the storages of the backup are used if not set.",
+			},
+			"guest_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "qemu or lxc, detected from the archive name if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("qemu", "lxc"),
+				},
+			},
+			"unique": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Assigns new mac addresses so the restored guest can run next to the original. Defaults to true.",
+			},
+			"start": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Starts the guest after the restore. Defaults to false.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Seconds to wait for the restore to finish, defaults to 3600.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *BackupRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+// backupGuestType detects the guest type from a vzdump archive name or pbs backup path.
+func backupGuestType(archive string) string {
+	switch {
+	case strings.Contains(archive, "vzdump-qemu-") || strings.Contains(archive, "backup/vm/"):
+		return "qemu"
+	case strings.Contains(archive, "vzdump-lxc-") || strings.Contains(archive, "backup/ct/"):
+		return "lxc"
+	}
+	return ""
+}
+
+func (a *BackupRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data BackupRestoreActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	archive := data.Archive.ValueString()
+	guestType := data.GuestType.ValueString()
+	if guestType == "" {
+		guestType = backupGuestType(archive)
+	}
+	if guestType == "" {
+		resp.Diagnostics.AddError("Unknown Guest Type", fmt.Sprintf("Unable to detect the guest type of %s, set guest_type.", archive))
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	args := map[string]string{
+		"--vmid": fmt.Sprint(data.VmId.ValueInt64()),
+	}
+	// containers are restored by creating them from the archive
+	if guestType == "qemu" {
+		args["--archive"] = archive
+	} else {
+		args["--ostemplate"] = archive
+		args["--restore"] = "1"
+	}
+	if !data.Storage.IsNull() {
+		args["--storage"] = data.Storage.ValueString()
+	}
+	if data.Unique.IsNull() || data.Unique.ValueBool() {
+		args["--unique"] = "1"
+	}
+	if data.Start.ValueBool() {
+		args["--start"] = "1"
+	}
+
+	output, err := pveApiCall(ctx, client, a.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/%s", data.Node.ValueString(), guestType), args)
+	if err != nil {
+		resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to restore %s, got error: %s", archive, err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started restore task %s", output)})
+
+	timeout := int64(3600)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+	err = pveWaitForTask(ctx, client, a.cloudInventory.TargetPve, output, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Task Error", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Restored %s as guest %d", archive, data.VmId.ValueInt64())})
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// seconds to wait for ceph tasks like pool creation
+const cephTaskTimeout = 600
+
+// cephNode returns the node the ceph api is called on. Pools and filesystems are cluster wide,
+// pvesh resolves localhost to the node the backend is connected to, which has to run ceph.
+func cephNode(node types.String) string {
+	if node.IsNull() || node.IsUnknown() {
+		return "localhost"
+	}
+	return node.ValueString()
+}
+
+// cephCommand runs the ceph cli with args and decodes its json output into v unless it is nil.
+func cephCommand(ctx context.Context, client pb.CloudServiceClient, targetPve string, v any, args ...string) error {
+	return cephToolCommand(ctx, client, targetPve, pb.CephCommandRequest_CEPH, v, args)
+}
+
+// rbdCommand runs the rbd cli like cephCommand.
+func rbdCommand(ctx context.Context, client pb.CloudServiceClient, targetPve string, v any, args ...string) error {
+	return cephToolCommand(ctx, client, targetPve, pb.CephCommandRequest_RBD, v, args)
+}
+
+func cephToolCommand(ctx context.Context, client pb.CloudServiceClient, targetPve string, tool pb.CephCommandRequest_Tool, v any, args []string) error {
+	cresp, err := client.CephCommand(ctx, &pb.CephCommandRequest{TargetPve: targetPve, Args: args, Tool: tool})
+	if err != nil {
+		return err
+	}
+
+	if v == nil || strings.TrimSpace(cresp.JsonResp) == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(cresp.JsonResp), v)
+}
+
+// cephOsdMap holds the osd counters of the ceph status, older ceph
+// releases nest them in an additional osdmap object.
+type cephOsdMap struct {
+	NumOsds   int64       `json:"num_osds"`
+	NumUpOsds int64       `json:"num_up_osds"`
+	NumInOsds int64       `json:"num_in_osds"`
+	OsdMap    *cephOsdMap `json:"osdmap"`
+}
+
+// CephStatus is the subset of pvesh get /cluster/ceph/status we expose.
+type CephStatus struct {
+	Health struct {
+		Status string `json:"status"`
+		Checks map[string]struct {
+			Severity string `json:"severity"`
+			Summary  struct {
+				Message string `json:"message"`
+			} `json:"summary"`
+		} `json:"checks"`
+	} `json:"health"`
+	PgMap struct {
+		NumPgs     int64 `json:"num_pgs"`
+		BytesTotal int64 `json:"bytes_total"`
+		BytesUsed  int64 `json:"bytes_used"`
+		BytesAvail int64 `json:"bytes_avail"`
+		PgsByState []struct {
+			StateName string `json:"state_name"`
+			Count     int64  `json:"count"`
+		} `json:"pgs_by_state"`
+	} `json:"pgmap"`
+	OsdMap cephOsdMap `json:"osdmap"`
+}
+
+// osds returns the osd counters independent of the ceph release.
+func (status CephStatus) osds() cephOsdMap {
+	if status.OsdMap.OsdMap != nil {
+		return *status.OsdMap.OsdMap
+	}
+	return status.OsdMap
+}
+
+// uncleanPgs returns the number of placement groups that are not active+clean, e.g. while backfilling.
+func (status CephStatus) uncleanPgs() int64 {
+	var unclean int64
+	for _, state := range status.PgMap.PgsByState {
+		if state.StateName != "active+clean" {
+			unclean += state.Count
+		}
+	}
+	return unclean
+}
+
+// interval between polls of the ceph status while waiting for the placement groups
+const cephPollInterval = 10 * time.Second
+
+// waitForCephClean polls the ceph status until all placement groups are active+clean or wait passes.
+func waitForCephClean(ctx context.Context, client pb.CloudServiceClient, targetPve string, wait time.Duration) error {
+	deadline := time.Now().Add(wait)
+
+	for {
+		var status CephStatus
+		err := getPveApiJson(ctx, client, targetPve, "/cluster/ceph/status", &status)
+		if err != nil {
+			return err
+		}
+
+		unclean := status.uncleanPgs()
+		if unclean == 0 {
+			return nil
+		}
+		tflog.Debug(ctx, "waiting for ceph placement groups", map[string]any{"unclean": unclean})
+
+		if time.Now().Add(cephPollInterval).After(deadline) {
+			return fmt.Errorf("%d placement groups are still not active+clean after %s", unclean, wait)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cephPollInterval):
+		}
+	}
+}
+
+// cephFsQuota converts the bytes_quota of a cephfs subvolume (group) info, a number or "infinite".
+func cephFsQuota(info map[string]interface{}) types.Int64 {
+	if quota, ok := info["bytes_quota"].(float64); ok {
+		return types.Int64Value(int64(quota))
+	}
+	return types.Int64Null()
+}
+
+// cephFsMode converts the mode of a cephfs subvolume (group) info to its octal permission bits.
+func cephFsMode(info map[string]interface{}) types.String {
+	mode, ok := info["mode"].(float64)
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(fmt.Sprintf("%o", int64(mode)&0o777))
+}
+
+// cephFsModeRe matches the octal permissions of cephfs subvolumes and groups.
+var cephFsModeRe = regexp.MustCompile(`^[0-7]{3}$`)
+
+// CephClientRbdModel grants a ceph client access to the rbd images of a pool (namespace).
+type CephClientRbdModel struct {
+	Pool      types.String `tfsdk:"pool"`
+	Namespace types.String `tfsdk:"namespace"`
+}
+
+// CephClientFsModel grants a ceph client access to a directory of a cephfs filesystem.
+type CephClientFsModel struct {
+	Fs       types.String `tfsdk:"fs"`
+	Path     types.String `tfsdk:"path"`
+	ReadOnly types.Bool   `tfsdk:"read_only"`
+}
+
+// cephAuthEntry is an entry of the json output of ceph auth get and get-or-create.
+type cephAuthEntry struct {
+	Entity string            `json:"entity"`
+	Key    string            `json:"key"`
+	Caps   map[string]string `json:"caps"`
+}
+
+// cephClientCaps builds the caps of a client restricted to the given rbd pools and cephfs directories,
+// the same caps the rbd profiles and ceph fs authorize would grant.
+func cephClientCaps(rbd []CephClientRbdModel, fs []CephClientFsModel) map[string]string {
+	caps := map[string][]string{}
+
+	if len(rbd) > 0 {
+		caps["mon"] = append(caps["mon"], "profile rbd")
+	}
+	for _, entry := range rbd {
+		pool := "profile rbd pool=" + entry.Pool.ValueString()
+		if entry.Namespace.ValueString() != "" {
+			pool += " namespace=" + entry.Namespace.ValueString()
+		}
+		caps["osd"] = append(caps["osd"], pool)
+		caps["mgr"] = append(caps["mgr"], pool)
+	}
+
+	for _, entry := range fs {
+		perm := "rw"
+		if entry.ReadOnly.ValueBool() {
+			perm = "r"
+		}
+		fsPath := "/"
+		if entry.Path.ValueString() != "" {
+			fsPath = entry.Path.ValueString()
+		}
+		caps["mon"] = append(caps["mon"], "allow r fs_name="+entry.Fs.ValueString())
+		caps["mds"] = append(caps["mds"], fmt.Sprintf("allow %s fs_name=%s path=%s", perm, entry.Fs.ValueString(), fsPath))
+		caps["osd"] = append(caps["osd"], fmt.Sprintf("allow %s tag cephfs data=%s", perm, entry.Fs.ValueString()))
+	}
+
+	joined := map[string]string{}
+	for capType, grants := range caps {
+		joined[capType] = strings.Join(grants, ", ")
+	}
+	return joined
+}
+
+// cephCapArgs returns the caps as arguments for ceph auth get-or-create and ceph auth caps.
+func cephCapArgs(caps map[string]string) []string {
+	args := []string{}
+	for _, capType := range slices.Sorted(maps.Keys(caps)) {
+		args = append(args, capType, caps[capType])
+	}
+	return args
+}
+
+// cephKeyring renders the keyring file of an auth entry, as ceph auth get would print it.
+func cephKeyring(entry cephAuthEntry) string {
+	var keyring strings.Builder
+	fmt.Fprintf(&keyring, "[%s]\n\tkey = %s\n", entry.Entity, entry.Key)
+	for _, capType := range slices.Sorted(maps.Keys(entry.Caps)) {
+		fmt.Fprintf(&keyring, "\tcaps %s = \"%s\"\n", capType, entry.Caps[capType])
+	}
+	return keyring.String()
+}
+
+// cephAuthCommand runs a ceph auth subcommand that prints the auth entry of a single client.
+func cephAuthCommand(ctx context.Context, client pb.CloudServiceClient, targetPve string, args ...string) (cephAuthEntry, error) {
+	var entries []cephAuthEntry
+	err := cephCommand(ctx, client, targetPve, &entries, append([]string{"auth"}, args...)...)
+	if err != nil {
+		return cephAuthEntry{}, err
+	}
+	if len(entries) != 1 {
+		return cephAuthEntry{}, fmt.Errorf("expected a single auth entry, got %d", len(entries))
+	}
+	return entries[0], nil
+}
@@ -34,15 +34,18 @@ func (d *CephAccessDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 func (d *CephAccessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches ceph conf and the admin keyring of the associated target_pve from the kubespray inventory" +
-			"file passed to the provider during init.",
+		MarkdownDescription: "Fetches ceph conf and the admin keyring of the associated target_pve from the kubespray inventory " +
+			"file passed to the provider during init. The admin keyring grants full cluster access, use pxc_ceph_client for scoped credentials " +
+			"and the ephemeral pxc_ceph_access to keep it out of the state.",
 		Attributes: map[string]schema.Attribute{
 			"ceph_conf": schema.StringAttribute{
 				Computed:            true,
+				Sensitive:           true,
 				MarkdownDescription: "ceph.conf file from /etc/ceph/",
 			},
 			"admin_keyring": schema.StringAttribute{
 				Computed:            true,
+				Sensitive:           true,
 				MarkdownDescription: "ceph.client.admin.keyring file from /etc/pve/priv/",
 			},
 		},
@@ -77,7 +80,7 @@ func (d *CephAccessDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &CephAccessEphemeralResource{}
+
+func NewCephAccessEphemeralResource() ephemeral.EphemeralResource {
+	return &CephAccessEphemeralResource{}
+}
+
+// CephAccessEphemeralResource defines the ephemeral resource implementation.
+type CephAccessEphemeralResource struct {
+	cloudInventory CloudInventory
+}
+
+// CephAccessEphemeralResourceModel describes the ephemeral resource data model.
+type CephAccessEphemeralResourceModel struct {
+	CephConf     types.String `tfsdk:"ceph_conf"`
+	AdminKeyring types.String `tfsdk:"admin_keyring"`
+}
+
+func (r *CephAccessEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_access"
+}
+
+func (r *CephAccessEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get ceph conf and the admin keyring of the associated target_pve without persisting them in the state, like the pxc_ceph_access data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"ceph_conf": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "ceph.conf file from /etc/ceph/",
+			},
+			"admin_keyring": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "ceph.client.admin.keyring file from /etc/pve/priv/",
+			},
+		},
+	}
+}
+
+func (r *CephAccessEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *CephAccessEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data CephAccessEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// perform the request
+	cresp, err := client.GetCephAccess(ctx, &pb.GetCephAccessRequest{TargetPve: r.cloudInventory.TargetPve})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable get ceph access files, got error: %s", err))
+		return
+	}
+
+	data.CephConf = types.StringValue(cresp.CephConf)
+	data.AdminKeyring = types.StringValue(cresp.AdminKeyring)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
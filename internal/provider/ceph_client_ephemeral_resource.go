@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &CephClientEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &CephClientEphemeralResource{}
+
+func NewCephClientEphemeralResource() ephemeral.EphemeralResource {
+	return &CephClientEphemeralResource{}
+}
+
+// CephClientEphemeralResource defines the ephemeral resource implementation.
+type CephClientEphemeralResource struct {
+	cloudInventory CloudInventory
+}
+
+// CephClientEphemeralResourceModel describes the ephemeral resource data model.
+type CephClientEphemeralResourceModel struct {
+	NamePrefix types.String         `tfsdk:"name_prefix"`
+	Rbd        []CephClientRbdModel `tfsdk:"rbd"`
+	Cephfs     []CephClientFsModel  `tfsdk:"cephfs"`
+	Name       types.String         `tfsdk:"name"`
+	Key        types.String         `tfsdk:"key"`
+	Keyring    types.String         `tfsdk:"keyring"`
+}
+
+func (r *CephClientEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_client"
+}
+
+func (r *CephClientEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short lived ceph auth client with the same scoped caps as the pxc_ceph_client resource, " +
+			"for provider configurations and provisioners that need ceph access only during a run. The client is removed again when terraform closes the ephemeral resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Prefix of the client name, a random suffix is appended so concurrent runs don't collide.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cephClientNameRe, "must start with a letter or digit and only contain letters, digits, ., - and _"),
+				},
+			},
+			"rbd": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Pools the client can create, map and delete rbd images in.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pool": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the pool.",
+						},
+						"namespace": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Restricts the access to a rados namespace of the pool.",
+						},
+					},
+				},
+			},
+			"cephfs": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Cephfs directories the client can mount.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fs": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the filesystem.",
+						},
+						"path": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Directory the access is restricted to, the whole filesystem if not set.",
+						},
+						"read_only": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Only grants read access.",
+						},
+					},
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Generated name of the client without the `client.` prefix.",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret key of the client.",
+			},
+			"keyring": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Keyring file of the client.",
+			},
+		},
+	}
+}
+
+func (r *CephClientEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *CephClientEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data CephClientEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate client name, got error: %s", err))
+		return
+	}
+	data.Name = types.StringValue(data.NamePrefix.ValueString() + "-" + hex.EncodeToString(suffix))
+	entity := "client." + data.Name.ValueString()
+
+	args := append([]string{"get-or-create", entity}, cephCapArgs(cephClientCaps(data.Rbd, data.Cephfs))...)
+	entry, err := cephAuthCommand(ctx, client, r.cloudInventory.TargetPve, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ceph client, got error: %s", err))
+		return
+	}
+
+	data.Key = types.StringValue(entry.Key)
+	data.Keyring = types.StringValue(cephKeyring(entry))
+
+	// remember the client for close, the result data is not passed along
+	privateEntity, err := json.Marshal(entity)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to store ceph client name, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "entity", privateEntity)...)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *CephClientEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateEntity, diags := req.Private.GetKey(ctx, "entity")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateEntity == nil {
+		return
+	}
+
+	var entity string
+	if err := json.Unmarshal(privateEntity, &entity); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to load ceph client name, got error: %s", err))
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, "auth", "rm", entity)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove ceph client %s, got error: %s", entity, err))
+		return
+	}
+}
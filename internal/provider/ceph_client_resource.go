@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CephClientResource{}
+var _ resource.ResourceWithImportState = &CephClientResource{}
+
+// names of ceph auth clients without the client. prefix
+var cephClientNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-]*$`)
+
+func NewCephClientResource() resource.Resource {
+	return &CephClientResource{}
+}
+
+// CephClientResource defines the resource implementation.
+type CephClientResource struct {
+	cloudInventory CloudInventory
+}
+
+// CephClientResourceModel describes the resource data model.
+type CephClientResourceModel struct {
+	Name    types.String         `tfsdk:"name"`
+	Rbd     []CephClientRbdModel `tfsdk:"rbd"`
+	Cephfs  []CephClientFsModel  `tfsdk:"cephfs"`
+	Key     types.String         `tfsdk:"key"`
+	Keyring types.String         `tfsdk:"keyring"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// entity returns the ceph auth entity of the client.
+func (data CephClientResourceModel) entity() string {
+	return "client." + data.Name.ValueString()
+}
+
+func (r *CephClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_client"
+}
+
+func (r *CephClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ceph auth client whose caps are limited to the listed rbd pools and cephfs directories, " +
+			"for example for the ceph csi drivers of a kubernetes stack instead of the admin keyring of pxc_ceph_access. " +
+			"Caps changed outside of terraform are reset on the next apply. Import with `<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the client without the `client.` prefix.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cephClientNameRe, "must start with a letter or digit and only contain letters, digits, ., - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rbd": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Pools the client can create, map and delete rbd images in.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pool": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the pool.",
+						},
+						"namespace": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Restricts the access to a rados namespace of the pool.",
+						},
+					},
+				},
+			},
+			"cephfs": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Cephfs directories the client can mount. This covers mounting existing subvolumes, " +
+					"dynamic provisioning by the csi driver additionally needs `mgr` caps that are not granted here.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fs": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the filesystem.",
+						},
+						"path": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Directory the access is restricted to, e.g. the path of a pxc_cephfs_subvolume(_group). The whole filesystem if not set.",
+						},
+						"read_only": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Only grants read access.",
+						},
+					},
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret key of the client, as expected by the `userKey` of ceph csi secrets.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keyring": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Keyring file of the client, for `/etc/ceph/ceph.client.<name>.keyring`.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *CephClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *CephClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// fails if the client already exists with different caps
+	args := append([]string{"get-or-create", data.entity()}, cephCapArgs(cephClientCaps(data.Rbd, data.Cephfs))...)
+	entry, err := cephAuthCommand(ctx, client, r.cloudInventory.TargetPve, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating ceph client, got error: %s", err))
+		return
+	}
+
+	data.Key = types.StringValue(entry.Key)
+	data.Keyring = types.StringValue(cephKeyring(entry))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	entry, err := cephAuthCommand(ctx, client, r.cloudInventory.TargetPve, "get", data.entity())
+	if removeIfMissing(ctx, err == nil, err, "ceph client", resp) {
+		return
+	}
+
+	// caps can't be mapped back to pools and directories, clearing them lets the next apply rewrite them
+	if !maps.Equal(entry.Caps, cephClientCaps(data.Rbd, data.Cephfs)) {
+		data.Rbd = nil
+		data.Cephfs = nil
+	}
+
+	data.Key = types.StringValue(entry.Key)
+	data.Keyring = types.StringValue(cephKeyring(entry))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// replaces all caps of the client, the key stays the same
+	args := append([]string{"auth", "caps", data.entity()}, cephCapArgs(cephClientCaps(data.Rbd, data.Cephfs))...)
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating ceph client caps, got error: %s", err))
+		return
+	}
+
+	entry, err := cephAuthCommand(ctx, client, r.cloudInventory.TargetPve, "get", data.entity())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ceph client, got error: %s", err))
+		return
+	}
+	data.Key = types.StringValue(entry.Key)
+	data.Keyring = types.StringValue(cephKeyring(entry))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, "auth", "rm", data.entity())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting ceph client, got error: %s", err))
+		return
+	}
+}
+
+func (r *CephClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CephDfDataSource{}
+
+func NewCephDfDataSource() datasource.DataSource {
+	return &CephDfDataSource{}
+}
+
+// CephDfDataSource defines the data source implementation.
+type CephDfDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// CephDfDataSourceModel describes the data source data model.
+type CephDfDataSourceModel struct {
+	TotalBytes     types.Int64       `tfsdk:"total_bytes"`
+	TotalAvail     types.Int64       `tfsdk:"total_avail_bytes"`
+	TotalUsedRaw   types.Int64       `tfsdk:"total_used_raw_bytes"`
+	TotalUsedRatio types.Float64     `tfsdk:"total_used_raw_ratio"`
+	Pools          []CephDfPoolModel `tfsdk:"pools"`
+}
+
+// CephDfPoolModel describes the usage of a single pool.
+type CephDfPoolModel struct {
+	Name      types.String  `tfsdk:"name"`
+	Stored    types.Int64   `tfsdk:"stored"`
+	Objects   types.Int64   `tfsdk:"objects"`
+	BytesUsed types.Int64   `tfsdk:"bytes_used"`
+	UsedRatio types.Float64 `tfsdk:"used_ratio"`
+	MaxAvail  types.Int64   `tfsdk:"max_avail"`
+}
+
+// cephDf is the subset of ceph df we expose.
+type cephDf struct {
+	Stats struct {
+		TotalBytes        int64   `json:"total_bytes"`
+		TotalAvailBytes   int64   `json:"total_avail_bytes"`
+		TotalUsedRawBytes int64   `json:"total_used_raw_bytes"`
+		TotalUsedRawRatio float64 `json:"total_used_raw_ratio"`
+	} `json:"stats"`
+	Pools []struct {
+		Name  string `json:"name"`
+		Stats struct {
+			Stored      int64   `json:"stored"`
+			Objects     int64   `json:"objects"`
+			BytesUsed   int64   `json:"bytes_used"`
+			PercentUsed float64 `json:"percent_used"`
+			MaxAvail    int64   `json:"max_avail"`
+		} `json:"stats"`
+	} `json:"pools"`
+}
+
+func (d *CephDfDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_df"
+}
+
+func (d *CephDfDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the raw and per pool usage of the target_pve ceph cluster like `ceph df`, " +
+			"e.g. to check in preconditions that a pool has room for new images.",
+
+		Attributes: map[string]schema.Attribute{
+			"total_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Raw capacity of all osds in bytes.",
+			},
+			"total_avail_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Available raw capacity in bytes.",
+			},
+			"total_used_raw_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Used raw capacity in bytes, including replication and internal metadata.",
+			},
+			"total_used_raw_ratio": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Fraction of the raw capacity that is used, between 0 and 1.",
+			},
+			"pools": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Usage of the pools.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the pool.",
+						},
+						"stored": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Bytes stored by clients, without replication.",
+						},
+						"objects": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of objects.",
+						},
+						"bytes_used": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Raw bytes used, including replication.",
+						},
+						"used_ratio": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Fraction of the pool capacity that is used, between 0 and 1.",
+						},
+						"max_avail": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Bytes clients can still store before the first osd gets full.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephDfDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *CephDfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephDfDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var df cephDf
+	err = cephCommand(ctx, client, d.cloudInventory.TargetPve, &df, "df")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ceph usage, got error: %s", err))
+		return
+	}
+
+	data.TotalBytes = types.Int64Value(df.Stats.TotalBytes)
+	data.TotalAvail = types.Int64Value(df.Stats.TotalAvailBytes)
+	data.TotalUsedRaw = types.Int64Value(df.Stats.TotalUsedRawBytes)
+	data.TotalUsedRatio = types.Float64Value(df.Stats.TotalUsedRawRatio)
+
+	data.Pools = []CephDfPoolModel{}
+	for _, pool := range df.Pools {
+		data.Pools = append(data.Pools, CephDfPoolModel{
+			Name:      types.StringValue(pool.Name),
+			Stored:    types.Int64Value(pool.Stats.Stored),
+			Objects:   types.Int64Value(pool.Stats.Objects),
+			BytesUsed: types.Int64Value(pool.Stats.BytesUsed),
+			UsedRatio: types.Float64Value(pool.Stats.PercentUsed),
+			MaxAvail:  types.Int64Value(pool.Stats.MaxAvail),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &CephFlagAction{}
+var _ action.ActionWithConfigure = &CephFlagAction{}
+
+func NewCephSetFlagAction() action.Action {
+	return &CephFlagAction{command: "set"}
+}
+
+func NewCephUnsetFlagAction() action.Action {
+	return &CephFlagAction{command: "unset"}
+}
+
+// CephFlagAction defines the action implementation, one action to set and one to unset flags.
+type CephFlagAction struct {
+	cloudInventory CloudInventory
+	command        string
+}
+
+// CephFlagActionModel describes the action data model.
+type CephFlagActionModel struct {
+	Flags []string `tfsdk:"flags"`
+}
+
+func (a *CephFlagAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_" + a.command + "_flag"
+}
+
+func (a *CephFlagAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	descriptions := map[string]string{
+		"set": "Sets cluster wide ceph osd flags, e.g. `noout` before rebooting nodes in a maintenance window so their osds aren't rebalanced away. " +
+			"Flags that are already set are kept.",
+		"unset": "Unsets cluster wide ceph osd flags again after a maintenance window. Flags that aren't set are skipped.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: descriptions[a.command],
+
+		Attributes: map[string]schema.Attribute{
+			"flags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Flags to " + a.command + ".",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						"noout", "noin", "nodown", "noup", "nobackfill", "norebalance", "norecover", "noscrub", "nodeep-scrub", "pause",
+					)),
+				},
+			},
+		},
+	}
+}
+
+func (a *CephFlagAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *CephFlagAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CephFlagActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	value := boolToPve(a.command == "set")
+	for _, flag := range sortedStrings(data.Flags) {
+		_, err = pveApiCall(ctx, client, a.cloudInventory.TargetPve, "PUT", "/cluster/ceph/flags/"+flag, map[string]string{"--value": value})
+		if err != nil {
+			resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to %s ceph flag %s, got error: %s", a.command, flag, err))
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Ceph flag %s %s", flag, a.command)})
+	}
+}
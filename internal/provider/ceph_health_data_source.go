@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CephHealthDataSource{}
+
+func NewCephHealthDataSource() datasource.DataSource {
+	return &CephHealthDataSource{}
+}
+
+// CephHealthDataSource defines the data source implementation.
+type CephHealthDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// CephHealthDataSourceModel describes the data source data model.
+type CephHealthDataSourceModel struct {
+	Health types.String `tfsdk:"health"`
+	Pgs    types.Int64  `tfsdk:"pgs"`
+	Osds   types.Int64  `tfsdk:"osds"`
+	OsdsUp types.Int64  `tfsdk:"osds_up"`
+	OsdsIn types.Int64  `tfsdk:"osds_in"`
+}
+
+func (d *CephHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_health"
+}
+
+func (d *CephHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the ceph health of the target_pve cluster. Use it in preconditions to gate operations on a healthy ceph.",
+		DeprecationMessage:  "Use pxc_ceph_status, which additionally reports the health checks and the capacity of the cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"health": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Overall ceph health, one of HEALTH_OK, HEALTH_WARN or HEALTH_ERR.",
+			},
+			"pgs": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total number of placement groups.",
+			},
+			"osds": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total number of osds.",
+			},
+			"osds_up": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of osds that are up.",
+			},
+			"osds_in": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of osds that are in.",
+			},
+		},
+	}
+}
+
+func (d *CephHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *CephHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephHealthDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// perform the request
+	cresp, err := client.GetProxmoxApi(ctx, &pb.GetProxmoxApiRequest{TargetPve: d.cloudInventory.TargetPve, ApiPath: "/cluster/ceph/status"})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make get api request, got error: %s", err))
+		return
+	}
+
+	var status CephStatus
+	err = json.Unmarshal([]byte(cresp.JsonResp), &status)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unmarschal ceph status, got error: %s", err))
+		return
+	}
+
+	osdMap := status.osds()
+
+	data.Health = types.StringValue(status.Health.Status)
+	data.Pgs = types.Int64Value(status.PgMap.NumPgs)
+	data.Osds = types.Int64Value(osdMap.NumOsds)
+	data.OsdsUp = types.Int64Value(osdMap.NumUpOsds)
+	data.OsdsIn = types.Int64Value(osdMap.NumInOsds)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
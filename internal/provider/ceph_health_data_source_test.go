@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCephHealthDataSourceRead(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   CephHealthDataSourceModel
+	}{
+		{
+			name:   "ok",
+			status: `{"health":{"status":"HEALTH_OK","checks":{}},"pgmap":{"num_pgs":129},"osdmap":{"num_osds":3,"num_up_osds":3,"num_in_osds":3}}`,
+			want:   CephHealthDataSourceModel{Health: types.StringValue("HEALTH_OK"), Pgs: types.Int64Value(129), Osds: types.Int64Value(3), OsdsUp: types.Int64Value(3), OsdsIn: types.Int64Value(3)},
+		},
+		{
+			name: "warn",
+			status: `{"health":{"status":"HEALTH_WARN","checks":{"OSD_DOWN":{"severity":"HEALTH_WARN","summary":{"message":"1 osds down"}}}},` +
+				`"pgmap":{"num_pgs":129},"osdmap":{"num_osds":3,"num_up_osds":2,"num_in_osds":3}}`,
+			want: CephHealthDataSourceModel{Health: types.StringValue("HEALTH_WARN"), Pgs: types.Int64Value(129), Osds: types.Int64Value(3), OsdsUp: types.Int64Value(2), OsdsIn: types.Int64Value(3)},
+		},
+		{
+			// ceph releases before reef nest the counters
+			name:   "nested osdmap",
+			status: `{"health":{"status":"HEALTH_WARN"},"pgmap":{"num_pgs":64},"osdmap":{"osdmap":{"num_osds":4,"num_up_osds":4,"num_in_osds":3}}}`,
+			want:   CephHealthDataSourceModel{Health: types.StringValue("HEALTH_WARN"), Pgs: types.Int64Value(64), Osds: types.Int64Value(4), OsdsUp: types.Int64Value(4), OsdsIn: types.Int64Value(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+				"GetProxmoxApi": func(req proto.Message) (proto.Message, error) {
+					if path := req.(*pb.GetProxmoxApiRequest).ApiPath; path != "/cluster/ceph/status" {
+						t.Errorf("unexpected api path %s", path)
+					}
+					return &pb.GetProxmoxApiResponse{JsonResp: tt.status}, nil
+				},
+			}}
+
+			state := readDataSource(t, &CephHealthDataSource{cloudInventory: testInventory(conn)}, nil)
+
+			var data CephHealthDataSourceModel
+			if diags := state.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("State.Get() diagnostics: %v", diags)
+			}
+			if data != tt.want {
+				t.Errorf("Read() = %+v, want %+v", data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &CephOsdAction{}
+var _ action.ActionWithConfigure = &CephOsdAction{}
+
+func NewCephOsdOutAction() action.Action {
+	return &CephOsdAction{command: "out"}
+}
+
+func NewCephOsdInAction() action.Action {
+	return &CephOsdAction{command: "in"}
+}
+
+// CephOsdAction defines the action implementation, one action to mark osds out and one to mark them in.
+type CephOsdAction struct {
+	cloudInventory CloudInventory
+	command        string
+}
+
+// CephOsdActionModel describes the action data model.
+type CephOsdActionModel struct {
+	OsdIds       []int64      `tfsdk:"osd_ids"`
+	Node         types.String `tfsdk:"node"`
+	WaitForClean types.Bool   `tfsdk:"wait_for_clean"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *CephOsdAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_osd_" + a.command
+}
+
+func (a *CephOsdAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	descriptions := map[string]string{
+		"out": "Marks ceph osds out, so their data is moved to the remaining osds, e.g. before replacing a disk or removing a node.",
+		"in":  "Marks ceph osds in again, so data is moved back onto them.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: descriptions[a.command],
+
+		Attributes: map[string]schema.Attribute{
+			"osd_ids": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				Required:            true,
+				MarkdownDescription: "Ids of the osds.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(0)),
+				},
+			},
+			"node": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ceph node the api calls are made on, the node the backend is connected to if not set.",
+			},
+			"wait_for_clean": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Waits until the data movement finished and all placement groups are active+clean again. Defaults to false.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Seconds to wait for the placement groups with wait_for_clean, defaults to 3600.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *CephOsdAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *CephOsdAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CephOsdActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	for _, osdId := range data.OsdIds {
+		apiPath := fmt.Sprintf("/nodes/%s/ceph/osd/%d/%s", cephNode(data.Node), osdId, a.command)
+		_, err = pveApiCall(ctx, client, a.cloudInventory.TargetPve, "POST", apiPath, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to mark osd.%d %s, got error: %s", osdId, a.command, err))
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Marked osd.%d %s", osdId, a.command)})
+	}
+
+	if !data.WaitForClean.ValueBool() {
+		return
+	}
+
+	timeout := int64(3600)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Waiting for the placement groups to become active+clean"})
+	err = waitForCephClean(ctx, client, a.cloudInventory.TargetPve, time.Duration(timeout)*time.Second)
+	if err != nil {
+		resp.Diagnostics.AddError("Wait Error", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "All placement groups are active+clean"})
+}
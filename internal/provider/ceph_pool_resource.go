@@ -0,0 +1,438 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CephPoolResource{}
+var _ resource.ResourceWithImportState = &CephPoolResource{}
+
+func NewCephPoolResource() resource.Resource {
+	return &CephPoolResource{}
+}
+
+// CephPoolResource defines the resource implementation.
+type CephPoolResource struct {
+	cloudInventory CloudInventory
+}
+
+// CephPoolResourceModel describes the resource data model.
+type CephPoolResourceModel struct {
+	Name            types.String            `tfsdk:"name"`
+	Node            types.String            `tfsdk:"node"`
+	Size            types.Int64             `tfsdk:"size"`
+	MinSize         types.Int64             `tfsdk:"min_size"`
+	PgAutoscaleMode types.String            `tfsdk:"pg_autoscale_mode"`
+	PgNum           types.Int64             `tfsdk:"pg_num"`
+	TargetSizeRatio types.Float64           `tfsdk:"target_size_ratio"`
+	Application     types.String            `tfsdk:"application"`
+	CrushRule       types.String            `tfsdk:"crush_rule"`
+	ErasureCoding   *CephErasureCodingModel `tfsdk:"erasure_coding"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// CephErasureCodingModel describes the erasure coding profile of the data pool.
+type CephErasureCodingModel struct {
+	K             types.Int64  `tfsdk:"k"`
+	M             types.Int64  `tfsdk:"m"`
+	FailureDomain types.String `tfsdk:"failure_domain"`
+	DeviceClass   types.String `tfsdk:"device_class"`
+	Profile       types.String `tfsdk:"profile"`
+}
+
+func (r *CephPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_pool"
+}
+
+func (r *CephPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ceph pool of the hyperconverged proxmox ceph cluster, e.g. the rbd pool of the kubernetes csi. " +
+			"With erasure_coding proxmox creates the pools `<name>-data` and `<name>-metadata`, the replication attributes apply to the metadata pool. " +
+			"Deleting the resource deletes the pool and all of its data. Import with `<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ceph node the api calls are made on, the node the backend is connected to if not set.",
+			},
+			"size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3),
+				MarkdownDescription: "Number of replicas of each object.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 7),
+				},
+			},
+			"min_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(2),
+				MarkdownDescription: "Minimum number of available replicas to serve io.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 7),
+				},
+			},
+			"pg_autoscale_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("on"),
+				MarkdownDescription: "Whether ceph scales the placement groups of the pool.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off", "warn"),
+				},
+			},
+			"pg_num": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of placement groups, only refreshed if set. Leave unset with pg_autoscale_mode `on`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32768),
+				},
+			},
+			"target_size_ratio": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Expected share of the cluster capacity, lets the autoscaler size the placement groups in advance.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"application": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("rbd"),
+				MarkdownDescription: "Application of the pool.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("rbd", "cephfs", "rgw"),
+				},
+			},
+			"crush_rule": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Crush rule placing the objects, e.g. to restrict the pool to a device class. Ceph uses `replicated_rule` if not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"erasure_coding": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Creates an erasure coded data pool instead of a replicated one, fixed at creation.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"k": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "Number of data chunks.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2),
+						},
+					},
+					"m": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "Number of coding chunks, the number of failures the pool survives.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"failure_domain": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Crush failure domain of the chunks, ceph defaults to `host`.",
+					},
+					"device_class": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Device class the chunks are placed on, e.g. `ssd`.",
+					},
+					"profile": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Existing erasure code profile to use instead of k and m.",
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *CephPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// poolNames returns the ceph pools of the resource, the replicated pool the attributes apply to first.
+func (data CephPoolResourceModel) poolNames() []string {
+	if data.ErasureCoding != nil {
+		return []string{data.Name.ValueString() + "-metadata", data.Name.ValueString() + "-data"}
+	}
+	return []string{data.Name.ValueString()}
+}
+
+// poolPath returns the api path of a pool.
+func (data CephPoolResourceModel) poolPath(pool string) string {
+	return fmt.Sprintf("/nodes/%s/ceph/pool/%s", cephNode(data.Node), pool)
+}
+
+// poolArgs returns the pvesh args of the pool settings that can be updated.
+func (data CephPoolResourceModel) poolArgs() map[string]string {
+	args := map[string]string{
+		"--size":              strconv.FormatInt(data.Size.ValueInt64(), 10),
+		"--min_size":          strconv.FormatInt(data.MinSize.ValueInt64(), 10),
+		"--pg_autoscale_mode": data.PgAutoscaleMode.ValueString(),
+		"--application":       data.Application.ValueString(),
+	}
+	if !data.PgNum.IsNull() {
+		args["--pg_num"] = strconv.FormatInt(data.PgNum.ValueInt64(), 10)
+	}
+	if !data.TargetSizeRatio.IsNull() {
+		args["--target_size_ratio"] = strconv.FormatFloat(data.TargetSizeRatio.ValueFloat64(), 'f', -1, 64)
+	}
+	if !data.CrushRule.IsNull() && !data.CrushRule.IsUnknown() {
+		args["--crush_rule"] = data.CrushRule.ValueString()
+	}
+	return args
+}
+
+// erasureCodingSpec builds the erasure-coding property string.
+func (ec *CephErasureCodingModel) erasureCodingSpec() string {
+	parts := []string{
+		"k=" + strconv.FormatInt(ec.K.ValueInt64(), 10),
+		"m=" + strconv.FormatInt(ec.M.ValueInt64(), 10),
+	}
+	if !ec.FailureDomain.IsNull() {
+		parts = append(parts, "failure-domain="+ec.FailureDomain.ValueString())
+	}
+	if !ec.DeviceClass.IsNull() {
+		parts = append(parts, "device-class="+ec.DeviceClass.ValueString())
+	}
+	if !ec.Profile.IsNull() {
+		parts = append(parts, "profile="+ec.Profile.ValueString())
+	}
+	return strings.Join(parts, ",")
+}
+
+// readPool reads the status of the pool the attributes apply to, nil if it doesn't exist.
+func (r *CephPoolResource) readPool(ctx context.Context, client pb.CloudServiceClient, data CephPoolResourceModel) (map[string]interface{}, error) {
+	pool := data.poolNames()[0]
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/ceph/pool", cephNode(data.Node)), "pool_name", pool)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	var status map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.poolPath(pool)+"/status", &status)
+	return status, err
+}
+
+func (r *CephPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephPoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := data.poolArgs()
+	createArgs["--name"] = data.Name.ValueString()
+	if data.ErasureCoding != nil {
+		createArgs["--erasure-coding"] = data.ErasureCoding.erasureCodingSpec()
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/ceph/pool", cephNode(data.Node)), createArgs, true, cephTaskTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating pool, got error: %s", err))
+		return
+	}
+
+	status, err := r.readPool(ctx, client, data)
+	if err != nil || status == nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to read the created pool, got error: %v", err))
+		return
+	}
+	crushRule, _ := pveConfigString(status, "crush_rule")
+	data.CrushRule = types.StringValue(crushRule)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	status, err := r.readPool(ctx, client, data)
+	if removeIfMissing(ctx, status != nil, err, "pool", resp) {
+		return
+	}
+
+	size, _ := pveConfigInt(status, "size")
+	minSize, _ := pveConfigInt(status, "min_size")
+	autoscaleMode, _ := pveConfigString(status, "pg_autoscale_mode")
+	application, _ := pveConfigString(status, "application")
+	crushRule, _ := pveConfigString(status, "crush_rule")
+	data.Size = types.Int64Value(size)
+	data.MinSize = types.Int64Value(minSize)
+	data.PgAutoscaleMode = types.StringValue(autoscaleMode)
+	data.Application = types.StringValue(application)
+	data.CrushRule = types.StringValue(crushRule)
+
+	// the autoscaler changes pg_num on its own
+	if pgNum, ok := pveConfigInt(status, "pg_num"); ok && !data.PgNum.IsNull() {
+		data.PgNum = types.Int64Value(pgNum)
+	}
+	ratio, _ := pveConfigString(status, "target_size_ratio")
+	if parsed, err := strconv.ParseFloat(ratio, 64); err == nil && parsed > 0 {
+		data.TargetSizeRatio = types.Float64Value(parsed)
+	} else {
+		data.TargetSizeRatio = types.Float64Null()
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephPoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// a removed target_size_ratio is reset to 0, which disables it
+	setArgs := data.poolArgs()
+	if data.TargetSizeRatio.IsNull() {
+		setArgs["--target_size_ratio"] = "0"
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "PUT", data.poolPath(data.poolNames()[0]), setArgs, true, cephTaskTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating pool, got error: %s", err))
+		return
+	}
+
+	status, err := r.readPool(ctx, client, data)
+	if err != nil || status == nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to read the updated pool, got error: %v", err))
+		return
+	}
+	crushRule, _ := pveConfigString(status, "crush_rule")
+	data.CrushRule = types.StringValue(crushRule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to delete pools that are still used by a storage
+	for _, pool := range data.poolNames() {
+		err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", data.poolPath(pool), nil, true, cephTaskTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting pool %s, got error: %s", pool, err))
+			return
+		}
+	}
+}
+
+func (r *CephPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &CephPoolScrubAction{}
+var _ action.ActionWithConfigure = &CephPoolScrubAction{}
+
+func NewCephPoolScrubAction() action.Action {
+	return &CephPoolScrubAction{}
+}
+
+// CephPoolScrubAction defines the action implementation.
+type CephPoolScrubAction struct {
+	cloudInventory CloudInventory
+}
+
+// CephPoolScrubActionModel describes the action data model.
+type CephPoolScrubActionModel struct {
+	Pool types.String `tfsdk:"pool"`
+	Deep types.Bool   `tfsdk:"deep"`
+}
+
+func (a *CephPoolScrubAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_pool_scrub"
+}
+
+func (a *CephPoolScrubAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Schedules a scrub of all placement groups of a pool, e.g. to verify the data after replacing disks. " +
+			"The osds scrub in the background, progress and found inconsistencies show up in the ceph status.",
+
+		Attributes: map[string]schema.Attribute{
+			"pool": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the pool.",
+			},
+			"deep": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Runs a deep scrub that reads and checksums all data instead of only comparing metadata. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (a *CephPoolScrubAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *CephPoolScrubAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CephPoolScrubActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	scrub := "deep-scrub"
+	if !data.Deep.IsNull() && !data.Deep.ValueBool() {
+		scrub = "scrub"
+	}
+
+	err = cephCommand(ctx, client, a.cloudInventory.TargetPve, nil, "osd", "pool", scrub, data.Pool.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to %s pool %s, got error: %s", scrub, data.Pool.ValueString(), err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Scheduled %s of pool %s", scrub, data.Pool.ValueString())})
+}
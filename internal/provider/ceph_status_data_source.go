@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CephStatusDataSource{}
+
+func NewCephStatusDataSource() datasource.DataSource {
+	return &CephStatusDataSource{}
+}
+
+// CephStatusDataSource defines the data source implementation.
+type CephStatusDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// CephStatusDataSourceModel describes the data source data model.
+type CephStatusDataSourceModel struct {
+	Health       types.String      `tfsdk:"health"`
+	HealthChecks map[string]string `tfsdk:"health_checks"`
+	Pgs          types.Int64       `tfsdk:"pgs"`
+	Osds         types.Int64       `tfsdk:"osds"`
+	OsdsUp       types.Int64       `tfsdk:"osds_up"`
+	OsdsIn       types.Int64       `tfsdk:"osds_in"`
+	BytesTotal   types.Int64       `tfsdk:"bytes_total"`
+	BytesUsed    types.Int64       `tfsdk:"bytes_used"`
+	BytesAvail   types.Int64       `tfsdk:"bytes_avail"`
+	UsedRatio    types.Float64     `tfsdk:"used_ratio"`
+}
+
+func (d *CephStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ceph_status"
+}
+
+func (d *CephStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the ceph status of the target_pve cluster. Use it in preconditions to gate risky operations, " +
+			"e.g. only create pools while the health is HEALTH_OK and enough raw capacity is left.",
+
+		Attributes: map[string]schema.Attribute{
+			"health": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Overall ceph health, one of HEALTH_OK, HEALTH_WARN or HEALTH_ERR.",
+			},
+			"health_checks": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Failing health checks, e.g. `OSD_DOWN`, mapped to their summary prefixed with the severity.",
+			},
+			"pgs": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total number of placement groups.",
+			},
+			"osds": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total number of osds.",
+			},
+			"osds_up": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of osds that are up.",
+			},
+			"osds_in": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of osds that are in.",
+			},
+			"bytes_total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Raw capacity of all osds in bytes.",
+			},
+			"bytes_used": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Used raw capacity in bytes, including replication.",
+			},
+			"bytes_avail": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Available raw capacity in bytes.",
+			},
+			"used_ratio": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Fraction of the raw capacity that is used, between 0 and 1.",
+			},
+		},
+	}
+}
+
+func (d *CephStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *CephStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var status CephStatus
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, "/cluster/ceph/status", &status)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ceph status, got error: %s", err))
+		return
+	}
+
+	osdMap := status.osds()
+
+	data.Health = types.StringValue(status.Health.Status)
+	data.HealthChecks = map[string]string{}
+	for name, check := range status.Health.Checks {
+		data.HealthChecks[name] = fmt.Sprintf("%s: %s", check.Severity, check.Summary.Message)
+	}
+	data.Pgs = types.Int64Value(status.PgMap.NumPgs)
+	data.Osds = types.Int64Value(osdMap.NumOsds)
+	data.OsdsUp = types.Int64Value(osdMap.NumUpOsds)
+	data.OsdsIn = types.Int64Value(osdMap.NumInOsds)
+	data.BytesTotal = types.Int64Value(status.PgMap.BytesTotal)
+	data.BytesUsed = types.Int64Value(status.PgMap.BytesUsed)
+	data.BytesAvail = types.Int64Value(status.PgMap.BytesAvail)
+
+	data.UsedRatio = types.Float64Value(0)
+	if status.PgMap.BytesTotal > 0 {
+		data.UsedRatio = types.Float64Value(float64(status.PgMap.BytesUsed) / float64(status.PgMap.BytesTotal))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
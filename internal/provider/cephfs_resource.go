@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CephFsResource{}
+var _ resource.ResourceWithImportState = &CephFsResource{}
+
+func NewCephFsResource() resource.Resource {
+	return &CephFsResource{}
+}
+
+// CephFsResource defines the resource implementation.
+type CephFsResource struct {
+	cloudInventory CloudInventory
+}
+
+// CephFsResourceModel describes the resource data model.
+type CephFsResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Node       types.String `tfsdk:"node"`
+	PgNum      types.Int64  `tfsdk:"pg_num"`
+	AddStorage types.Bool   `tfsdk:"add_storage"`
+	MdsNodes   []string     `tfsdk:"mds_nodes"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *CephFsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs"
+}
+
+func (r *CephFsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a cephfs filesystem together with its data and metadata pools and the metadata servers (mds) it runs on. " +
+			"Deleting the resource fails the filesystem, deletes it with its pools and all data and removes the metadata servers. Import with `<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the filesystem, the pools are named `<name>_data` and `<name>_metadata`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ceph node the api calls are made on, the node the backend is connected to if not set.",
+			},
+			"pg_num": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(128),
+				MarkdownDescription: "Initial number of placement groups of the data pool, fixed at creation.",
+				Validators: []validator.Int64{
+					int64validator.Between(8, 32768),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"add_storage": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Adds a proxmox storage of the same name for iso images, templates and backups, fixed at creation.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"mds_nodes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				MarkdownDescription: "Nodes running a metadata server named after the node, the first to start is active, the others are standby. " +
+					"Metadata servers serve all filesystems of the cluster, don't list the same node in multiple resources.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *CephFsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// listMds returns the names of the metadata servers of the cluster.
+func (r *CephFsResource) listMds(ctx context.Context, client pb.CloudServiceClient, node types.String) ([]string, error) {
+	var servers []map[string]interface{}
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/ceph/mds", cephNode(node)), &servers)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, server := range servers {
+		if name, ok := pveConfigString(server, "name"); ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// setMds creates the metadata servers of the nodes in add that don't exist yet and destroys those of remove.
+func (r *CephFsResource) setMds(ctx context.Context, client pb.CloudServiceClient, data CephFsResourceModel, add []string, remove []string) error {
+	existing, err := r.listMds(ctx, client, data.Node)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range add {
+		if slices.Contains(existing, node) {
+			continue
+		}
+		err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/ceph/mds/%s", node, node), nil, true, cephTaskTimeout)
+		if err != nil {
+			return fmt.Errorf("mds %s: %w", node, err)
+		}
+	}
+
+	for _, node := range remove {
+		if !slices.Contains(existing, node) {
+			continue
+		}
+		err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", fmt.Sprintf("/nodes/%s/ceph/mds/%s", node, node), nil, true, cephTaskTimeout)
+		if err != nil {
+			return fmt.Errorf("mds %s: %w", node, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *CephFsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephFsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox only creates a filesystem if a metadata server is available
+	err = r.setMds(ctx, client, data, data.MdsNodes, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating metadata servers, got error: %s", err))
+		return
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/ceph/fs/%s", cephNode(data.Node), data.Name.ValueString()), map[string]string{
+		"--pg_num":      strconv.FormatInt(data.PgNum.ValueInt64(), 10),
+		"--add-storage": boolToPve(data.AddStorage.ValueBool()),
+	}, true, cephTaskTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating filesystem, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephFsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/ceph/fs", cephNode(data.Node)), "name", data.Name.ValueString())
+	if removeIfMissing(ctx, exists, err, "filesystem", resp) {
+		return
+	}
+
+	// metadata servers are named after their node, missing ones show up as drift
+	existing, err := r.listMds(ctx, client, data.Node)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list metadata servers, got error: %s", err))
+		return
+	}
+	mdsNodes := []string{}
+	for _, node := range data.MdsNodes {
+		if slices.Contains(existing, node) {
+			mdsNodes = append(mdsNodes, node)
+		}
+	}
+	data.MdsNodes = mdsNodes
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CephFsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	removed := []string{}
+	for _, node := range state.MdsNodes {
+		if !slices.Contains(data.MdsNodes, node) {
+			removed = append(removed, node)
+		}
+	}
+
+	// new servers are added first, so there is always one available
+	err = r.setMds(ctx, client, data, data.MdsNodes, removed)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating metadata servers, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephFsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox only destroys filesystems without active metadata servers
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, "fs", "fail", data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error failing filesystem, got error: %s", err))
+		return
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", fmt.Sprintf("/nodes/%s/ceph/fs/%s", cephNode(data.Node), data.Name.ValueString()), map[string]string{
+		"--remove-pools":    "1",
+		"--remove-storages": boolToPve(data.AddStorage.ValueBool()),
+	}, true, cephTaskTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting filesystem, got error: %s", err))
+		return
+	}
+
+	err = r.setMds(ctx, client, data, nil, data.MdsNodes)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error removing metadata servers, got error: %s", err))
+		return
+	}
+}
+
+func (r *CephFsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
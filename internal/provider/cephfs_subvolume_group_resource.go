@@ -0,0 +1,280 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CephFsSubvolumeGroupResource{}
+var _ resource.ResourceWithImportState = &CephFsSubvolumeGroupResource{}
+
+func NewCephFsSubvolumeGroupResource() resource.Resource {
+	return &CephFsSubvolumeGroupResource{}
+}
+
+// CephFsSubvolumeGroupResource defines the resource implementation.
+type CephFsSubvolumeGroupResource struct {
+	cloudInventory CloudInventory
+}
+
+// CephFsSubvolumeGroupResourceModel describes the resource data model.
+type CephFsSubvolumeGroupResourceModel struct {
+	Fs   types.String `tfsdk:"fs"`
+	Name types.String `tfsdk:"name"`
+	Size types.Int64  `tfsdk:"size"`
+	Mode types.String `tfsdk:"mode"`
+	Path types.String `tfsdk:"path"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *CephFsSubvolumeGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_subvolume_group"
+}
+
+func (r *CephFsSubvolumeGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a cephfs subvolume group, a directory grouping subvolumes under a common quota, for example all shares of one kubernetes stack. " +
+			"Deleting the resource fails while the group still contains subvolumes. Import with `<fs>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"fs": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the cephfs filesystem.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the subvolume group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Quota of all subvolumes in the group in bytes, unlimited if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Octal permissions of the group directory, e.g. `755`, fixed at creation.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cephFsModeRe, "must be three octal digits"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Path of the group inside the filesystem.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *CephFsSubvolumeGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// args returns the ceph arguments addressing the group after the subcommand.
+func (data CephFsSubvolumeGroupResourceModel) args(extra ...string) []string {
+	return append([]string{data.Fs.ValueString(), data.Name.ValueString()}, extra...)
+}
+
+// read refreshes the computed attributes from the group info, which unlike the
+// subvolume info has no path. Groups always live directly below /volumes.
+func (data *CephFsSubvolumeGroupResourceModel) read(info map[string]interface{}) {
+	data.Size = cephFsQuota(info)
+	data.Mode = cephFsMode(info)
+	data.Path = types.StringValue("/volumes/" + data.Name.ValueString())
+}
+
+func (r *CephFsSubvolumeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephFsSubvolumeGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var extra []string
+	if !data.Size.IsNull() {
+		extra = append(extra, "--size", strconv.FormatInt(data.Size.ValueInt64(), 10))
+	}
+	if !data.Mode.IsUnknown() {
+		extra = append(extra, "--mode", data.Mode.ValueString())
+	}
+
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"fs", "subvolumegroup", "create"}, data.args(extra...)...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating subvolume group, got error: %s", err))
+		return
+	}
+
+	var info map[string]interface{}
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, &info, append([]string{"fs", "subvolumegroup", "info"}, data.args()...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subvolume group, got error: %s", err))
+		return
+	}
+	data.read(info)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsSubvolumeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephFsSubvolumeGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var info map[string]interface{}
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, &info, append([]string{"fs", "subvolumegroup", "info"}, data.args()...)...)
+	if removeIfMissing(ctx, err == nil, err, "subvolume group", resp) {
+		return
+	}
+	data.read(info)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsSubvolumeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephFsSubvolumeGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	size := "inf"
+	if !data.Size.IsNull() {
+		size = strconv.FormatInt(data.Size.ValueInt64(), 10)
+	}
+
+	// only the quota can change, everything else requires a replacement
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"fs", "subvolumegroup", "resize"}, data.args(size)...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error resizing subvolume group, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsSubvolumeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephFsSubvolumeGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"fs", "subvolumegroup", "rm"}, data.args()...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting subvolume group, got error: %s", err))
+		return
+	}
+}
+
+func (r *CephFsSubvolumeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fs, name, found := strings.Cut(req.ID, "/")
+	if !found || fs == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <fs>/<name>, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fs"), fs)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
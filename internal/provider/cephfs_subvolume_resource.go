@@ -0,0 +1,297 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CephFsSubvolumeResource{}
+var _ resource.ResourceWithImportState = &CephFsSubvolumeResource{}
+
+func NewCephFsSubvolumeResource() resource.Resource {
+	return &CephFsSubvolumeResource{}
+}
+
+// CephFsSubvolumeResource defines the resource implementation.
+type CephFsSubvolumeResource struct {
+	cloudInventory CloudInventory
+}
+
+// CephFsSubvolumeResourceModel describes the resource data model.
+type CephFsSubvolumeResourceModel struct {
+	Fs    types.String `tfsdk:"fs"`
+	Name  types.String `tfsdk:"name"`
+	Group types.String `tfsdk:"group"`
+	Size  types.Int64  `tfsdk:"size"`
+	Mode  types.String `tfsdk:"mode"`
+	Path  types.String `tfsdk:"path"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *CephFsSubvolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_subvolume"
+}
+
+func (r *CephFsSubvolumeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a cephfs subvolume, a directory with its own quota that is handed out as share, for example to the ceph csi driver of a kubernetes stack. " +
+			"Deleting the resource deletes the subvolume with all its data. Import with `<fs>/<name>` or `<fs>/<group>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"fs": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the cephfs filesystem.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the subvolume.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Subvolume group the subvolume is created in, the default group `_nogroup` if not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Quota of the subvolume in bytes, unlimited if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Octal permissions of the subvolume directory, e.g. `755`, fixed at creation.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cephFsModeRe, "must be three octal digits"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Path of the subvolume inside the filesystem, used as root path when mounting it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *CephFsSubvolumeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// args returns the ceph arguments addressing the subvolume after the subcommand.
+func (data CephFsSubvolumeResourceModel) args(extra ...string) []string {
+	args := append([]string{data.Fs.ValueString(), data.Name.ValueString()}, extra...)
+	if !data.Group.IsNull() {
+		args = append(args, "--group_name", data.Group.ValueString())
+	}
+	return args
+}
+
+// read refreshes the computed attributes from the subvolume info.
+func (data *CephFsSubvolumeResourceModel) read(info map[string]interface{}) {
+	data.Size = cephFsQuota(info)
+	data.Mode = cephFsMode(info)
+	subvolPath, _ := pveConfigString(info, "path")
+	data.Path = types.StringValue(subvolPath)
+}
+
+func (r *CephFsSubvolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephFsSubvolumeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var extra []string
+	if !data.Size.IsNull() {
+		extra = append(extra, "--size", strconv.FormatInt(data.Size.ValueInt64(), 10))
+	}
+	if !data.Mode.IsUnknown() {
+		extra = append(extra, "--mode", data.Mode.ValueString())
+	}
+
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"fs", "subvolume", "create"}, data.args(extra...)...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating subvolume, got error: %s", err))
+		return
+	}
+
+	var info map[string]interface{}
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, &info, append([]string{"fs", "subvolume", "info"}, data.args()...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subvolume, got error: %s", err))
+		return
+	}
+	data.read(info)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsSubvolumeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephFsSubvolumeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var info map[string]interface{}
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, &info, append([]string{"fs", "subvolume", "info"}, data.args()...)...)
+	if removeIfMissing(ctx, err == nil, err, "subvolume", resp) {
+		return
+	}
+	data.read(info)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsSubvolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephFsSubvolumeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	size := "inf"
+	if !data.Size.IsNull() {
+		size = strconv.FormatInt(data.Size.ValueInt64(), 10)
+	}
+
+	// only the quota can change, everything else requires a replacement
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"fs", "subvolume", "resize"}, data.args(size)...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error resizing subvolume, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephFsSubvolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephFsSubvolumeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = cephCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"fs", "subvolume", "rm"}, data.args()...)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting subvolume, got error: %s", err))
+		return
+	}
+}
+
+func (r *CephFsSubvolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) < 2 || len(parts) > 3 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <fs>/<name> or <fs>/<group>/<name>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fs"), parts[0])...)
+	if len(parts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), parts[1])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[len(parts)-1])...)
+}
@@ -6,31 +6,29 @@ package provider
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
+	"slices"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"filippo.io/age"
-	"filippo.io/age/agessh"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CloudSecretAgeResource{}
-var _ resource.ResourceWithImportState = &CloudSecretAgeResource{}
 
 func NewCloudSecretAgeResource() resource.Resource {
 	return &CloudSecretAgeResource{}
@@ -43,9 +41,18 @@ type CloudSecretAgeResource struct {
 
 // CloudSecretAgeResourceModel describes the resource data model.
 type CloudSecretAgeResourceModel struct {
-	SecretName types.String `tfsdk:"secret_name"`
-	B64AgeData types.String `tfsdk:"b64_age_data"`
-	PlainData  types.String `tfsdk:"plain_data"`
+	SecretName           types.String `tfsdk:"secret_name"`
+	B64AgeData           types.String `tfsdk:"b64_age_data"`
+	SecretDataWo         types.String `tfsdk:"secret_data_wo"`
+	SecretDataWoVersion  types.Int64  `tfsdk:"secret_data_wo_version"`
+	IdentityPaths        []string     `tfsdk:"identity_paths"`
+	CheckSshAgent        types.Bool   `tfsdk:"check_ssh_agent"`
+	Recipients           []string     `tfsdk:"recipients"`
+	RecipientsB64AgeData types.String `tfsdk:"recipients_b64_age_data"`
+	RecipientsMatch      types.Bool   `tfsdk:"recipients_match"`
+	PlainData            types.String `tfsdk:"plain_data"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
 }
 
 func (r *CloudSecretAgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,7 +61,7 @@ func (r *CloudSecretAgeResource) Metadata(ctx context.Context, req resource.Meta
 
 func (r *CloudSecretAgeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates age encrypted secret in proxmox cloud. This is useful for storing hard coded secrets safely in git repositories. This resource will try to use keys from the ~/.ssh directory for decryption during resource creation.",
+		MarkdownDescription: "Creates age encrypted secret in proxmox cloud. This is useful for storing hard coded secrets safely in git repositories. This resource uses the identities configured in the provider age_identities, keys from the ~/.ssh directory and identity_paths for decryption. The age ciphertext is not stored remotely, so the resource can't be imported.",
 		Attributes: map[string]schema.Attribute{
 			"secret_name": schema.StringAttribute{
 				Required:            true,
@@ -64,17 +71,62 @@ func (r *CloudSecretAgeResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"b64_age_data": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Insert your b64 encoded age encrypted secret here, use `age -R ~/.ssh/id_ed25519.pub -R ~/.ssh/id_rsa.pub secret.file | base64 -w0` to generate the value. Currently only supports string files.",
+				Optional:            true,
+				MarkdownDescription: "Insert your age encrypted secret here, either ascii armored via `age -a -R ~/.ssh/id_ed25519.pub -R ~/.ssh/id_rsa.pub secret.file` or b64 encoded via `age -R ~/.ssh/id_ed25519.pub -R ~/.ssh/id_rsa.pub secret.file | base64 -w0`, the encoding is detected automatically. Currently only supports string files. Exactly one of b64_age_data or secret_data_wo has to be set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(), // lazy replace
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("secret_data_wo")),
+				},
+			},
+			"secret_data_wo": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				MarkdownDescription: "Write-only variant of b64_age_data, requires terraform 1.11+. When used plain_data is not stored in the state either. Bump secret_data_wo_version to recreate the secret with a new value.",
+			},
+			"secret_data_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of secret_data_wo, changing it recreates the secret with the current secret_data_wo value.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_data_wo")),
+				},
+			},
+			"identity_paths": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Additional identity files used for decryption. Supports native age identity files (AGE-SECRET-KEY-...), age identity files encrypted with `age -p` and ssh private keys. The passphrase of protected identities is read from the CLOUD_AGE_IDENTITY_PASSPHRASE env var.",
+			},
+			"check_ssh_agent": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "On decryption failure check the keys held by the ssh-agent at SSH_AUTH_SOCK and report the ones the secret is encrypted to. The ssh-agent protocol only supports signing, so age can't decrypt through the agent directly and the matching private key has to be passed via identity_paths.",
+			},
+			"recipients": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Declared recipients of the secret, native age (age1...) and ssh public keys. The decrypted secret is re-encrypted for them into recipients_b64_age_data.",
+			},
+			"recipients_b64_age_data": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "B64 encoded age data encrypted for the declared recipients. Copy it into b64_age_data to rotate the recipients of the secret.",
+			},
+			"recipients_match": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether b64_age_data is encrypted to exactly the declared recipients. Native age recipients can only be compared by count.",
 			},
 			"plain_data": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "During resource creation the provider looks at the env var CLOUD_AGE_SSH_KEY_FILE to load file for initial decryption. Once the resource is created you can here access the unencrypted secret, this is for convenience sake. You can also use the pxc_cloud_secret datasource to access it.",
+				MarkdownDescription: "Decrypted with the provider age_identities and identity_paths. Once the resource is created you can here access the unencrypted secret, this is for convenience sake. You can also use the pxc_cloud_secret datasource to access it. Stays empty when secret_data_wo is used.",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
 
@@ -106,68 +158,23 @@ func (r *CloudSecretAgeResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// try decode the secret value with keyfiles from ~/.ssh
-	identities := []age.Identity{}
-	home, _ := os.UserHomeDir()
-	sshDir := filepath.Join(home, ".ssh")
-	
-	files, _ := os.ReadDir(sshDir)
-	for _, file := range files {
-		if strings.HasPrefix(file.Name(), "id_") && !strings.HasSuffix(file.Name(), ".pub") {
-			keyPath := filepath.Join(sshDir, file.Name())
-			
-			pemBytes, err := os.ReadFile(keyPath)
-			if err != nil {
-				continue
-			}
-
-			identity, err := agessh.ParseIdentity(pemBytes)
-			if err == nil {
-				identities = append(identities, identity)
-			}
-		}
-	}
-	
-	// additionally a env var can be passed to specific custom location (e.g. e2e usecase)
-	ageSshKey := os.Getenv("CLOUD_AGE_SSH_KEY_FILE")
-	if ageSshKey != "" {
-		pemBytes, err := os.ReadFile(ageSshKey)
-		if err != nil {
-			resp.Diagnostics.AddError("Read err", fmt.Sprintf("Error reading ssh key %s", err))
-			return
-		}
-
-		identity, err := agessh.ParseIdentity(pemBytes)
-		if err != nil {
-			resp.Diagnostics.AddError("Parse err", fmt.Sprintf("Error parsing age id %s", err))
-			return
-		}
-		identities = append(identities, identity)
-	}
-
-	b64Reader := base64.NewDecoder(base64.StdEncoding, strings.NewReader(data.B64AgeData.ValueString()))
-	re, err := age.Decrypt(b64Reader, identities...)
-	if err != nil {
-		resp.Diagnostics.AddError("Decrypt err", fmt.Sprintf("Failed to decrypt: %v (Ensure your SSH key matches one of the recipients)", err))
-		return
-	}
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
 
-	var out bytes.Buffer
-	if _, err := io.Copy(&out, re); err != nil {
-		resp.Diagnostics.AddError("Read err", fmt.Sprintf("Error reading decrypted data: %v", err))
+	plainData, diags := r.decrypt(ctx, req.Config, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.PlainData = types.StringValue(out.String())
-
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
 	// perform the request
-	cresp, err := client.CreateCloudSecret(ctx, &pb.CreateCloudSecretRequest{TargetPve:r.cloudInventory.TargetPve, CloudDomain: r.cloudInventory.CloudDomain, SecretName: data.SecretName.ValueString(), SecretData: data.PlainData.String()})
+	cresp, err := client.CreateCloudSecret(ctx, &pb.CreateCloudSecretRequest{TargetPve: r.cloudInventory.TargetPve, CloudDomain: r.cloudInventory.CloudDomain, SecretName: data.SecretName.ValueString(), SecretData: types.StringValue(plainData).String()})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make grp create cloud secret request, got error: %s", err))
 		return
@@ -192,43 +199,123 @@ func (r *CloudSecretAgeResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// only the existence is checked, the stored plaintext can't be compared
+	// against the age data without the decryption keys
+	cresp, err := client.GetCloudSecretByName(ctx, &pb.GetCloudSecretByNameRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString()})
+	if removeIfMissing(ctx, err == nil && cresp.Found, err, "cloud age secret", resp) {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CloudSecretAgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"This resource does not support in-place updates. Any change to these attributes "+
-			"should have triggered a replacement. This is a provider bug.",
-	)
-	// var data CloudSecretAgeResourceModel
-
-	// // Read Terraform plan data into the model
-	// resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
-
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	var data CloudSecretAgeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	// only decryption settings and recipients can change in place, the
+	// stored secret stays the same but has to be re-encrypted
+	_, diags := r.decrypt(ctx, req.Config, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// decrypt decrypts the age payload, fills plain_data and the recipient attributes
+// of the model and returns the plaintext.
+func (r *CloudSecretAgeResource) decrypt(ctx context.Context, config tfsdk.Config, data *CloudSecretAgeResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// provider level identities are validated during configure
+	identities := slices.Clone(r.cloudInventory.AgeIdentities)
+	fileIdentities, err := loadAgeIdentityFiles(data.IdentityPaths)
+	if err != nil {
+		diags.AddError("Read err", fmt.Sprintf("Error loading age identities: %s", err))
+		return "", diags
+	}
+	identities = append(identities, fileIdentities...)
+
+	b64AgeData, payloadDiags := secretPayload(ctx, config, data.B64AgeData)
+	diags.Append(payloadDiags...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	ciphertext, err := decodeAgePayload(b64AgeData)
+	if err != nil {
+		diags.AddError("Decode err", fmt.Sprintf("Failed to decode age data: %v", err))
+		return "", diags
+	}
+
+	re, err := age.Decrypt(bytes.NewReader(ciphertext), identities...)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to decrypt: %v (Ensure your SSH key matches one of the recipients)", err)
+		if data.CheckSshAgent.ValueBool() {
+			msg += ", " + sshAgentDecryptHint(ciphertext)
+		}
+		diags.AddError("Decrypt err", msg)
+		return "", diags
+	}
+
+	var out bytes.Buffer
+	if _, err := io.Copy(&out, re); err != nil {
+		diags.AddError("Read err", fmt.Sprintf("Error reading decrypted data: %v", err))
+		return "", diags
+	}
+
+	// keep the plaintext out of the state if the payload was passed write-only
+	data.PlainData = types.StringNull()
+	if !data.B64AgeData.IsNull() {
+		data.PlainData = types.StringValue(out.String())
+	}
+
+	data.RecipientsB64AgeData = types.StringNull()
+	data.RecipientsMatch = types.BoolNull()
+	if len(data.Recipients) == 0 {
+		return out.String(), diags
+	}
+
+	reencrypted, err := encryptAge(out.Bytes(), data.Recipients)
+	if err != nil {
+		diags.AddError("Encrypt err", fmt.Sprintf("Failed to encrypt for recipients: %v", err))
+		return "", diags
+	}
+	data.RecipientsB64AgeData = types.StringValue(reencrypted)
+
+	match, err := ageRecipientsMatch(ciphertext, data.Recipients)
+	if err != nil {
+		diags.AddError("Recipients err", fmt.Sprintf("Failed to compare recipients: %v", err))
+		return "", diags
+	}
+	data.RecipientsMatch = types.BoolValue(match)
+	if !match {
+		diags.AddWarning("Recipients mismatch", "The age data is not encrypted to the declared recipients, replace it with recipients_b64_age_data.")
+	}
+
+	return out.String(), diags
 }
 
 func (r *CloudSecretAgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -240,20 +327,15 @@ func (r *CloudSecretAgeResource) Delete(ctx context.Context, req resource.Delete
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// init rpc client
-	conn, err := grpc.NewClient(
-		fmt.Sprintf("unix:///tmp/pc-rpc-%d.sock", os.Getpid()),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init grpc client, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
-	defer conn.Close()
-
-	client := pb.NewCloudServiceClient(conn)
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
-	defer cancel()
 
 	// perform the request
 	cresp, err := client.DeleteCloudSecret(ctx, &pb.DeleteCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString()})
@@ -268,7 +350,3 @@ func (r *CloudSecretAgeResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 }
-
-func (r *CloudSecretAgeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
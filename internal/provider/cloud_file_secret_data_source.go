@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"unicode/utf8"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -27,6 +29,8 @@ type CloudFileSecretDataSourceModel struct {
 	SecretName types.String `tfsdk:"secret_name"`
 	Secret     types.String `tfsdk:"secret"`
 	Rstrip     types.Bool   `tfsdk:"rstrip"`
+	SecretB64  types.String `tfsdk:"secret_b64"`
+	IsBinary   types.Bool   `tfsdk:"is_binary"`
 }
 
 func (d *CloudFileSecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,7 +48,15 @@ func (d *CloudFileSecretDataSource) Schema(ctx context.Context, req datasource.S
 			},
 			"secret": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Cat output of raw secret file.",
+				MarkdownDescription: "Cat output of raw secret file. Binary files are corrupted by the string conversion, use `secret_b64` for them.",
+			},
+			"secret_b64": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Base64 encoded unmodified file content, `rstrip` is not applied. Safe for binary secrets like keytabs or PKCS12 bundles, decode with `base64decode` or pass on as is.",
+			},
+			"is_binary": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if the file content is not valid UTF-8 and `secret` can't represent it.",
 			},
 			"rstrip": schema.BoolAttribute{
 				MarkdownDescription: "Wheter to rstrip the secret, removing whitespace and newlines, if not specified defaults to true.",
@@ -82,7 +94,7 @@ func (d *CloudFileSecretDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
@@ -102,6 +114,8 @@ func (d *CloudFileSecretDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	data.Secret = types.StringValue(cresp.Secret)
+	data.SecretB64 = types.StringValue(base64.StdEncoding.EncodeToString(cresp.Raw))
+	data.IsBinary = types.BoolValue(!utf8.Valid(cresp.Raw))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -26,6 +26,8 @@ type CloudSecretDataSource struct {
 type CloudSecretDataSourceModel struct {
 	SecretName types.String `tfsdk:"secret_name"`
 	SecretData types.String `tfsdk:"secret_data"`
+	Namespace  types.String `tfsdk:"namespace"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
 }
 
 func (d *CloudSecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +39,10 @@ func (d *CloudSecretDataSource) Schema(ctx context.Context, req datasource.Schem
 		MarkdownDescription: "Fetches a proxmox cloud secret, scoped by target_pve, from the postgres px_cloud_secret table.",
 
 		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the secrets, e.g. the kubespray stack name. Uses the shared namespace if not specified.",
+				Optional:            true,
+			},
 			"secret_name": schema.StringAttribute{
 				MarkdownDescription: "Secret name to fetch.",
 				Required:            true,
@@ -46,6 +52,10 @@ func (d *CloudSecretDataSource) Schema(ctx context.Context, req datasource.Schem
 				Computed:            true,
 				MarkdownDescription: "Secret data as json string, parsed from jsonb inside postgres database. Use jsondecode to access it as dynamic terraform object.",
 			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC3339 timestamp after which the backend deletes the secret, null if it never expires.",
+			},
 		},
 	}
 }
@@ -78,19 +88,20 @@ func (d *CloudSecretDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
-	cresp, err := client.GetCloudSecret(ctx, &pb.GetCloudSecretRequest{CloudDomain: d.cloudInventory.CloudDomain, TargetPve: d.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString()})
+	cresp, err := client.GetCloudSecret(ctx, &pb.GetCloudSecretRequest{CloudDomain: d.cloudInventory.CloudDomain, TargetPve: d.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString(), Namespace: data.Namespace.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud secret, got error: %s", err))
 		return
 	}
 
 	data.SecretData = types.StringValue(cresp.Secret)
+	data.ExpiresAt = optionalString(cresp.ExpiresAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
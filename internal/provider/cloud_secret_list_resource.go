@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &CloudSecretListResource{}
+var _ list.ListResourceWithConfigure = &CloudSecretListResource{}
+
+func NewCloudSecretListResource() list.ListResource {
+	return &CloudSecretListResource{}
+}
+
+// CloudSecretListResource lists the cloud secrets of the target_pve cluster.
+type CloudSecretListResource struct {
+	cloudInventory CloudInventory
+}
+
+// CloudSecretListResourceModel describes the list config data model.
+type CloudSecretListResourceModel struct {
+	SecretType types.String `tfsdk:"secret_type"`
+	Namespace  types.String `tfsdk:"namespace"`
+}
+
+func (l *CloudSecretListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_secret"
+}
+
+func (l *CloudSecretListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the cloud secrets of a namespace, e.g. to generate import configuration for secrets not managed yet. The data is imported into secret_data.",
+
+		Attributes: map[string]schema.Attribute{
+			"secret_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list secrets of this type.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Namespace to list, defaults to the shared namespace.",
+			},
+		},
+	}
+}
+
+func (l *CloudSecretListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.cloudInventory = cloudInv
+}
+
+func (l *CloudSecretListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data CloudSecretListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, l.cloudInventory)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cresp, err := client.GetCloudSecretNames(ctx, &pb.GetCloudSecretNamesRequest{CloudDomain: l.cloudInventory.CloudDomain, TargetPve: l.cloudInventory.TargetPve, SecretType: data.SecretType.ValueString(), Namespace: data.Namespace.ValueString()})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list cloud secrets, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, meta := range cresp.Secrets {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = meta.SecretName
+
+			secretData := CloudSecretResourceModel{
+				SecretName: types.StringValue(meta.SecretName),
+				SecretType: optionalString(meta.SecretType),
+				Namespace:  data.Namespace,
+				ExpiresAt:  optionalString(meta.ExpiresAt),
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, CloudSecretIdentityModel{
+				TargetPve:  types.StringValue(l.cloudInventory.TargetPve),
+				Namespace:  types.StringValue(data.Namespace.ValueString()),
+				SecretName: secretData.SecretName,
+			})...)
+
+			if req.IncludeResource {
+				secret, err := client.GetCloudSecretByName(ctx, &pb.GetCloudSecretByNameRequest{CloudDomain: l.cloudInventory.CloudDomain, TargetPve: l.cloudInventory.TargetPve, SecretName: meta.SecretName, Namespace: data.Namespace.ValueString()})
+				if err != nil {
+					result.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud secret %s, got error: %s", meta.SecretName, err))
+				} else {
+					secretData.SecretData = types.StringValue(secret.SecretData)
+					result.Diagnostics.Append(result.Resource.Set(ctx, &secretData)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
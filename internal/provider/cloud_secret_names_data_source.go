@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudSecretNamesDataSource{}
+
+func NewCloudSecretNamesDataSource() datasource.DataSource {
+	return &CloudSecretNamesDataSource{}
+}
+
+// CloudSecretNamesDataSource defines the data source implementation.
+type CloudSecretNamesDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// CloudSecretNamesDataSourceModel describes the data source data model.
+type CloudSecretNamesDataSourceModel struct {
+	SecretType types.String           `tfsdk:"secret_type"`
+	Secrets    []CloudSecretMetaModel `tfsdk:"secrets"`
+	Names      []string               `tfsdk:"names"`
+	Namespace  types.String           `tfsdk:"namespace"`
+}
+
+// CloudSecretMetaModel describes the metadata of a single secret.
+type CloudSecretMetaModel struct {
+	SecretName types.String `tfsdk:"secret_name"`
+	SecretType types.String `tfsdk:"secret_type"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+func (d *CloudSecretNamesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_secret_names"
+}
+
+func (d *CloudSecretNamesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the names and metadata of the proxmox cloud secrets in the postgres px_cloud_secret table, scoped by target_pve. Secret values are never fetched, use this to implement create-if-missing patterns without pulling payloads into state.",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the secrets, e.g. the kubespray stack name. Uses the shared namespace if not specified.",
+				Optional:            true,
+			},
+			"secret_type": schema.StringAttribute{
+				MarkdownDescription: "Only list secrets of this type, lists all types if not specified.",
+				Optional:            true,
+			},
+			"secrets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Metadata of the matching secrets.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"secret_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the secret.",
+						},
+						"secret_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the secret.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC3339 creation timestamp.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC3339 timestamp of the last update.",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "RFC3339 timestamp after which the backend deletes the secret, null if it never expires.",
+						},
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Names of the matching secrets, convenient for `contains` checks.",
+			},
+		},
+	}
+}
+
+func (d *CloudSecretNamesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *CloudSecretNamesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudSecretNamesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.GetCloudSecretNames(ctx, &pb.GetCloudSecretNamesRequest{CloudDomain: d.cloudInventory.CloudDomain, TargetPve: d.cloudInventory.TargetPve, SecretType: data.SecretType.ValueString(), Namespace: data.Namespace.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud secret names, got error: %s", err))
+		return
+	}
+
+	data.Secrets = []CloudSecretMetaModel{}
+	data.Names = []string{}
+	for _, secret := range cresp.Secrets {
+		data.Secrets = append(data.Secrets, CloudSecretMetaModel{
+			SecretName: types.StringValue(secret.SecretName),
+			SecretType: types.StringValue(secret.SecretType),
+			CreatedAt:  types.StringValue(secret.CreatedAt),
+			UpdatedAt:  types.StringValue(secret.UpdatedAt),
+			ExpiresAt:  optionalString(secret.ExpiresAt),
+		})
+		data.Names = append(data.Names, secret.SecretName)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CloudSecretRefModel references a value stored in a cloud secret, so credentials
+// don't have to pass through the terraform config.
+type CloudSecretRefModel struct {
+	Name      types.String `tfsdk:"name"`
+	Namespace types.String `tfsdk:"namespace"`
+	Key       types.String `tfsdk:"key"`
+}
+
+// cloudSecretRefAttribute is the schema of a CloudSecretRefModel.
+func cloudSecretRefAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the cloud secret.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Namespace of the cloud secret, the shared namespace if not set.",
+			},
+			"key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Key of the value if the secret data is a json object, without it the secret data has to be a json string.",
+			},
+		},
+	}
+}
+
+// resolveCloudSecretRef fetches the referenced cloud secret and extracts the value.
+func resolveCloudSecretRef(ctx context.Context, client pb.CloudServiceClient, cloudInv CloudInventory, ref *CloudSecretRefModel) (string, error) {
+	cresp, err := client.GetCloudSecret(ctx, &pb.GetCloudSecretRequest{CloudDomain: cloudInv.CloudDomain, TargetPve: cloudInv.TargetPve, SecretName: ref.Name.ValueString(), Namespace: ref.Namespace.ValueString()})
+	if err != nil {
+		return "", fmt.Errorf("unable to get cloud secret %s: %w", ref.Name.ValueString(), err)
+	}
+
+	if ref.Key.IsNull() {
+		var value string
+		if err := json.Unmarshal([]byte(cresp.Secret), &value); err != nil {
+			return "", fmt.Errorf("cloud secret %s is not a json string, set key to pick a value", ref.Name.ValueString())
+		}
+		return value, nil
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(cresp.Secret), &obj); err != nil {
+		return "", fmt.Errorf("cloud secret %s is not a json object: %w", ref.Name.ValueString(), err)
+	}
+	value, ok := obj[ref.Key.ValueString()].(string)
+	if !ok {
+		return "", fmt.Errorf("cloud secret %s has no string value for key %s", ref.Name.ValueString(), ref.Key.ValueString())
+	}
+	return value, nil
+}
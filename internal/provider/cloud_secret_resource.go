@@ -6,23 +6,28 @@ package provider
 import (
 	"context"
 	"fmt"
-	"os"
+	"strings"
 	"time"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CloudSecretResource{}
 var _ resource.ResourceWithImportState = &CloudSecretResource{}
+var _ resource.ResourceWithIdentity = &CloudSecretResource{}
 
 func NewCloudSecretResource() resource.Resource {
 	return &CloudSecretResource{}
@@ -35,9 +40,23 @@ type CloudSecretResource struct {
 
 // CloudSecretResourceModel describes the resource data model.
 type CloudSecretResourceModel struct {
+	SecretName          types.String `tfsdk:"secret_name"`
+	SecretData          types.String `tfsdk:"secret_data"`
+	SecretDataWo        types.String `tfsdk:"secret_data_wo"`
+	SecretDataWoVersion types.Int64  `tfsdk:"secret_data_wo_version"`
+	SecretType          types.String `tfsdk:"secret_type"`
+	Namespace           types.String `tfsdk:"namespace"`
+	Ttl                 types.String `tfsdk:"ttl"`
+	ExpiresAt           types.String `tfsdk:"expires_at"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// CloudSecretIdentityModel describes the resource identity.
+type CloudSecretIdentityModel struct {
+	TargetPve  types.String `tfsdk:"target_pve"`
+	Namespace  types.String `tfsdk:"namespace"`
 	SecretName types.String `tfsdk:"secret_name"`
-	SecretData types.String `tfsdk:"secret_data"`
-	SecretType types.String `tfsdk:"secret_type"`
 }
 
 func (r *CloudSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,35 +65,98 @@ func (r *CloudSecretResource) Metadata(ctx context.Context, req resource.Metadat
 
 func (r *CloudSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates a proxmox cloud secret that is saved in the clouds patroni postgres.",
+		MarkdownDescription: "Creates a proxmox cloud secret that is saved in the clouds patroni postgres. Import with `[<namespace>/]<secret_name>`, the data is imported into secret_data.",
 
 		Attributes: map[string]schema.Attribute{
 			"secret_name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Name of the secret, has to be unique for the target_pve.",
+				MarkdownDescription: "Name of the secret, has to be unique for the target_pve and namespace.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(), // lazy replace
 				},
 			},
 			// todo: figure out terraforms absurd type system to avoid jsonencode and decode calls to pass / receive dynamic values
 			"secret_data": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Secret data as json string, use jsonencode to pass your terraform object (will be converted to json on storage).",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // lazy replace
+				Optional:            true,
+				MarkdownDescription: "Secret data as json string, use jsonencode to pass your terraform object (will be converted to json on storage). Changes are applied in place. Exactly one of secret_data or secret_data_wo has to be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("secret_data_wo")),
+				},
+			},
+			"secret_data_wo": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				MarkdownDescription: "Write-only variant of secret_data that is never persisted in the state, requires terraform 1.11+. Bump secret_data_wo_version to apply a new value.",
+			},
+			"secret_data_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of secret_data_wo, changing it triggers an update with the current secret_data_wo value.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_data_wo")),
 				},
 			},
 			"secret_type": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Type of the secret, can be used to store configuration secrets and for discovery.",
+				MarkdownDescription: "Type of the secret, can be used to store configuration secrets and for discovery. Changes are applied in place.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Scope of the secret, e.g. the name of the kubespray stack, so stacks don't collide on secret names. Secrets without namespace share a single namespace per target_pve.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // lazy replace
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"ttl": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Lifetime of the secret as go duration (e.g. `24h`), expires_at is computed from it on creation. The backend stops returning expired secrets and deletes them on the next write, the next plan then recreates the resource. Changing the ttl recreates the secret.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("expires_at")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "RFC3339 timestamp after which the backend deletes the secret. Can be set directly instead of ttl, changes are applied in place.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *CloudSecretResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"target_pve": targetPveIdentityAttribute,
+			"namespace": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Namespace of the secret, empty for the shared namespace.",
+			},
+			"secret_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the secret.",
+			},
 		},
 	}
 }
 
+func (r *CloudSecretResource) identity(data CloudSecretResourceModel) CloudSecretIdentityModel {
+	return CloudSecretIdentityModel{
+		TargetPve:  types.StringValue(r.cloudInventory.TargetPve),
+		Namespace:  types.StringValue(data.Namespace.ValueString()),
+		SecretName: data.SecretName,
+	}
+}
+
 func (r *CloudSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -103,16 +185,32 @@ func (r *CloudSecretResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
-	
+	secretData, diags := secretPayload(ctx, req.Config, data.SecretData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, err := secretExpiry(data.Ttl, data.ExpiresAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Expiry", err.Error())
+		return
+	}
+	if data.ExpiresAt.IsUnknown() {
+		data.ExpiresAt = optionalString(expiresAt)
+	}
 
 	// perform the request
-	cresp, err := client.CreateCloudSecret(ctx, &pb.CreateCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString(), SecretType: data.SecretType.ValueString(), SecretData: data.SecretData.ValueString()})
+	cresp, err := client.CreateCloudSecret(ctx, &pb.CreateCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString(), SecretType: data.SecretType.ValueString(), SecretData: secretData, Namespace: data.Namespace.ValueString(), ExpiresAt: expiresAt})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make grp create cloud secret request, got error: %s", err))
 		return
@@ -125,6 +223,9 @@ func (r *CloudSecretResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(data))...)
+	}
 }
 
 func (r *CloudSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -137,44 +238,87 @@ func (r *CloudSecretResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.GetCloudSecretByName(ctx, &pb.GetCloudSecretByNameRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString(), Namespace: data.Namespace.ValueString()})
+	if removeIfMissing(ctx, err == nil && cresp.Found, err, "cloud secret", resp) {
+		return
+	}
+
+	// postgres stores the data as jsonb, only take it over if it differs semantically.
+	// write-only payloads are never refreshed into the state.
+	if !data.SecretData.IsNull() && !jsonEqual(data.SecretData.ValueString(), cresp.SecretData) {
+		data.SecretData = types.StringValue(cresp.SecretData)
+	}
+
+	if cresp.SecretType != "" || !data.SecretType.IsNull() {
+		data.SecretType = types.StringValue(cresp.SecretType)
+	}
+
+	if !sameInstant(data.ExpiresAt.ValueString(), cresp.ExpiresAt) {
+		data.ExpiresAt = optionalString(cresp.ExpiresAt)
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(data))...)
+	}
 }
 
 func (r *CloudSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"This resource does not support in-place updates. Any change to these attributes "+
-			"should have triggered a replacement. This is a provider bug.",
-	)
+	var data CloudSecretResourceModel
 
-	// var data CloudSecretResourceModel
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	// // Read Terraform plan data into the model
-	// resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
 
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	secretData, diags := secretPayload(ctx, req.Config, data.SecretData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the ttl forces a replacement, so only a changed expires_at ends up here
+	expiresAt, err := secretExpiry(types.StringNull(), data.ExpiresAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Expiry", err.Error())
+		return
+	}
+
+	// data, type and expiry are updated in a single statement on server side
+	cresp, err := client.UpdateCloudSecret(ctx, &pb.UpdateCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString(), SecretType: data.SecretType.ValueString(), SecretData: secretData, Namespace: data.Namespace.ValueString(), ExpiresAt: expiresAt})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make grp update cloud secret request, got error: %s", err))
+		return
+	}
+
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error on server side updating cloud secret, got error: %s", cresp.ErrMessage))
+		return
+	}
 
 	// Save updated data into Terraform state
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CloudSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -186,23 +330,18 @@ func (r *CloudSecretResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// init rpc client
-	conn, err := grpc.NewClient(
-		fmt.Sprintf("unix:///tmp/pc-rpc-%d.sock", os.Getpid()),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init grpc client, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
-	defer conn.Close()
-
-	client := pb.NewCloudServiceClient(conn)
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
-	defer cancel()
 
 	// perform the request
-	cresp, err := client.DeleteCloudSecret(ctx, &pb.DeleteCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString()})
+	cresp, err := client.DeleteCloudSecret(ctx, &pb.DeleteCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretName.ValueString(), Namespace: data.Namespace.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make grp delete cloud secret request, got error: %s", err))
 		return
@@ -215,6 +354,74 @@ func (r *CloudSecretResource) Delete(ctx context.Context, req resource.DeleteReq
 
 }
 
+// secretPayload returns the secret payload, either the regular attribute value or
+// the write-only one which is only available from the config.
+func secretPayload(ctx context.Context, config tfsdk.Config, value types.String) (string, diag.Diagnostics) {
+	if !value.IsNull() {
+		return value.ValueString(), nil
+	}
+
+	var valueWo types.String
+	diags := config.GetAttribute(ctx, path.Root("secret_data_wo"), &valueWo)
+	return valueWo.ValueString(), diags
+}
+
+// secretExpiry returns the normalized RFC3339 expiry of a secret, computed from
+// the ttl if set. Unknown or null values mean the secret never expires.
+func secretExpiry(ttl types.String, expiresAt types.String) (string, error) {
+	if !ttl.IsNull() && !ttl.IsUnknown() {
+		duration, err := time.ParseDuration(ttl.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("invalid ttl %s: %w", ttl.ValueString(), err)
+		}
+		return time.Now().UTC().Add(duration).Format(time.RFC3339), nil
+	}
+
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return "", nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("invalid expires_at %s, expected RFC3339: %w", expiresAt.ValueString(), err)
+	}
+	return parsed.UTC().Format(time.RFC3339), nil
+}
+
+// sameInstant compares two RFC3339 timestamps, the backend might format them differently.
+func sameInstant(a string, b string) bool {
+	aTime, aErr := time.Parse(time.RFC3339, a)
+	bTime, bErr := time.Parse(time.RFC3339, b)
+	if aErr != nil || bErr != nil {
+		return a == b
+	}
+	return aTime.Equal(bTime)
+}
+
 func (r *CloudSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// secrets in the shared namespace are imported by name only
+	namespace, secretName, found := strings.Cut(req.ID, "/")
+	if !found {
+		namespace, secretName = "", req.ID
+	}
+	if req.ID == "" {
+		var identity CloudSecretIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(checkIdentityTargetPve(identity.TargetPve, r.cloudInventory)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		namespace, secretName = identity.Namespace.ValueString(), identity.SecretName.ValueString()
+	}
+	if secretName == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format [<namespace>/]<secret_name>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret_name"), secretName)...)
+	if namespace != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	}
+	// an empty secret_data makes read take over the remote data
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret_data"), "")...)
 }
@@ -26,6 +26,7 @@ type CloudSecretsDataSource struct {
 type CloudSecretsDataSourceModel struct {
 	SecretType  types.String `tfsdk:"secret_type"`
 	SecretsData types.String `tfsdk:"secrets_data"`
+	Namespace   types.String `tfsdk:"namespace"`
 }
 
 func (d *CloudSecretsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,6 +38,10 @@ func (d *CloudSecretsDataSource) Schema(ctx context.Context, req datasource.Sche
 		MarkdownDescription: "Fetches a proxmox cloud secrets based on their type, scoped by target_pve, from the postgres px_cloud_secret table.",
 
 		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the secrets, e.g. the kubespray stack name. Uses the shared namespace if not specified.",
+				Optional:            true,
+			},
 			"secret_type": schema.StringAttribute{
 				MarkdownDescription: "Secrets of type to fetch.",
 				Required:            true,
@@ -78,13 +83,13 @@ func (d *CloudSecretsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
-	cresp, err := client.GetCloudSecrets(ctx, &pb.GetCloudSecretsRequest{CloudDomain: d.cloudInventory.CloudDomain, TargetPve: d.cloudInventory.TargetPve, SecretType: data.SecretType.ValueString()})
+	cresp, err := client.GetCloudSecrets(ctx, &pb.GetCloudSecretsRequest{CloudDomain: d.cloudInventory.CloudDomain, TargetPve: d.cloudInventory.TargetPve, SecretType: data.SecretType.ValueString(), Namespace: data.Namespace.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud secret, got error: %s", err))
 		return
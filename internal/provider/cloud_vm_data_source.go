@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudVmDataSource{}
+
+func NewCloudVmDataSource() datasource.DataSource {
+	return &CloudVmDataSource{}
+}
+
+// CloudVmDataSource defines the data source implementation.
+type CloudVmDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// CloudVmDataSourceModel describes the data source data model.
+type CloudVmDataSourceModel struct {
+	VmId        types.Int64   `tfsdk:"vmid"`
+	Name        types.String  `tfsdk:"name"`
+	BlakeId     types.String  `tfsdk:"blake_id"`
+	Node        types.String  `tfsdk:"node"`
+	GuestType   types.String  `tfsdk:"guest_type"`
+	Status      types.String  `tfsdk:"status"`
+	Tags        types.List    `tfsdk:"tags"`
+	IpAddresses types.List    `tfsdk:"ip_addresses"`
+	BlakeVars   types.Dynamic `tfsdk:"blake_vars"`
+}
+
+func (d *CloudVmDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_vm"
+}
+
+func (d *CloudVmDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a single vm or lxc container of the current target_pve by vmid, name or blake id.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				MarkdownDescription: "Id of the guest to look up.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("blake_id")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the guest to look up, has to be unique in the cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"blake_id": schema.StringAttribute{
+				MarkdownDescription: "Blake id of the guest to look up, the guest is tagged with `<blake_id>-blake`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Node the guest runs on.",
+				Computed:            true,
+			},
+			"guest_type": schema.StringAttribute{
+				MarkdownDescription: "qemu or lxc.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the guest, e.g. running or stopped.",
+				Computed:            true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags of the guest.",
+				Computed:            true,
+			},
+			"ip_addresses": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Global unicast addresses of a running guest, for qemu vms reported by the guest agent. Empty if the guest is stopped or the agent isn't available.",
+				Computed:            true,
+			},
+			"blake_vars": schema.DynamicAttribute{
+				MarkdownDescription: "Vm vars of the guest, null if it has no blake id or no vars.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CloudVmDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *CloudVmDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudVmDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var machines []map[string]interface{}
+	err = getPveApiJsonArgs(ctx, client, d.cloudInventory.TargetPve, "/cluster/resources", map[string]string{"--type": "vm"}, &machines)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guests, got error: %s", err))
+		return
+	}
+
+	matches := filterMachines(machines, func(machine map[string]interface{}) bool {
+		switch {
+		case !data.VmId.IsNull():
+			vmid, _ := machine["vmid"].(float64)
+			return int64(vmid) == data.VmId.ValueInt64()
+		case !data.Name.IsNull():
+			return machine["name"] == data.Name.ValueString()
+		default:
+			return blakeIdFromTags(machine) == data.BlakeId.ValueString()
+		}
+	})
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Guest not found", "No vm or lxc container matches the given vmid, name or blake_id.")
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError("Ambiguous guest", fmt.Sprintf("%d guests match the given name or blake_id, look the guest up by vmid instead.", len(matches)))
+		return
+	}
+
+	machine := matches[0]
+	vmid, _ := machine["vmid"].(float64)
+	name, _ := machine["name"].(string)
+	node, _ := machine["node"].(string)
+	guestType, _ := machine["type"].(string)
+	status, _ := machine["status"].(string)
+
+	data.VmId = types.Int64Value(int64(vmid))
+	data.Name = types.StringValue(name)
+	data.BlakeId = optionalString(blakeIdFromTags(machine))
+	data.Node = types.StringValue(node)
+	data.GuestType = types.StringValue(guestType)
+	data.Status = types.StringValue(status)
+
+	tags := []attr.Value{}
+	for _, tag := range machineTags(machine) {
+		tags = append(tags, types.StringValue(tag))
+	}
+	data.Tags = types.ListValueMust(types.StringType, tags)
+
+	addresses := []attr.Value{}
+	if status == "running" {
+		interfaces, err := getGuestInterfaces(ctx, client, d.cloudInventory.TargetPve, node, guestType, int64(vmid))
+		if err != nil {
+			// the guest agent is optional
+			resp.Diagnostics.AddWarning("Guest addresses unavailable", fmt.Sprintf("Unable to read the interfaces of %s, got error: %s", name, err))
+		}
+		for _, address := range guestIpAddresses(interfaces) {
+			addresses = append(addresses, types.StringValue(address))
+		}
+	}
+	data.IpAddresses = types.ListValueMust(types.StringType, addresses)
+
+	data.BlakeVars = types.DynamicNull()
+	if blakeId := blakeIdFromTags(machine); blakeId != "" {
+		blakeVars, err := fetchBlakeVars(ctx, client, d.cloudInventory, []string{blakeId})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make request for vm vars, got error: %s", err))
+			return
+		}
+		if vmVars, ok := blakeVars[blakeId]; ok {
+			data.BlakeVars = types.DynamicValue(jsonToAttrValue(vmVars))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
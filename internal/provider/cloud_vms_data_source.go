@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -26,7 +32,27 @@ type CloudVmsDataSource struct {
 
 // CloudVmsDataSourceModel describes the data source data model.
 type CloudVmsDataSourceModel struct {
-	CloudVmsJson types.String `tfsdk:"vms_json"`
+	CloudVmsJson     types.String   `tfsdk:"vms_json"`
+	Vms              []CloudVmModel `tfsdk:"vms"`
+	BlakeVars        types.Dynamic  `tfsdk:"blake_vars"`
+	IncludeBlakeVars types.Bool     `tfsdk:"include_blake_vars"`
+	IncludeLxc       types.Bool     `tfsdk:"include_lxc"`
+	IncludeStopped   types.Bool     `tfsdk:"include_stopped"`
+	Stack            types.String   `tfsdk:"stack"`
+	Node             types.String   `tfsdk:"node"`
+	Tag              types.String   `tfsdk:"tag"`
+	Status           types.String   `tfsdk:"status"`
+	NameRegex        types.String   `tfsdk:"name_regex"`
+}
+
+// CloudVmModel describes a single guest of the data source.
+type CloudVmModel struct {
+	VmId      types.Int64  `tfsdk:"vmid"`
+	GuestType types.String `tfsdk:"guest_type"`
+	Name      types.String `tfsdk:"name"`
+	Node      types.String `tfsdk:"node"`
+	Status    types.String `tfsdk:"status"`
+	Tags      []string     `tfsdk:"tags"`
 }
 
 func (d *CloudVmsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,14 +61,84 @@ func (d *CloudVmsDataSource) Metadata(ctx context.Context, req datasource.Metada
 
 func (d *CloudVmsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Returns all proxmox cloud vms on the current target_pve (proxmox cluster).",
+		MarkdownDescription: "Returns the proxmox cloud vms and lxc containers on the current target_pve (proxmox cluster), containers and stopped guests can be excluded.",
 
 		Attributes: map[string]schema.Attribute{
 			// todo: figure out terraforms absurd type system to avoid jsonencode and decode calls to pass / receive dynamic values
 			"vms_json": schema.StringAttribute{
-				MarkdownDescription: "Json list of cloud vm instances. Contains pvesh /cluster/resources output + merged in vm_vars based on blake ids.",
+				MarkdownDescription: "Json list of cloud vm instances. Contains pvesh /cluster/resources output + merged in vm_vars based on blake ids (unless include_blake_vars is false).",
+				Computed:            true,
+			},
+			"vms": schema.ListNestedAttribute{
+				MarkdownDescription: "The filtered vms, their vars are in blake_vars.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vmid": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Vmid of the guest.",
+						},
+						"guest_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the guest, qemu or lxc.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the guest.",
+						},
+						"node": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Proxmox node the guest runs on.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status of the guest, e.g. running or stopped.",
+						},
+						"tags": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Proxmox tags of the guest.",
+						},
+					},
+				},
+			},
+			// terraform lists can't hold the differently shaped vars, so they are keyed by vmid outside of vms
+			"blake_vars": schema.DynamicAttribute{
+				MarkdownDescription: "Object of the vm_vars of the filtered vms keyed by vmid, e.g. `lookup(blake_vars, tostring(vm.vmid), null)`. Vms without vars are left out, empty if include_blake_vars is false.",
 				Computed:            true,
 			},
+			"include_blake_vars": schema.BoolAttribute{
+				MarkdownDescription: "Whether to merge the vm_vars into each vm as blake_vars, if not specified defaults to true. Disable it if you only need the pvesh fields, this skips the expensive vars lookup.",
+				Optional:            true,
+			},
+			"include_lxc": schema.BoolAttribute{
+				MarkdownDescription: "Whether to also return lxc containers, if not specified defaults to true.",
+				Optional:            true,
+			},
+			"include_stopped": schema.BoolAttribute{
+				MarkdownDescription: "Whether to also return guests that aren't running, if not specified defaults to true. Ignored if status is set.",
+				Optional:            true,
+			},
+			"stack": schema.StringAttribute{
+				MarkdownDescription: "Only return vms of this stack, tagged with the stack name or with a matching stack_name in their blake_vars.",
+				Optional:            true,
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Only return vms on this node.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Only return vms with this tag.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return vms in this status, e.g. running or stopped.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return vms whose name matches this regular expression.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -75,7 +171,7 @@ func (d *CloudVmsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
@@ -97,54 +193,71 @@ func (d *CloudVmsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// extract blake ids for fetch call
-	var blakeIds []string
-	for _, machine := range machines {
-		if val, ok := machine["tags"]; ok {
-			if tagStr, isString := val.(string); isString {
+	var nameRe *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		nameRe, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_regex", err.Error())
+			return
+		}
+	}
 
-				tags := strings.Split(tagStr, ";")
+	// all guests unless excluded, like before the include arguments existed
+	includeLxc := data.IncludeLxc.IsNull() || data.IncludeLxc.ValueBool()
+	includeStopped := data.IncludeStopped.IsNull() || data.IncludeStopped.ValueBool()
 
-				for _, tag := range tags {
-					if strings.HasSuffix(tag, "-blake") {
-						blakeIds = append(blakeIds, strings.TrimSuffix(tag, "-blake"))
-						break
-					}
-				}
-			}
+	// filter before merging, to only fetch the vars of returned vms
+	machines = filterMachines(machines, func(machine map[string]interface{}) bool {
+		name, _ := machine["name"].(string)
+		return (includeLxc || machine["type"] != "lxc") &&
+			(includeStopped || !data.Status.IsNull() || machine["status"] == "running") &&
+			(data.Node.IsNull() || machine["node"] == data.Node.ValueString()) &&
+			(data.Status.IsNull() || machine["status"] == data.Status.ValueString()) &&
+			(data.Tag.IsNull() || slices.Contains(machineTags(machine), data.Tag.ValueString())) &&
+			(nameRe == nil || nameRe.MatchString(name))
+	})
+
+	// merging is optional since the vars lookup is expensive
+	if data.IncludeBlakeVars.IsNull() || data.IncludeBlakeVars.ValueBool() {
+		err = mergeBlakeVars(ctx, client, d.cloudInventory, machines)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make request for vm vars, got error: %s", err))
+			return
 		}
 	}
 
-	vcresp, err := client.GetVmVarsBlake(ctx, &pb.GetVmVarsBlakeRequest{BlakeIds: blakeIds, TargetPve: d.cloudInventory.TargetPve, CloudDomain: d.cloudInventory.CloudDomain})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make request for vm vars, got error: %s", err))
-		return
+	if !data.Stack.IsNull() {
+		stack := data.Stack.ValueString()
+		machines = filterMachines(machines, func(machine map[string]interface{}) bool {
+			blakeVars, _ := machine["blake_vars"].(map[string]interface{})
+			return slices.Contains(machineTags(machine), stack) || blakeVars["stack_name"] == stack
+		})
 	}
 
-	// iterate again and add vars
+	data.Vms = []CloudVmModel{}
+	blakeVars := map[string]interface{}{}
 	for _, machine := range machines {
-		if val, ok := machine["tags"]; ok {
-			if tagStr, isString := val.(string); isString {
-
-				tags := strings.Split(tagStr, ";")
-
-				for _, tag := range tags {
-					if strings.HasSuffix(tag, "-blake") {
-						// found blake id
-						if vmVars, ok := vcresp.BlakeIdVars[strings.TrimSuffix(tag, "-blake")]; ok {
-							// found vm vars => decode json and inject
-							decoder := json.NewDecoder(strings.NewReader(vmVars))
-
-							var blakeVars map[string]interface{}
-							decoder.Decode(&blakeVars)
-							machine["blake_vars"] = blakeVars
-						}
-						break
-					}
-				}
-			}
+		vmId, _ := machine["vmid"].(float64)
+		guestType, _ := machine["type"].(string)
+		name, _ := machine["name"].(string)
+		node, _ := machine["node"].(string)
+		status, _ := machine["status"].(string)
+
+		data.Vms = append(data.Vms, CloudVmModel{
+			VmId:      types.Int64Value(int64(vmId)),
+			GuestType: types.StringValue(guestType),
+			Name:      types.StringValue(name),
+			Node:      types.StringValue(node),
+			Status:    types.StringValue(status),
+			Tags:      append([]string{}, machineTags(machine)...),
+		})
+
+		if vmVars, ok := machine["blake_vars"]; ok {
+			blakeVars[strconv.FormatInt(int64(vmId), 10)] = vmVars
 		}
 	}
+	data.BlakeVars = types.DynamicValue(jsonToAttrValue(blakeVars))
+
 	mBytes, err := json.Marshal(machines)
 	if err != nil {
 		resp.Diagnostics.AddError("Marshal error", fmt.Sprintf("Error marshalling modified vms pve api response back into json, got error: %s", err))
@@ -156,3 +269,148 @@ func (d *CloudVmsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// vm vars are fetched in batches over parallel streams, a single response for
+// hundreds of vms is slow and can exceed the grpc message size
+const (
+	vmVarsBatchSize = 200
+	vmVarsWorkers   = 4
+)
+
+// machineTags splits the semicolon separated tags of a pvesh vm.
+func machineTags(machine map[string]interface{}) []string {
+	tagStr, ok := machine["tags"].(string)
+	if !ok || tagStr == "" {
+		return nil
+	}
+	return strings.Split(tagStr, ";")
+}
+
+// filterMachines returns the machines keep returns true for.
+func filterMachines(machines []map[string]interface{}, keep func(map[string]interface{}) bool) []map[string]interface{} {
+	filtered := []map[string]interface{}{}
+	for _, machine := range machines {
+		if keep(machine) {
+			filtered = append(filtered, machine)
+		}
+	}
+	return filtered
+}
+
+// blakeIdFromTags returns the blake id from the <id>-blake tag of a vm, empty if untagged.
+func blakeIdFromTags(machine map[string]interface{}) string {
+	for _, tag := range machineTags(machine) {
+		if strings.HasSuffix(tag, "-blake") {
+			return strings.TrimSuffix(tag, "-blake")
+		}
+	}
+	return ""
+}
+
+// mergeBlakeVars fetches the vm vars for all machines tagged with a blake id and injects them as blake_vars.
+func mergeBlakeVars(ctx context.Context, client pb.CloudServiceClient, cloudInv CloudInventory, machines []map[string]interface{}) error {
+	// extract blake ids for fetch call
+	var blakeIds []string
+	seen := map[string]bool{}
+	for _, machine := range machines {
+		if blakeId := blakeIdFromTags(machine); blakeId != "" && !seen[blakeId] {
+			seen[blakeId] = true
+			blakeIds = append(blakeIds, blakeId)
+		}
+	}
+
+	blakeVars, err := fetchBlakeVars(ctx, client, cloudInv, blakeIds)
+	if err != nil {
+		return err
+	}
+
+	// iterate again and add vars
+	for _, machine := range machines {
+		if vmVars, ok := blakeVars[blakeIdFromTags(machine)]; ok {
+			machine["blake_vars"] = vmVars
+		}
+	}
+
+	return nil
+}
+
+// fetchBlakeVars streams the vm vars of blakeIds in parallel batches, decoding them as they arrive.
+func fetchBlakeVars(ctx context.Context, client pb.CloudServiceClient, cloudInv CloudInventory, blakeIds []string) (map[string]map[string]interface{}, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	batches := make(chan []string)
+	go func() {
+		defer close(batches)
+		for start := 0; start < len(blakeIds); start += vmVarsBatchSize {
+			select {
+			case batches <- blakeIds[start:min(start+vmVarsBatchSize, len(blakeIds))]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	var firstErr error
+	blakeVars := map[string]map[string]interface{}{}
+
+	var wg sync.WaitGroup
+	for range vmVarsWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				err := streamBlakeVars(ctx, client, cloudInv, batch, func(blakeId string, vmVars map[string]interface{}) {
+					mu.Lock()
+					defer mu.Unlock()
+					blakeVars[blakeId] = vmVars
+				})
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return blakeVars, firstErr
+}
+
+// streamBlakeVars fetches a single batch, passing each decoded entry to add.
+func streamBlakeVars(ctx context.Context, client pb.CloudServiceClient, cloudInv CloudInventory, blakeIds []string, add func(string, map[string]interface{})) error {
+	// streams bypass the unary interceptor, apply its default timeout here
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, rpcCallTimeout)
+		defer cancel()
+	}
+
+	stream, err := client.StreamVmVarsBlake(ctx, &pb.GetVmVarsBlakeRequest{BlakeIds: blakeIds, TargetPve: cloudInv.TargetPve, CloudDomain: cloudInv.CloudDomain})
+	if err != nil {
+		return &rpcError{Code: classifyRpcError(err, nil), Method: "StreamVmVarsBlake", Err: err}
+	}
+
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return &rpcError{Code: classifyRpcError(err, stream.Trailer()), Method: "StreamVmVarsBlake", Err: err}
+		}
+
+		var vmVars map[string]interface{}
+		if err := json.Unmarshal([]byte(entry.Vars), &vmVars); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping invalid vm vars of %s: %s", entry.BlakeId, err))
+			continue
+		}
+		add(entry.BlakeId, vmVars)
+	}
+}
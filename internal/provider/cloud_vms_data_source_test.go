@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCloudVmsDataSourceSchema(t *testing.T) {
+	var resp datasource.SchemaResponse
+	(&CloudVmsDataSource{}).Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+
+	if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+		t.Fatalf("ValidateImplementation() diagnostics: %v", diags)
+	}
+}
+
+func TestCloudVmsDataSourceIncludeBlakeVars(t *testing.T) {
+	tests := []struct {
+		name             string
+		includeBlakeVars tftypes.Value
+		wantLookup       bool
+		wantBlakeVars    map[string]interface{}
+	}{
+		{name: "default", includeBlakeVars: tftypes.NewValue(tftypes.Bool, nil), wantLookup: true, wantBlakeVars: map[string]interface{}{"stack_name": "k8s"}},
+		{name: "enabled", includeBlakeVars: tftypes.NewValue(tftypes.Bool, true), wantLookup: true, wantBlakeVars: map[string]interface{}{"stack_name": "k8s"}},
+		{name: "disabled", includeBlakeVars: tftypes.NewValue(tftypes.Bool, false), wantLookup: false, wantBlakeVars: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeRpcConn{
+				handlers: map[string]func(proto.Message) (proto.Message, error){
+					"GetProxmoxApi": func(req proto.Message) (proto.Message, error) {
+						return &pb.GetProxmoxApiResponse{JsonResp: `[{"vmid":100,"type":"qemu","name":"master-0","node":"pve1","status":"running","tags":"abc-blake;k8s"}]`}, nil
+					},
+				},
+				streams: map[string]func(proto.Message) ([]proto.Message, error){
+					"StreamVmVarsBlake": func(req proto.Message) ([]proto.Message, error) {
+						return []proto.Message{&pb.VmVarsBlakeEntry{BlakeId: "abc", Vars: `{"stack_name":"k8s"}`}}, nil
+					},
+				},
+			}
+
+			d := &CloudVmsDataSource{cloudInventory: testInventory(conn)}
+			state := readDataSource(t, d, map[string]tftypes.Value{"include_blake_vars": tt.includeBlakeVars})
+
+			var data CloudVmsDataSourceModel
+			if diags := state.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("State.Get() diagnostics: %v", diags)
+			}
+
+			if lookups := len(conn.called("StreamVmVarsBlake")); (lookups > 0) != tt.wantLookup {
+				t.Errorf("vars looked up %d times, want lookup %t", lookups, tt.wantLookup)
+			}
+
+			var machines []map[string]interface{}
+			if err := json.Unmarshal([]byte(data.CloudVmsJson.ValueString()), &machines); err != nil {
+				t.Fatalf("invalid vms_json: %s", err)
+			}
+			if len(machines) != 1 {
+				t.Fatalf("got %d vms, want 1", len(machines))
+			}
+
+			blakeVars, _ := machines[0]["blake_vars"].(map[string]interface{})
+			if (blakeVars == nil) != (tt.wantBlakeVars == nil) || (blakeVars != nil && blakeVars["stack_name"] != tt.wantBlakeVars["stack_name"]) {
+				t.Errorf("blake_vars = %v, want %v", blakeVars, tt.wantBlakeVars)
+			}
+
+			// the typed list and the vars keyed by vmid carry the same data
+			if len(data.Vms) != 1 || data.Vms[0].VmId.ValueInt64() != 100 || data.Vms[0].Name.ValueString() != "master-0" || !slices.Equal(data.Vms[0].Tags, []string{"abc-blake", "k8s"}) {
+				t.Errorf("vms = %+v", data.Vms)
+			}
+			vars, ok := data.BlakeVars.UnderlyingValue().(types.Object)
+			if !ok {
+				t.Fatalf("blake_vars is a %T, want an object", data.BlakeVars.UnderlyingValue())
+			}
+			if _, found := vars.Attributes()["100"]; found != (tt.wantBlakeVars != nil) {
+				t.Errorf("blake_vars = %s, want vars of 100 %t", vars, tt.wantBlakeVars != nil)
+			}
+		})
+	}
+}
+
+func TestCloudVmsDataSourceIncludeGuests(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    map[string]tftypes.Value
+		wantVmIds []float64
+	}{
+		{name: "default", config: map[string]tftypes.Value{}, wantVmIds: []float64{100, 101, 200}},
+		{name: "without lxc", config: map[string]tftypes.Value{"include_lxc": tftypes.NewValue(tftypes.Bool, false)}, wantVmIds: []float64{100, 101}},
+		{name: "without stopped", config: map[string]tftypes.Value{"include_stopped": tftypes.NewValue(tftypes.Bool, false)}, wantVmIds: []float64{100, 200}},
+		{
+			name: "status overrides include_stopped",
+			config: map[string]tftypes.Value{
+				"include_stopped": tftypes.NewValue(tftypes.Bool, false),
+				"status":          tftypes.NewValue(tftypes.String, "stopped"),
+			},
+			wantVmIds: []float64{101},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := fakePveApi(map[string]string{"/cluster/resources": `[
+				{"vmid":100,"type":"qemu","name":"web-0","node":"pve1","status":"running"},
+				{"vmid":101,"type":"qemu","name":"web-1","node":"pve1","status":"stopped"},
+				{"vmid":200,"type":"lxc","name":"dns-0","node":"pve2","status":"running"}
+			]`})
+
+			tt.config["include_blake_vars"] = tftypes.NewValue(tftypes.Bool, false)
+			d := &CloudVmsDataSource{cloudInventory: testInventory(conn)}
+			state := readDataSource(t, d, tt.config)
+
+			var data CloudVmsDataSourceModel
+			if diags := state.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("State.Get() diagnostics: %v", diags)
+			}
+
+			var machines []map[string]interface{}
+			if err := json.Unmarshal([]byte(data.CloudVmsJson.ValueString()), &machines); err != nil {
+				t.Fatalf("invalid vms_json: %s", err)
+			}
+			vmIds := []float64{}
+			for _, machine := range machines {
+				vmIds = append(vmIds, machine["vmid"].(float64))
+			}
+			if !slices.Equal(vmIds, tt.wantVmIds) {
+				t.Errorf("vmids = %v, want %v", vmIds, tt.wantVmIds)
+			}
+		})
+	}
+}
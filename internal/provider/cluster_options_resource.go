@@ -0,0 +1,544 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterOptionsResource{}
+
+func NewClusterOptionsResource() resource.Resource {
+	return &ClusterOptionsResource{}
+}
+
+// ClusterOptionsResource defines the resource implementation.
+type ClusterOptionsResource struct {
+	cloudInventory CloudInventory
+}
+
+// ClusterOptionsResourceModel describes the resource data model.
+type ClusterOptionsResourceModel struct {
+	Migration      *ClusterMigrationModel     `tfsdk:"migration"`
+	Bwlimit        *ClusterBwlimitModel       `tfsdk:"bwlimit"`
+	Console        types.String               `tfsdk:"console"`
+	EmailFrom      types.String               `tfsdk:"email_from"`
+	TagStyle       *ClusterTagStyleModel      `tfsdk:"tag_style"`
+	UserTagAccess  *ClusterUserTagAccessModel `tfsdk:"user_tag_access"`
+	RegisteredTags []string                   `tfsdk:"registered_tags"`
+	NextId         *ClusterNextIdModel        `tfsdk:"next_id"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// ClusterMigrationModel describes the migration option.
+type ClusterMigrationModel struct {
+	Type    types.String `tfsdk:"type"`
+	Network types.String `tfsdk:"network"`
+}
+
+// ClusterBwlimitModel describes the bwlimit option, limits are in KiB/s.
+type ClusterBwlimitModel struct {
+	Default   types.Int64 `tfsdk:"default"`
+	Clone     types.Int64 `tfsdk:"clone"`
+	Migration types.Int64 `tfsdk:"migration"`
+	Move      types.Int64 `tfsdk:"move"`
+	Restore   types.Int64 `tfsdk:"restore"`
+}
+
+// ClusterTagStyleModel describes the tag-style option.
+type ClusterTagStyleModel struct {
+	Shape         types.String `tfsdk:"shape"`
+	Ordering      types.String `tfsdk:"ordering"`
+	CaseSensitive types.Bool   `tfsdk:"case_sensitive"`
+}
+
+// ClusterUserTagAccessModel describes the user-tag-access option.
+type ClusterUserTagAccessModel struct {
+	UserAllow     types.String `tfsdk:"user_allow"`
+	UserAllowList []string     `tfsdk:"user_allow_list"`
+}
+
+// ClusterNextIdModel describes the next-id option, the range free vmids are picked from.
+type ClusterNextIdModel struct {
+	Lower types.Int64 `tfsdk:"lower"`
+	Upper types.Int64 `tfsdk:"upper"`
+}
+
+// bwlimit keys in the order of the bwlimit attributes
+var clusterBwlimitKeys = []string{"default", "clone", "migration", "move", "restore"}
+
+func (r *ClusterOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_options"
+}
+
+func (r *ClusterOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages datacenter wide options (/cluster/options). Only the options set here are touched, " +
+			"all others keep their current value. Destroying the resource resets the managed options to the proxmox defaults. " +
+			"There should only be one instance per cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"migration": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Network and encryption used for migrations.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Migration traffic encryption, secure (ssh tunnel) or insecure.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("secure", "insecure"),
+						},
+					},
+					"network": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "CIDR of the network used for migrations, e.g. `10.0.0.0/24`.",
+					},
+				},
+			},
+			"bwlimit": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Bandwidth limits of io operations in KiB/s.",
+				Attributes: map[string]schema.Attribute{
+					"default":   bwlimitAttribute("Default limit of all operations."),
+					"clone":     bwlimitAttribute("Limit for cloning disks."),
+					"migration": bwlimitAttribute("Limit for migrating guests."),
+					"move":      bwlimitAttribute("Limit for moving disks."),
+					"restore":   bwlimitAttribute("Limit for restoring backups."),
+				},
+			},
+			"console": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Default console viewer, one of applet, vv, html5 or xtermjs.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("applet", "vv", "html5", "xtermjs"),
+				},
+			},
+			"email_from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sender address of notification mails.",
+			},
+			"tag_style": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Display of guest tags in the web interface.",
+				Attributes: map[string]schema.Attribute{
+					"shape": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Tag shape, one of full, circle, dense or none.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("full", "circle", "dense", "none"),
+						},
+					},
+					"ordering": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Tag order, config or alphabetical.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("config", "alphabetical"),
+						},
+					},
+					"case_sensitive": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether tags are sorted and filtered case sensitive.",
+					},
+				},
+			},
+			"user_tag_access": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Tags users may set on guests they have access to.",
+				Attributes: map[string]schema.Attribute{
+					"user_allow": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "One of none, list (only user_allow_list), existing (list and tags in use) or free.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "list", "existing", "free"),
+						},
+					},
+					"user_allow_list": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "Tags users may always set.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.RegexMatches(pveTagRe, "must be a valid proxmox tag")),
+						},
+					},
+				},
+			},
+			"registered_tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Tags reserved for users with Sys.Modify on /, e.g. the tags managed by the stack.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(pveTagRe, "must be a valid proxmox tag")),
+				},
+			},
+			"next_id": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Range the next free vmid is picked from.",
+				Attributes: map[string]schema.Attribute{
+					"lower": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Lower bound, inclusive.",
+						Validators: []validator.Int64{
+							int64validator.Between(100, 999999999),
+						},
+					},
+					"upper": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Upper bound, exclusive.",
+						Validators: []validator.Int64{
+							int64validator.Between(100, 1000000000),
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func bwlimitAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+}
+
+func (r *ClusterOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// optionArgs returns the property strings of the managed options, keyed by option name.
+func (data ClusterOptionsResourceModel) optionArgs() map[string]string {
+	options := map[string]string{}
+
+	if data.Migration != nil {
+		parts := []string{"type=" + data.Migration.Type.ValueString()}
+		if !data.Migration.Network.IsNull() {
+			parts = append(parts, "network="+data.Migration.Network.ValueString())
+		}
+		options["migration"] = strings.Join(parts, ",")
+	}
+	if data.Bwlimit != nil {
+		values := []types.Int64{data.Bwlimit.Default, data.Bwlimit.Clone, data.Bwlimit.Migration, data.Bwlimit.Move, data.Bwlimit.Restore}
+		parts := []string{}
+		for i, value := range values {
+			if !value.IsNull() {
+				parts = append(parts, fmt.Sprintf("%s=%d", clusterBwlimitKeys[i], value.ValueInt64()))
+			}
+		}
+		options["bwlimit"] = strings.Join(parts, ",")
+	}
+	if !data.Console.IsNull() {
+		options["console"] = data.Console.ValueString()
+	}
+	if !data.EmailFrom.IsNull() {
+		options["email_from"] = data.EmailFrom.ValueString()
+	}
+	if data.TagStyle != nil {
+		parts := []string{}
+		if !data.TagStyle.Shape.IsNull() {
+			parts = append(parts, "shape="+data.TagStyle.Shape.ValueString())
+		}
+		if !data.TagStyle.Ordering.IsNull() {
+			parts = append(parts, "ordering="+data.TagStyle.Ordering.ValueString())
+		}
+		if !data.TagStyle.CaseSensitive.IsNull() {
+			parts = append(parts, "case-sensitive="+boolToPve(data.TagStyle.CaseSensitive.ValueBool()))
+		}
+		options["tag-style"] = strings.Join(parts, ",")
+	}
+	if data.UserTagAccess != nil {
+		parts := []string{"user-allow=" + data.UserTagAccess.UserAllow.ValueString()}
+		if len(data.UserTagAccess.UserAllowList) > 0 {
+			parts = append(parts, "user-allow-list="+strings.Join(sortedStrings(data.UserTagAccess.UserAllowList), ";"))
+		}
+		options["user-tag-access"] = strings.Join(parts, ",")
+	}
+	if data.RegisteredTags != nil {
+		options["registered-tags"] = strings.Join(sortedStrings(data.RegisteredTags), ";")
+	}
+	if data.NextId != nil {
+		parts := []string{}
+		if !data.NextId.Lower.IsNull() {
+			parts = append(parts, fmt.Sprintf("lower=%d", data.NextId.Lower.ValueInt64()))
+		}
+		if !data.NextId.Upper.IsNull() {
+			parts = append(parts, fmt.Sprintf("upper=%d", data.NextId.Upper.ValueInt64()))
+		}
+		options["next-id"] = strings.Join(parts, ",")
+	}
+
+	// empty property strings are unset options
+	for key, value := range options {
+		if value == "" {
+			delete(options, key)
+		}
+	}
+	return options
+}
+
+// setOptions sets the options and deletes the ones given in deletes.
+func (r *ClusterOptionsResource) setOptions(ctx context.Context, options map[string]string, deletes []string) error {
+	if len(options) == 0 && len(deletes) == 0 {
+		return nil
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return fmt.Errorf("unable to init client, got error: %s", err)
+	}
+
+	setArgs := map[string]string{}
+	for key, value := range options {
+		setArgs["--"+key] = value
+	}
+	if len(deletes) > 0 {
+		slices.Sort(deletes)
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	return pveApiSet(ctx, client, r.cloudInventory.TargetPve, "/cluster/options", setArgs)
+}
+
+// propInt64 reads an integer property, null if unset.
+func propInt64(props map[string]string, key string) types.Int64 {
+	value, err := strconv.ParseInt(props[key], 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+// splitPveTags splits a semicolon separated tag list of the options, nil if empty.
+func splitPveTags(raw json.RawMessage) []string {
+	var tags []string
+	for _, value := range pveStringList(raw) {
+		for _, tag := range strings.Split(value, ";") {
+			if tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
+
+// readOptions refreshes the managed options, options not managed by the config stay null.
+func (data *ClusterOptionsResourceModel) readOptions(options map[string]json.RawMessage) {
+	if data.Migration != nil {
+		props := parsePveObject(options["migration"])
+		data.Migration = nil
+		if len(props) > 0 {
+			data.Migration = &ClusterMigrationModel{
+				Type:    optionalString(props["type"]),
+				Network: optionalString(props["network"]),
+			}
+		}
+	}
+	if data.Bwlimit != nil {
+		props := parsePveObject(options["bwlimit"])
+		data.Bwlimit = nil
+		if len(props) > 0 {
+			data.Bwlimit = &ClusterBwlimitModel{
+				Default:   propInt64(props, "default"),
+				Clone:     propInt64(props, "clone"),
+				Migration: propInt64(props, "migration"),
+				Move:      propInt64(props, "move"),
+				Restore:   propInt64(props, "restore"),
+			}
+		}
+	}
+	if !data.Console.IsNull() {
+		var console string
+		_ = json.Unmarshal(options["console"], &console)
+		data.Console = optionalString(console)
+	}
+	if !data.EmailFrom.IsNull() {
+		var emailFrom string
+		_ = json.Unmarshal(options["email_from"], &emailFrom)
+		data.EmailFrom = optionalString(emailFrom)
+	}
+	if data.TagStyle != nil {
+		props := parsePveObject(options["tag-style"])
+		data.TagStyle = nil
+		if len(props) > 0 {
+			data.TagStyle = &ClusterTagStyleModel{
+				Shape:         optionalString(props["shape"]),
+				Ordering:      optionalString(props["ordering"]),
+				CaseSensitive: types.BoolNull(),
+			}
+			if caseSensitive, ok := props["case-sensitive"]; ok {
+				data.TagStyle.CaseSensitive = types.BoolValue(caseSensitive == "1" || caseSensitive == "true")
+			}
+		}
+	}
+	if data.UserTagAccess != nil {
+		props := parsePveObject(options["user-tag-access"])
+		data.UserTagAccess = nil
+		if len(props) > 0 {
+			data.UserTagAccess = &ClusterUserTagAccessModel{
+				UserAllow: optionalString(props["user-allow"]),
+			}
+			if allowList := splitTags(props["user-allow-list"]); len(allowList) > 0 {
+				data.UserTagAccess.UserAllowList = allowList
+			}
+		}
+	}
+	if data.RegisteredTags != nil {
+		data.RegisteredTags = splitPveTags(options["registered-tags"])
+	}
+	if data.NextId != nil {
+		props := parsePveObject(options["next-id"])
+		data.NextId = nil
+		if len(props) > 0 {
+			data.NextId = &ClusterNextIdModel{
+				Lower: propInt64(props, "lower"),
+				Upper: propInt64(props, "upper"),
+			}
+		}
+	}
+}
+
+func (r *ClusterOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterOptionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	if err := r.setOptions(ctx, data.optionArgs(), nil); err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error setting cluster options, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var options map[string]json.RawMessage
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/options", &options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster options, got error: %s", err))
+		return
+	}
+
+	data.readOptions(options)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ClusterOptionsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	// options removed from the config are reset, unmanaged ones are left alone
+	options := data.optionArgs()
+	deletes := []string{}
+	for key := range state.optionArgs() {
+		if _, ok := options[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+
+	if err := r.setOptions(ctx, options, deletes); err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error setting cluster options, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	deletes := []string{}
+	for key := range data.optionArgs() {
+		deletes = append(deletes, key)
+	}
+
+	if err := r.setOptions(ctx, nil, deletes); err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error resetting cluster options, got error: %s", err))
+		return
+	}
+}
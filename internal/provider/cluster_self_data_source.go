@@ -100,7 +100,7 @@ func (d *CloudSelfDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
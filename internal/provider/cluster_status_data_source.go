@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterStatusDataSource{}
+
+func NewClusterStatusDataSource() datasource.DataSource {
+	return &ClusterStatusDataSource{}
+}
+
+// ClusterStatusDataSource defines the data source implementation.
+type ClusterStatusDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// ClusterStatusDataSourceModel describes the data source data model.
+type ClusterStatusDataSourceModel struct {
+	Name          types.String             `tfsdk:"name"`
+	Quorate       types.Bool               `tfsdk:"quorate"`
+	ConfigVersion types.Int64              `tfsdk:"config_version"`
+	PveVersion    types.String             `tfsdk:"pve_version"`
+	NodesOnline   types.Int64              `tfsdk:"nodes_online"`
+	Nodes         []ClusterStatusNodeModel `tfsdk:"nodes"`
+}
+
+// ClusterStatusNodeModel describes the membership of a single node.
+type ClusterStatusNodeModel struct {
+	Name   types.String `tfsdk:"name"`
+	NodeId types.Int64  `tfsdk:"nodeid"`
+	Ip     types.String `tfsdk:"ip"`
+	Online types.Bool   `tfsdk:"online"`
+	Local  types.Bool   `tfsdk:"local"`
+}
+
+// pveClusterStatus is an entry of /cluster/status, the type is either cluster or node.
+// Standalone nodes only return their node entry.
+type pveClusterStatus struct {
+	Type    string  `json:"type"`
+	Name    string  `json:"name"`
+	Ip      string  `json:"ip"`
+	Online  pveBool `json:"online"`
+	Local   pveBool `json:"local"`
+	NodeId  int64   `json:"nodeid"`
+	Quorate pveBool `json:"quorate"`
+	Version int64   `json:"version"`
+}
+
+func (d *ClusterStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_status"
+}
+
+func (d *ClusterStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the quorum and node membership of the target_pve cluster from `/cluster/status`, " +
+			"e.g. to assert in check blocks that the cluster is quorate and all nodes are online before changes are applied.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the corosync cluster, null for standalone nodes.",
+			},
+			"quorate": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "If the cluster has quorum, standalone nodes are always quorate.",
+			},
+			"config_version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Version of the corosync config, null for standalone nodes.",
+			},
+			"pve_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Proxmox VE version of the node answering the api call, e.g. `8.2.4`.",
+			},
+			"nodes_online": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of online nodes.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Members of the cluster sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the node.",
+						},
+						"nodeid": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Corosync node id, 0 for standalone nodes.",
+						},
+						"ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Cluster address of the node.",
+						},
+						"online": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "If the node is online.",
+						},
+						"local": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "If the node answered the api call.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *ClusterStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var status []pveClusterStatus
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, "/cluster/status", &status)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cluster status, got error: %s", err))
+		return
+	}
+
+	var version struct {
+		Version string `json:"version"`
+	}
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, "/version", &version)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get pve version, got error: %s", err))
+		return
+	}
+	data.PveVersion = types.StringValue(version.Version)
+
+	slices.SortFunc(status, func(a, b pveClusterStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// defaults for standalone nodes without cluster entry
+	data.Name = types.StringNull()
+	data.Quorate = types.BoolValue(true)
+	data.ConfigVersion = types.Int64Null()
+
+	online := int64(0)
+	data.Nodes = []ClusterStatusNodeModel{}
+	for _, entry := range status {
+		switch entry.Type {
+		case "cluster":
+			data.Name = types.StringValue(entry.Name)
+			data.Quorate = types.BoolValue(bool(entry.Quorate))
+			data.ConfigVersion = types.Int64Value(entry.Version)
+		case "node":
+			if entry.Online {
+				online++
+			}
+			data.Nodes = append(data.Nodes, ClusterStatusNodeModel{
+				Name:   types.StringValue(entry.Name),
+				NodeId: types.Int64Value(entry.NodeId),
+				Ip:     optionalString(entry.Ip),
+				Online: types.BoolValue(bool(entry.Online)),
+				Local:  types.BoolValue(bool(entry.Local)),
+			})
+		}
+	}
+	data.NodesOnline = types.Int64Value(online)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
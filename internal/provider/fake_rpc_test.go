@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"path"
+	"sync"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeRpcConn serves the rpcs of a test from handlers keyed by method name, unknown
+// methods fail with codes.Unimplemented. Calls are recorded in order.
+type fakeRpcConn struct {
+	handlers map[string]func(req proto.Message) (proto.Message, error)
+	// responses of server streaming methods, keyed by method name
+	streams map[string]func(req proto.Message) ([]proto.Message, error)
+
+	mu    sync.Mutex
+	calls []fakeRpcCall
+}
+
+type fakeRpcCall struct {
+	Method string
+	Req    proto.Message
+}
+
+func (c *fakeRpcConn) record(method string, req any) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name := path.Base(method)
+	c.calls = append(c.calls, fakeRpcCall{Method: name, Req: proto.Clone(req.(proto.Message))})
+	return name
+}
+
+// called returns the requests made to method.
+func (c *fakeRpcConn) called(method string) []proto.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	reqs := []proto.Message{}
+	for _, call := range c.calls {
+		if call.Method == method {
+			reqs = append(reqs, call.Req)
+		}
+	}
+	return reqs
+}
+
+func (c *fakeRpcConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	name := c.record(method, args)
+	handler, ok := c.handlers[name]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "%s not faked", name)
+	}
+
+	resp, err := handler(args.(proto.Message))
+	if err != nil {
+		// classified like by the interceptor of real connections
+		return &rpcError{Code: classifyRpcError(err, nil), Method: name, Err: err}
+	}
+	proto.Merge(reply.(proto.Message), resp)
+	return nil
+}
+
+func (c *fakeRpcConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return &fakeClientStream{ctx: ctx, conn: c, method: method}, nil
+}
+
+// fakeClientStream replays the responses of a server streaming method once the request was sent.
+type fakeClientStream struct {
+	ctx       context.Context
+	conn      *fakeRpcConn
+	method    string
+	responses []proto.Message
+	err       error
+}
+
+func (s *fakeClientStream) SendMsg(m any) error {
+	name := s.conn.record(s.method, m)
+	handler, ok := s.conn.streams[name]
+	if !ok {
+		s.err = status.Errorf(codes.Unimplemented, "%s not faked", name)
+		return nil
+	}
+	s.responses, s.err = handler(m.(proto.Message))
+	return nil
+}
+
+func (s *fakeClientStream) RecvMsg(m any) error {
+	if s.err != nil {
+		return s.err
+	}
+	if len(s.responses) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.responses[0])
+	s.responses = s.responses[1:]
+	return nil
+}
+
+func (s *fakeClientStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *fakeClientStream) Trailer() metadata.MD         { return nil }
+func (s *fakeClientStream) CloseSend() error             { return nil }
+func (s *fakeClientStream) Context() context.Context     { return s.ctx }
+
+// fakePveApi serves GetProxmoxApi from json responses keyed by api path, other paths fail with codes.NotFound.
+func fakePveApi(responses map[string]string) *fakeRpcConn {
+	return &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+		"GetProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			apiPath := req.(*pb.GetProxmoxApiRequest).ApiPath
+			jsonResp, ok := responses[apiPath]
+			if !ok {
+				return nil, status.Errorf(codes.NotFound, "%s does not exist", apiPath)
+			}
+			return &pb.GetProxmoxApiResponse{JsonResp: jsonResp}, nil
+		},
+	}}
+}
+
+// testInventory returns a cloud inventory whose rpcs are served by conn.
+func testInventory(conn *fakeRpcConn) CloudInventory {
+	return CloudInventory{TargetPve: "pve.example.com", CloudDomain: "example.com", RpcConn: conn}
+}
+
+// testObject builds a value of the object type typ, attributes missing in values are null.
+func testObject(t *testing.T, typ attr.Type, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objType, ok := typ.TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("%s is not an object type", typ)
+	}
+
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range objType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name := range values {
+		if _, ok := objType.AttributeTypes[name]; !ok {
+			t.Fatalf("unknown attribute %s", name)
+		}
+	}
+
+	return tftypes.NewValue(objType, attrs)
+}
+
+// readDataSource runs Read of the configured data source d with config, failing the test on errors.
+func readDataSource(t *testing.T, d datasource.DataSource, config map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type()
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testObject(t, typ, config)}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ.TerraformType(ctx), nil)}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
+
+// readResource runs Read of the configured resource r with the prior state, failing the test on errors.
+// The response state is null if the resource was removed.
+func readResource(t *testing.T, r resource.Resource, state map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	prior := tfsdk.State{Schema: schemaResp.Schema, Raw: testObject(t, schemaResp.Schema.Type(), state)}
+
+	resp := resource.ReadResponse{State: prior}
+	r.Read(ctx, resource.ReadRequest{State: prior}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
+
+// createResource runs Create of the configured resource r with plan, failing the test on errors.
+func createResource(t *testing.T, r resource.Resource, plan map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	raw := testObject(t, schemaResp.Schema.Type(), plan)
+
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(raw.Type(), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
+
+// deleteResource runs Delete of the configured resource r with the prior state, failing the test on errors.
+func deleteResource(t *testing.T, r resource.Resource, state tfsdk.State) {
+	t.Helper()
+
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete() diagnostics: %v", resp.Diagnostics)
+	}
+}
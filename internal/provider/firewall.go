@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// errFirewallGuestMissing is returned for the firewall of a guest that doesn't exist (anymore).
+var errFirewallGuestMissing = errors.New("guest doesn't exist")
+
+// firewallBasePath returns the firewall api path of a guest if vmid is set, of a node if node is
+// set and of the cluster otherwise. Guests can be migrated, so their node is looked up on every call.
+func firewallBasePath(ctx context.Context, client pb.CloudServiceClient, targetPve string, node types.String, vmid types.Int64) (string, error) {
+	if !vmid.IsNull() {
+		guest, err := findPveGuest(ctx, client, targetPve, vmid.ValueInt64())
+		if err != nil {
+			return "", err
+		}
+		if guest == nil {
+			return "", fmt.Errorf("%w: %d", errFirewallGuestMissing, vmid.ValueInt64())
+		}
+		return fmt.Sprintf("/nodes/%s/%s/%d/firewall", guest.Node, guest.Type, guest.VmId), nil
+	}
+
+	if !node.IsNull() {
+		return fmt.Sprintf("/nodes/%s/firewall", node.ValueString()), nil
+	}
+
+	return "/cluster/firewall", nil
+}
+
+// importFirewallGuestEntry imports entries of the cluster firewall by `<name>` and of guests by `<vmid>/<name>`.
+func importFirewallGuestEntry(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmidPart, name, found := strings.Cut(req.ID, "/")
+	if !found {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+		return
+	}
+
+	vmid, err := strconv.ParseInt(vmidPart, 10, 64)
+	if err != nil || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <name> or <vmid>/<name>, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallAliasResource{}
+var _ resource.ResourceWithImportState = &FirewallAliasResource{}
+
+func NewFirewallAliasResource() resource.Resource {
+	return &FirewallAliasResource{}
+}
+
+// FirewallAliasResource defines the resource implementation.
+type FirewallAliasResource struct {
+	cloudInventory CloudInventory
+}
+
+// FirewallAliasResourceModel describes the resource data model.
+type FirewallAliasResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	VmId    types.Int64  `tfsdk:"vmid"`
+	Cidr    types.String `tfsdk:"cidr"`
+	Comment types.String `tfsdk:"comment"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *FirewallAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_alias"
+}
+
+func (r *FirewallAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a firewall alias, a named address or network usable as source and destination of rules. " +
+			"Cluster aliases are referenced as `dc/<name>`, guest aliases as `guest/<name>`. Import with `<name>` or `<vmid>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the alias.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(firewallNameRe, "must start with a letter and only contain letters, digits, - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Qemu vm or lxc container the alias belongs to, a cluster alias is created if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Address or network of the alias, e.g. `10.0.0.0/24`.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the alias.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *FirewallAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *FirewallAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallAliasResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{
+		"--name": data.Name.ValueString(),
+		"--cidr": data.Cidr.ValueString(),
+	}
+	if !data.Comment.IsNull() {
+		createArgs["--comment"] = data.Comment.ValueString()
+	}
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, basePath+"/aliases", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating alias, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if errors.Is(err, errFirewallGuestMissing) {
+		removeIfMissing(ctx, false, nil, "alias", resp)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, basePath+"/aliases", "name", data.Name.ValueString())
+	if removeIfMissing(ctx, exists, err, "alias", resp) {
+		return
+	}
+
+	var alias map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/aliases/%s", basePath, data.Name.ValueString()), &alias)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alias, got error: %s", err))
+		return
+	}
+
+	cidr, _ := pveConfigString(alias, "cidr")
+	comment, _ := pveConfigString(alias, "comment")
+	data.Cidr = types.StringValue(cidr)
+	data.Comment = optionalString(comment)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallAliasResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	// the update replaces the whole alias, an empty comment clears it
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/aliases/%s", basePath, data.Name.ValueString()), map[string]string{
+		"--cidr":    data.Cidr.ValueString(),
+		"--comment": data.Comment.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating alias, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if errors.Is(err, errFirewallGuestMissing) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/aliases/%s", basePath, data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting alias, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importFirewallGuestEntry(ctx, req, resp)
+}
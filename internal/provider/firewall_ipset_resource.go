@@ -0,0 +1,387 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallIpsetResource{}
+var _ resource.ResourceWithImportState = &FirewallIpsetResource{}
+
+func NewFirewallIpsetResource() resource.Resource {
+	return &FirewallIpsetResource{}
+}
+
+// FirewallIpsetResource defines the resource implementation.
+type FirewallIpsetResource struct {
+	cloudInventory CloudInventory
+}
+
+// FirewallIpsetResourceModel describes the resource data model.
+type FirewallIpsetResourceModel struct {
+	Name    types.String              `tfsdk:"name"`
+	VmId    types.Int64               `tfsdk:"vmid"`
+	Comment types.String              `tfsdk:"comment"`
+	Entries []FirewallIpsetEntryModel `tfsdk:"entries"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// FirewallIpsetEntryModel describes a single address or network of the ipset.
+type FirewallIpsetEntryModel struct {
+	Cidr    types.String `tfsdk:"cidr"`
+	NoMatch types.Bool   `tfsdk:"nomatch"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (r *FirewallIpsetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_ipset"
+}
+
+func (r *FirewallIpsetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a firewall ipset, a named list of addresses and networks usable as source and destination of rules (`+dc/<name>` or `+guest/<name>`). " +
+			"Entries can't be addressed individually if they contain a network, any change to them refills the ipset, so rules using it briefly match an empty set. " +
+			"Import with `<name>` or `<vmid>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the ipset.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(firewallNameRe, "must start with a letter and only contain letters, digits, - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Qemu vm or lxc container the ipset belongs to, a cluster ipset is created if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the ipset.",
+			},
+			"entries": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Addresses and networks of the ipset.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Address or network, e.g. `10.0.0.0/24`.",
+						},
+						"nomatch": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Excludes the entry from a larger network of the ipset.",
+						},
+						"comment": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Comment of the entry.",
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *FirewallIpsetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// createIpset creates the ipset and adds its entries.
+func (r *FirewallIpsetResource) createIpset(ctx context.Context, client pb.CloudServiceClient, basePath string, data FirewallIpsetResourceModel) error {
+	createArgs := map[string]string{"--name": data.Name.ValueString()}
+	if !data.Comment.IsNull() {
+		createArgs["--comment"] = data.Comment.ValueString()
+	}
+
+	err := pveApiCreate(ctx, client, r.cloudInventory.TargetPve, basePath+"/ipset", createArgs)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range data.Entries {
+		entryArgs := map[string]string{
+			"--cidr":    entry.Cidr.ValueString(),
+			"--nomatch": boolToPve(entry.NoMatch.ValueBool()),
+		}
+		if !entry.Comment.IsNull() {
+			entryArgs["--comment"] = entry.Comment.ValueString()
+		}
+
+		err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/ipset/%s", basePath, data.Name.ValueString()), entryArgs)
+		if err != nil {
+			return fmt.Errorf("entry %s: %w", entry.Cidr.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+// deleteIpset deletes the ipset together with its entries.
+func (r *FirewallIpsetResource) deleteIpset(ctx context.Context, client pb.CloudServiceClient, basePath string, name string) error {
+	_, err := pveApiCall(ctx, client, r.cloudInventory.TargetPve, "DELETE", fmt.Sprintf("%s/ipset/%s", basePath, name), map[string]string{"--force": "1"})
+	return err
+}
+
+func (r *FirewallIpsetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallIpsetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	err = r.createIpset(ctx, client, basePath, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating ipset, got error: %s", err))
+
+		// dont leave a partially filled ipset behind
+		if cleanupErr := r.deleteIpset(ctx, client, basePath, data.Name.ValueString()); cleanupErr != nil {
+			resp.Diagnostics.AddWarning("Cleanup Error", fmt.Sprintf("Unable to remove partially created ipset, got error: %s", cleanupErr))
+		}
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallIpsetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallIpsetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if errors.Is(err, errFirewallGuestMissing) {
+		removeIfMissing(ctx, false, nil, "ipset", resp)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	// the ipset itself only returns its entries, the comment is part of the listing
+	var ipsets []map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, basePath+"/ipset", &ipsets)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ipsets, got error: %s", err))
+		return
+	}
+
+	var ipset map[string]interface{}
+	for _, entry := range ipsets {
+		if name, _ := pveConfigString(entry, "name"); name == data.Name.ValueString() {
+			ipset = entry
+			break
+		}
+	}
+	if removeIfMissing(ctx, ipset != nil, nil, "ipset", resp) {
+		return
+	}
+
+	comment, _ := pveConfigString(ipset, "comment")
+	data.Comment = optionalString(comment)
+
+	var entries []map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/ipset/%s", basePath, data.Name.ValueString()), &entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ipset entries, got error: %s", err))
+		return
+	}
+
+	ipsetEntries := []FirewallIpsetEntryModel{}
+	for _, entry := range entries {
+		cidr, _ := pveConfigString(entry, "cidr")
+		entryComment, _ := pveConfigString(entry, "comment")
+		nomatch, _ := pveConfigInt(entry, "nomatch")
+
+		ipsetEntries = append(ipsetEntries, FirewallIpsetEntryModel{
+			Cidr:    types.StringValue(cidr),
+			NoMatch: types.BoolValue(nomatch == 1),
+			Comment: optionalString(entryComment),
+		})
+	}
+	if len(ipsetEntries) > 0 || data.Entries != nil {
+		data.Entries = ipsetEntries
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallIpsetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FirewallIpsetResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	// set semantics are already applied by the framework, both are equal if no entry changed
+	if !reflect.DeepEqual(firewallIpsetEntrySet(data.Entries), firewallIpsetEntrySet(state.Entries)) {
+		err = r.deleteIpset(ctx, client, basePath, data.Name.ValueString())
+		if err == nil {
+			err = r.createIpset(ctx, client, basePath, data)
+		}
+	} else {
+		// ipsets are updated by "renaming" them to their own name
+		err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, basePath+"/ipset", map[string]string{
+			"--name":    data.Name.ValueString(),
+			"--rename":  data.Name.ValueString(),
+			"--comment": data.Comment.ValueString(),
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating ipset, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// firewallIpsetEntrySet returns the entries as comparable set.
+func firewallIpsetEntrySet(entries []FirewallIpsetEntryModel) map[FirewallIpsetEntryModel]bool {
+	set := map[FirewallIpsetEntryModel]bool{}
+	for _, entry := range entries {
+		set[entry] = true
+	}
+	return set
+}
+
+func (r *FirewallIpsetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallIpsetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if errors.Is(err, errFirewallGuestMissing) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	err = r.deleteIpset(ctx, client, basePath, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting ipset, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallIpsetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importFirewallGuestEntry(ctx, req, resp)
+}
@@ -0,0 +1,471 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallRulesResource{}
+var _ resource.ResourceWithImportState = &FirewallRulesResource{}
+
+// optional rule keys in the order of the rule attributes
+var firewallRuleOptionalKeys = []string{"source", "dest", "proto", "sport", "dport", "iface", "macro", "log", "comment"}
+
+func NewFirewallRulesResource() resource.Resource {
+	return &FirewallRulesResource{}
+}
+
+// FirewallRulesResource defines the resource implementation.
+type FirewallRulesResource struct {
+	cloudInventory CloudInventory
+}
+
+// FirewallRulesResourceModel describes the resource data model.
+type FirewallRulesResourceModel struct {
+	SecurityGroup types.String        `tfsdk:"security_group"`
+	Node          types.String        `tfsdk:"node"`
+	VmId          types.Int64         `tfsdk:"vmid"`
+	Rules         []FirewallRuleModel `tfsdk:"rules"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// FirewallRuleModel describes a single rule, its position is the index in the rules list.
+type FirewallRuleModel struct {
+	Type    types.String `tfsdk:"type"`
+	Action  types.String `tfsdk:"action"`
+	Enable  types.Bool   `tfsdk:"enable"`
+	Source  types.String `tfsdk:"source"`
+	Dest    types.String `tfsdk:"dest"`
+	Proto   types.String `tfsdk:"proto"`
+	Sport   types.String `tfsdk:"sport"`
+	Dport   types.String `tfsdk:"dport"`
+	Iface   types.String `tfsdk:"iface"`
+	Macro   types.String `tfsdk:"macro"`
+	Log     types.String `tfsdk:"log"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (r *FirewallRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rules"
+}
+
+func (r *FirewallRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optional := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the ordered firewall rules of a security group, guest, node or the cluster. " +
+			"Proxmox addresses rules by their position, so all rules of the scope are managed together and rules not listed are removed. " +
+			"Use a single instance per scope. Import with `cluster`, `group/<name>`, `node/<node>` or `vm/<vmid>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"security_group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Security group the rules belong to. At most one of security_group, node or vmid can be set, the cluster rules are managed if none is set.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("node"), path.MatchRoot("vmid")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Node the rules belong to, they apply to the traffic of the host.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("vmid")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Qemu vm or lxc container the rules belong to, the firewall has to be enabled on its network devices.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Rules in the order they are evaluated.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Direction of the rule, in or out, or group to include a security group.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("in", "out", "group"),
+							},
+						},
+						"action": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "ACCEPT, DROP or REJECT, the name of the security group for group rules.",
+						},
+						"enable": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Whether the rule is active.",
+						},
+						"source":  optional("Source address, cidr, alias (e.g. `dc/lan`) or ipset (e.g. `+dc/management`)."),
+						"dest":    optional("Destination address, cidr, alias or ipset."),
+						"proto":   optional("Protocol, e.g. `tcp`, `udp` or `icmp`."),
+						"sport":   optional("Source ports, e.g. `80`, `8000:8100` or `80,443`."),
+						"dport":   optional("Destination ports."),
+						"iface":   optional("Network interface the rule applies to, e.g. `net0` for guests."),
+						"macro":   optional("Predefined rule macro like `SSH` or `HTTPS`, replaces proto and ports."),
+						"comment": optional("Comment of the rule."),
+						"log": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Log level of matches, e.g. `info`, `nolog` if not set.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("emerg", "alert", "crit", "err", "warning", "notice", "info", "debug", "nolog"),
+							},
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// rulesPath returns the api path listing the rules of the scope, single rules are addressed by appending their position.
+func (r *FirewallRulesResource) rulesPath(ctx context.Context, client pb.CloudServiceClient, data FirewallRulesResourceModel) (string, error) {
+	if !data.SecurityGroup.IsNull() {
+		return fmt.Sprintf("/cluster/firewall/groups/%s", data.SecurityGroup.ValueString()), nil
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, data.Node, data.VmId)
+	if err != nil {
+		return "", err
+	}
+	return basePath + "/rules", nil
+}
+
+// values returns the optional values of the rule in the order of firewallRuleOptionalKeys.
+func (rule FirewallRuleModel) values() []types.String {
+	return []types.String{rule.Source, rule.Dest, rule.Proto, rule.Sport, rule.Dport, rule.Iface, rule.Macro, rule.Log, rule.Comment}
+}
+
+// ruleArgs returns the pvesh args of the rule, together with the optional keys that are unset.
+func (rule FirewallRuleModel) ruleArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--type":   rule.Type.ValueString(),
+		"--action": rule.Action.ValueString(),
+		"--enable": boolToPve(rule.Enable.ValueBool()),
+	}
+	deletes := []string{}
+
+	for i, value := range rule.values() {
+		if !value.IsNull() {
+			args["--"+firewallRuleOptionalKeys[i]] = value.ValueString()
+		} else {
+			deletes = append(deletes, firewallRuleOptionalKeys[i])
+		}
+	}
+
+	return args, deletes
+}
+
+// readRules lists the rules of the scope ordered by their position.
+func readRules(ctx context.Context, client pb.CloudServiceClient, targetPve string, rulesPath string) ([]FirewallRuleModel, error) {
+	var entries []map[string]interface{}
+	err := getPveApiJson(ctx, client, targetPve, rulesPath, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		posI, _ := pveConfigInt(entries[i], "pos")
+		posJ, _ := pveConfigInt(entries[j], "pos")
+		return posI < posJ
+	})
+
+	rules := []FirewallRuleModel{}
+	for _, entry := range entries {
+		value := func(key string) types.String {
+			configValue, _ := pveConfigString(entry, key)
+			return optionalString(configValue)
+		}
+		enable, _ := pveConfigInt(entry, "enable")
+
+		rules = append(rules, FirewallRuleModel{
+			Type:    value("type"),
+			Action:  value("action"),
+			Enable:  types.BoolValue(enable == 1),
+			Source:  value("source"),
+			Dest:    value("dest"),
+			Proto:   value("proto"),
+			Sport:   value("sport"),
+			Dport:   value("dport"),
+			Iface:   value("iface"),
+			Macro:   value("macro"),
+			Log:     value("log"),
+			Comment: value("comment"),
+		})
+	}
+	return rules, nil
+}
+
+// applyRules updates the rules in place by position, appends missing ones and removes the surplus.
+func (r *FirewallRulesResource) applyRules(ctx context.Context, client pb.CloudServiceClient, rulesPath string, rules []FirewallRuleModel) error {
+	current, err := readRules(ctx, client, r.cloudInventory.TargetPve, rulesPath)
+	if err != nil {
+		return err
+	}
+
+	for pos, rule := range rules {
+		args, deletes := rule.ruleArgs()
+		if pos < len(current) {
+			if len(deletes) > 0 {
+				args["--delete"] = strings.Join(deletes, ",")
+			}
+			err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/%d", rulesPath, pos), args)
+		} else {
+			// new rules are inserted at pos, the default would be the top
+			args["--pos"] = strconv.Itoa(pos)
+			err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, rulesPath, args)
+		}
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", pos, err)
+		}
+	}
+
+	// from the end, so the positions of the remaining rules don't shift
+	for pos := len(current) - 1; pos >= len(rules); pos-- {
+		err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/%d", rulesPath, pos))
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", pos, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *FirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallRulesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	rulesPath, err := r.rulesPath(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	// existing rules of the scope are taken over
+	err = r.applyRules(ctx, client, rulesPath, data.Rules)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error setting firewall rules, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallRulesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	rulesPath, err := r.rulesPath(ctx, client, data)
+	if errors.Is(err, errFirewallGuestMissing) {
+		removeIfMissing(ctx, false, nil, "guest firewall", resp)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	if !data.SecurityGroup.IsNull() {
+		exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/firewall/groups", "group", data.SecurityGroup.ValueString())
+		if removeIfMissing(ctx, exists, err, "security group", resp) {
+			return
+		}
+	}
+
+	data.Rules, err = readRules(ctx, client, r.cloudInventory.TargetPve, rulesPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rules, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallRulesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	rulesPath, err := r.rulesPath(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	err = r.applyRules(ctx, client, rulesPath, data.Rules)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error setting firewall rules, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallRulesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the rules of a deleted guest are gone with it
+	rulesPath, err := r.rulesPath(ctx, client, data)
+	if errors.Is(err, errFirewallGuestMissing) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	err = r.applyRules(ctx, client, rulesPath, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error removing firewall rules, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "cluster" {
+		return
+	}
+
+	scope, id, _ := strings.Cut(req.ID, "/")
+	switch {
+	case scope == "group" && id != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("security_group"), id)...)
+	case scope == "node" && id != "":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), id)...)
+	case scope == "vm":
+		vmid, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric vmid in vm/<vmid>, got: %s", req.ID))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmid)...)
+	default:
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected cluster, group/<name>, node/<node> or vm/<vmid>, got: %s", req.ID))
+	}
+}
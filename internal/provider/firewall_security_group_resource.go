@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FirewallSecurityGroupResource{}
+var _ resource.ResourceWithImportState = &FirewallSecurityGroupResource{}
+
+// names of firewall security groups, aliases and ipsets
+var firewallNameRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_]+$`)
+
+func NewFirewallSecurityGroupResource() resource.Resource {
+	return &FirewallSecurityGroupResource{}
+}
+
+// FirewallSecurityGroupResource defines the resource implementation.
+type FirewallSecurityGroupResource struct {
+	cloudInventory CloudInventory
+}
+
+// FirewallSecurityGroupResourceModel describes the resource data model.
+type FirewallSecurityGroupResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *FirewallSecurityGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_security_group"
+}
+
+func (r *FirewallSecurityGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a cluster wide firewall security group, a named set of rules that is referenced by `group` rules of the cluster, nodes and guests. " +
+			"The rules of the group are managed with pxc_firewall_rules. Import with `<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the security group.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(firewallNameRe, "must start with a letter and only contain letters, digits, - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the security group.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *FirewallSecurityGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *FirewallSecurityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallSecurityGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{"--group": data.Name.ValueString()}
+	if !data.Comment.IsNull() {
+		createArgs["--comment"] = data.Comment.ValueString()
+	}
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/firewall/groups", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating security group, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallSecurityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallSecurityGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the group itself only returns its rules, the comment is part of the listing
+	var groups []map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/firewall/groups", &groups)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read security groups, got error: %s", err))
+		return
+	}
+
+	var group map[string]interface{}
+	for _, entry := range groups {
+		if name, _ := pveConfigString(entry, "group"); name == data.Name.ValueString() {
+			group = entry
+			break
+		}
+	}
+	if removeIfMissing(ctx, group != nil, nil, "security group", resp) {
+		return
+	}
+
+	comment, _ := pveConfigString(group, "comment")
+	data.Comment = optionalString(comment)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallSecurityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallSecurityGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// groups are updated by "renaming" them to their own name
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/firewall/groups", map[string]string{
+		"--group":   data.Name.ValueString(),
+		"--rename":  data.Name.ValueString(),
+		"--comment": data.Comment.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating security group, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallSecurityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallSecurityGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to delete groups that still contain rules
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/firewall/groups/%s", data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting security group, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallSecurityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,616 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// goBackend serves the pve native part of the rpc surface directly from go by running
+// the same commands as the python backend over ssh on the target cluster. It implements
+// grpc.ClientConnInterface so resources keep using the generated CloudServiceClient,
+// calls it doesn't support fail with codes.Unimplemented.
+type goBackend struct {
+	targetPve string
+	// ssh hosts of the target cluster, tried in order
+	hosts       []string
+	auth        []ssh.AuthMethod
+	interceptor grpc.UnaryClientInterceptor
+
+	mu     sync.Mutex
+	host   string
+	client *ssh.Client
+}
+
+// goBackendMethod handles a single rpc, req and reply are the generated messages.
+type goBackendMethod func(b *goBackend, ctx context.Context, req any, reply any) error
+
+// goMethod adapts a typed handler to goBackendMethod.
+func goMethod[Req proto.Message, Resp proto.Message](handler func(*goBackend, context.Context, Req) (Resp, error)) goBackendMethod {
+	return func(b *goBackend, ctx context.Context, req any, reply any) error {
+		resp, err := handler(b, ctx, req.(Req))
+		if err != nil {
+			return err
+		}
+
+		proto.Merge(reply.(proto.Message), resp)
+		return nil
+	}
+}
+
+// rpcs supported by the go backend, keyed by method name
+var goBackendMethods = map[string]goBackendMethod{
+	"GetProxmoxApi":      goMethod((*goBackend).getProxmoxApi),
+	"CreateProxmoxApi":   goMethod((*goBackend).createProxmoxApi),
+	"SetProxmoxApi":      goMethod((*goBackend).setProxmoxApi),
+	"DeleteProxmoxApi":   goMethod((*goBackend).deleteProxmoxApi),
+	"WaitForTask":        goMethod((*goBackend).waitForTask),
+	"GetProxmoxHost":     goMethod((*goBackend).getProxmoxHost),
+	"GetCloudDomain":     goMethod((*goBackend).getCloudDomain),
+	"GetClusterVars":     goMethod((*goBackend).getClusterVars),
+	"GetCloudFileSecret": goMethod((*goBackend).getCloudFileSecret),
+	"GetSshKey":          goMethod((*goBackend).getSshKey),
+	"GetCephAccess":      goMethod((*goBackend).getCephAccess),
+	"CephCommand":        goMethod((*goBackend).cephCommand),
+	"WriteStorageFile":   goMethod((*goBackend).writeStorageFile),
+	"HashStorageFile":    goMethod((*goBackend).hashStorageFile),
+	"DeleteStorageFile":  goMethod((*goBackend).deleteStorageFile),
+	"GetNodeProxyConfig": goMethod((*goBackend).getNodeProxyConfig),
+	"SetNodeProxyConfig": goMethod((*goBackend).setNodeProxyConfig),
+}
+
+// rpcs the go backend can't serve, keyed by method name with the reason shown in diagnostics
+var goBackendUnsupported = map[string]string{
+	"CreateCloudSecret":    "cloud secrets are stored in the patroni database",
+	"UpdateCloudSecret":    "cloud secrets are stored in the patroni database",
+	"DeleteCloudSecret":    "cloud secrets are stored in the patroni database",
+	"GetCloudSecret":       "cloud secrets are stored in the patroni database",
+	"GetCloudSecrets":      "cloud secrets are stored in the patroni database",
+	"GetCloudSecretByName": "cloud secrets are stored in the patroni database",
+	"GetCloudSecretNames":  "cloud secrets are stored in the patroni database",
+}
+
+// newGoBackend connects to the first reachable of hosts, defaulting to the
+// target_pve name itself.
+func newGoBackend(ctx context.Context, targetPve string, hosts []string, retry rpcCallConfig) (*goBackend, error) {
+	if len(hosts) == 0 {
+		hosts = []string{targetPve}
+	}
+
+	b := &goBackend{
+		targetPve:   targetPve,
+		hosts:       hosts,
+		auth:        goBackendSshAuth(),
+		interceptor: newRpcUnaryInterceptor(retry),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.connect(ctx); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// goBackendSshAuth authenticates like the python backend, via the ssh agent and
+// the unencrypted default keys in ~/.ssh.
+func goBackendSshAuth() []ssh.AuthMethod {
+	methods := []ssh.AuthMethod{}
+
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		if conn, err := net.Dial("unix", sock); err == nil {
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+
+	home, _ := os.UserHomeDir()
+	keyFiles, _ := filepath.Glob(filepath.Join(home, ".ssh", "id_*"))
+
+	signers := []ssh.Signer{}
+	for _, keyFile := range keyFiles {
+		if strings.HasSuffix(keyFile, ".pub") {
+			continue
+		}
+
+		pemBytes, err := os.ReadFile(keyFile)
+		if err != nil {
+			continue
+		}
+
+		// passphrase protected keys have to be added to the agent
+		signer, err := ssh.ParsePrivateKey(pemBytes)
+		if err == nil {
+			signers = append(signers, signer)
+		}
+	}
+	if len(signers) > 0 {
+		methods = append(methods, ssh.PublicKeys(signers...))
+	}
+
+	return methods
+}
+
+// connect dials the hosts in order, the caller has to hold mu.
+func (b *goBackend) connect(ctx context.Context) error {
+	var errs []error
+	for _, host := range b.hosts {
+		address := host
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			address = net.JoinHostPort(host, "22")
+		}
+
+		dialer := net.Dialer{Timeout: 10 * time.Second}
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		sshConn, chans, reqs, err := ssh.NewClientConn(conn, address, &ssh.ClientConfig{
+			User: "root",
+			Auth: b.auth,
+			// same as the python backend which connects without known_hosts
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			Timeout:         10 * time.Second,
+		})
+		if err != nil {
+			conn.Close()
+			errs = append(errs, fmt.Errorf("%s: %w", host, err))
+			continue
+		}
+
+		b.host = host
+		b.client = ssh.NewClient(sshConn, chans, reqs)
+		return nil
+	}
+
+	return fmt.Errorf("no pve host of %s reachable via ssh: %w", b.targetPve, errors.Join(errs...))
+}
+
+// session opens a new ssh session, reconnecting if the connection broke.
+func (b *goBackend) session(ctx context.Context) (*ssh.Session, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.client != nil {
+		session, err := b.client.NewSession()
+		if err == nil {
+			return session, nil
+		}
+		b.client.Close()
+		b.client = nil
+	}
+
+	if err := b.connect(ctx); err != nil {
+		return nil, err
+	}
+
+	return b.client.NewSession()
+}
+
+// run executes command on the connected pve host, returning stdout and stderr.
+func (b *goBackend) run(ctx context.Context, command string) ([]byte, []byte, error) {
+	return b.runInput(ctx, command, nil)
+}
+
+// runInput is run with stdin fed from input.
+func (b *goBackend) runInput(ctx context.Context, command string, input []byte) ([]byte, []byte, error) {
+	session, err := b.session(ctx)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unavailable, err.Error())
+	}
+	defer session.Close()
+
+	if input != nil {
+		session.Stdin = bytes.NewReader(input)
+	}
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(command)
+	}()
+
+	select {
+	case err = <-done:
+		return stdout.Bytes(), stderr.Bytes(), err
+	case <-ctx.Done():
+		session.Signal(ssh.SIGKILL)
+		return nil, nil, ctx.Err()
+	}
+}
+
+// cat reads a file from the connected pve host.
+func (b *goBackend) cat(ctx context.Context, file string) ([]byte, error) {
+	stdout, stderr, err := b.run(ctx, "cat "+shellQuote(file))
+	if err != nil {
+		return nil, goBackendError(err, stderr, "cat %s failed", file)
+	}
+	return stdout, nil
+}
+
+// array parameters of the pve api, resources join their values with newlines. Other
+// values are passed as is, certificates, keys and comments can span lines.
+var pveshArrayArgs = map[string]bool{
+	"--dhcp-range": true,
+	"--map":        true,
+}
+
+// pveshCommand builds the pvesh command line, keys are passed including their dashes.
+func pveshCommand(verb string, apiPath string, args map[string]string, extra ...string) string {
+	command := []string{"pvesh", verb, shellQuote(apiPath)}
+
+	// sorted for reproducible commands
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		values := []string{args[k]}
+		if pveshArrayArgs[k] {
+			values = strings.Split(args[k], "\n")
+		}
+		for _, v := range values {
+			command = append(command, shellQuote(k), shellQuote(v))
+		}
+	}
+	command = append(command, extra...)
+
+	return strings.Join(command, " ")
+}
+
+// pvesh runs pvesh with the args of the request.
+func (b *goBackend) pvesh(ctx context.Context, verb string, apiPath string, args map[string]string, extra ...string) (string, string, error) {
+	stdout, stderr, err := b.run(ctx, pveshCommand(verb, apiPath, args, extra...))
+	return string(stdout), string(stderr), err
+}
+
+// pveshChange runs a create/set/delete and reports failures in the response like the python backend.
+func (b *goBackend) pveshChange(ctx context.Context, verb string, apiPath string, args map[string]string) (bool, string, string, error) {
+	stdout, stderr, err := b.pvesh(ctx, verb, apiPath, args)
+
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		return false, fmt.Sprintf("Exit code %d - %s", exitErr.ExitStatus(), stderr), "", nil
+	}
+	if err != nil {
+		return false, "", "", err
+	}
+
+	return true, "", strings.TrimSpace(stdout), nil
+}
+
+func (b *goBackend) getProxmoxApi(ctx context.Context, req *pb.GetProxmoxApiRequest) (*pb.GetProxmoxApiResponse, error) {
+	stdout, stderr, err := b.pvesh(ctx, "get", req.ApiPath, req.GetArgs, "--output-format", "json")
+	if err != nil {
+		return nil, goBackendError(err, []byte(stderr), "pvesh get %s failed", req.ApiPath)
+	}
+
+	return &pb.GetProxmoxApiResponse{JsonResp: stdout}, nil
+}
+
+func (b *goBackend) createProxmoxApi(ctx context.Context, req *pb.CreateProxmoxApiRequest) (*pb.CreateProxmoxApiResponse, error) {
+	success, errMessage, output, err := b.pveshChange(ctx, "create", req.ApiPath, req.CreateArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateProxmoxApiResponse{Success: success, ErrMessage: errMessage, Output: output}, nil
+}
+
+func (b *goBackend) setProxmoxApi(ctx context.Context, req *pb.SetProxmoxApiRequest) (*pb.SetProxmoxApiResponse, error) {
+	success, errMessage, output, err := b.pveshChange(ctx, "set", req.ApiPath, req.SetArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetProxmoxApiResponse{Success: success, ErrMessage: errMessage, Output: output}, nil
+}
+
+func (b *goBackend) deleteProxmoxApi(ctx context.Context, req *pb.DeleteProxmoxApiRequest) (*pb.DeleteProxmoxApiResponse, error) {
+	success, errMessage, output, err := b.pveshChange(ctx, "delete", req.ApiPath, req.DeleteArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteProxmoxApiResponse{Success: success, ErrMessage: errMessage, Output: output}, nil
+}
+
+func (b *goBackend) waitForTask(ctx context.Context, req *pb.WaitForTaskRequest) (*pb.WaitForTaskResponse, error) {
+	if !isPveUpid(req.Upid) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a task UPID", req.Upid)
+	}
+	taskPath := fmt.Sprintf("/nodes/%s/tasks/%s", strings.Split(req.Upid, ":")[1], req.Upid)
+	deadline := time.Now().Add(time.Duration(req.TimeoutSeconds) * time.Second)
+
+	var taskStatus struct {
+		Status     string `json:"status"`
+		ExitStatus string `json:"exitstatus"`
+	}
+	for {
+		sresp, err := b.getProxmoxApi(ctx, &pb.GetProxmoxApiRequest{ApiPath: taskPath + "/status"})
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(sresp.JsonResp), &taskStatus); err != nil {
+			return nil, err
+		}
+
+		if taskStatus.Status == "stopped" || !time.Now().Before(deadline) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+
+	resp := &pb.WaitForTaskResponse{Finished: taskStatus.Status == "stopped", ExitStatus: taskStatus.ExitStatus}
+
+	if req.LogLines > 0 {
+		// the api only returns the first 50 lines by default
+		lresp, err := b.getProxmoxApi(ctx, &pb.GetProxmoxApiRequest{ApiPath: taskPath + "/log", GetArgs: map[string]string{"--limit": "1000000"}})
+		if err != nil {
+			return nil, err
+		}
+
+		var lines []struct {
+			T string `json:"t"`
+		}
+		if err := json.Unmarshal([]byte(lresp.JsonResp), &lines); err != nil {
+			return nil, err
+		}
+
+		if int64(len(lines)) > req.LogLines {
+			lines = lines[int64(len(lines))-req.LogLines:]
+		}
+		tail := make([]string, len(lines))
+		for i, line := range lines {
+			tail[i] = line.T
+		}
+		resp.LogTail = strings.Join(tail, "\n")
+	}
+
+	return resp, nil
+}
+
+func (b *goBackend) getProxmoxHost(ctx context.Context, req *pb.GetProxmoxHostRequest) (*pb.GetProxmoxHostResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return &pb.GetProxmoxHostResponse{PveHost: b.host}, nil
+}
+
+// target_pve is named <cluster>.<cloud domain>
+func (b *goBackend) getCloudDomain(ctx context.Context, req *pb.GetCloudDomainRequest) (*pb.GetCloudDomainResponse, error) {
+	_, domain, found := strings.Cut(req.TargetPve, ".")
+	if !found {
+		return nil, status.Errorf(codes.InvalidArgument, "target_pve %s has no cloud domain", req.TargetPve)
+	}
+
+	return &pb.GetCloudDomainResponse{Domain: domain}, nil
+}
+
+func (b *goBackend) getClusterVars(ctx context.Context, req *pb.GetClusterVarsRequest) (*pb.GetClusterVarsResponse, error) {
+	vars, err := b.cat(ctx, "/etc/pve/cloud/cluster_vars.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetClusterVarsResponse{Vars: string(vars)}, nil
+}
+
+func (b *goBackend) getCloudFileSecret(ctx context.Context, req *pb.GetCloudFileSecretRequest) (*pb.GetCloudFileSecretResponse, error) {
+	raw, err := b.cat(ctx, path.Join("/etc/pve/cloud/secrets", req.SecretName))
+	if err != nil {
+		return nil, err
+	}
+
+	secret := string(raw)
+	if req.Rstrip {
+		secret = strings.TrimRight(secret, " \t\r\n")
+	}
+
+	return &pb.GetCloudFileSecretResponse{Secret: secret, Raw: raw}, nil
+}
+
+func (b *goBackend) getSshKey(ctx context.Context, req *pb.GetSshKeyRequest) (*pb.GetSshKeyResponse, error) {
+	keyFile := "/etc/pve/cloud/automation_id_ed25519"
+	if req.KeyType == pb.GetSshKeyRequest_PVE_HOST_RSA {
+		keyFile = "/root/.ssh/id_rsa"
+	}
+
+	key, err := b.cat(ctx, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSshKeyResponse{Key: string(key)}, nil
+}
+
+func (b *goBackend) getCephAccess(ctx context.Context, req *pb.GetCephAccessRequest) (*pb.GetCephAccessResponse, error) {
+	conf, err := b.cat(ctx, "/etc/ceph/ceph.conf")
+	if err != nil {
+		return nil, err
+	}
+
+	keyring, err := b.cat(ctx, "/etc/pve/priv/ceph.client.admin.keyring")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCephAccessResponse{CephConf: string(conf), AdminKeyring: string(keyring)}, nil
+}
+
+func (b *goBackend) cephCommand(ctx context.Context, req *pb.CephCommandRequest) (*pb.CephCommandResponse, error) {
+	tool := strings.ToLower(req.Tool.String())
+	command := []string{tool}
+	for _, arg := range req.Args {
+		command = append(command, shellQuote(arg))
+	}
+	command = append(command, "--format", "json")
+
+	stdout, stderr, err := b.run(ctx, strings.Join(command, " "))
+	if err != nil {
+		return nil, goBackendError(err, stderr, "%s %s failed", tool, strings.Join(req.Args, " "))
+	}
+
+	return &pb.CephCommandResponse{JsonResp: string(stdout)}, nil
+}
+
+// onNode wraps command to run on node, which is reached via ssh from the connected host
+// since pve nodes trust each other's root keys. Storage files resolve to the node's paths.
+func onNode(node string, command string) string {
+	return "ssh -o BatchMode=yes root@" + shellQuote(node) + " " + shellQuote(command)
+}
+
+// storageFileCommand resolves the path of volumeId with pvesm and runs command on it as $p.
+func storageFileCommand(node string, volumeId string, command string) string {
+	return onNode(node, "p=$(pvesm path "+shellQuote(volumeId)+") && "+command)
+}
+
+func (b *goBackend) writeStorageFile(ctx context.Context, req *pb.WriteStorageFileRequest) (*pb.WriteStorageFileResponse, error) {
+	// written to a temporary file first so readers never see partial content
+	mode := "644"
+	if req.Executable {
+		mode = "755"
+	}
+	command := storageFileCommand(req.Node, req.VolumeId, `mkdir -p "$(dirname "$p")" && cat > "$p.tmp" && chmod `+mode+` "$p.tmp" && mv "$p.tmp" "$p"`)
+	_, stderr, err := b.runInput(ctx, command, req.Content)
+	if err != nil {
+		return nil, goBackendError(err, stderr, "writing %s on %s failed", req.VolumeId, req.Node)
+	}
+
+	return &pb.WriteStorageFileResponse{}, nil
+}
+
+func (b *goBackend) hashStorageFile(ctx context.Context, req *pb.HashStorageFileRequest) (*pb.HashStorageFileResponse, error) {
+	stdout, stderr, err := b.run(ctx, storageFileCommand(req.Node, req.VolumeId, `sha256sum "$p"`))
+	if err != nil {
+		return nil, goBackendError(err, stderr, "hashing %s on %s failed", req.VolumeId, req.Node)
+	}
+
+	sha256, _, _ := strings.Cut(string(stdout), " ")
+	return &pb.HashStorageFileResponse{Sha256: sha256}, nil
+}
+
+func (b *goBackend) deleteStorageFile(ctx context.Context, req *pb.DeleteStorageFileRequest) (*pb.DeleteStorageFileResponse, error) {
+	_, stderr, err := b.run(ctx, storageFileCommand(req.Node, req.VolumeId, `rm -f "$p"`))
+	if err != nil {
+		return nil, goBackendError(err, stderr, "deleting %s on %s failed", req.VolumeId, req.Node)
+	}
+
+	return &pb.DeleteStorageFileResponse{}, nil
+}
+
+func (b *goBackend) getNodeProxyConfig(ctx context.Context, req *pb.GetNodeProxyConfigRequest) (*pb.GetNodeProxyConfigResponse, error) {
+	// the file only exists once options were set
+	stdout, stderr, err := b.run(ctx, onNode(req.Node, "cat /etc/default/pveproxy 2>/dev/null || true"))
+	if err != nil {
+		return nil, goBackendError(err, stderr, "reading the pveproxy config of %s failed", req.Node)
+	}
+
+	return &pb.GetNodeProxyConfigResponse{Config: parseProxyConfig(string(stdout))}, nil
+}
+
+// setNodeProxyConfig replaces the whole file, pveproxy has to be restarted to pick up the changes.
+func (b *goBackend) setNodeProxyConfig(ctx context.Context, req *pb.SetNodeProxyConfigRequest) (*pb.SetNodeProxyConfigResponse, error) {
+	content, err := formatProxyConfig(req.Config)
+	if err != nil {
+		return &pb.SetNodeProxyConfigResponse{Success: false, ErrMessage: err.Error()}, nil
+	}
+
+	command := onNode(req.Node, "cat > /etc/default/pveproxy.tmp && mv /etc/default/pveproxy.tmp /etc/default/pveproxy")
+	_, stderr, err := b.runInput(ctx, command, []byte(content))
+
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		return &pb.SetNodeProxyConfigResponse{Success: false, ErrMessage: fmt.Sprintf("Exit code %d - %s", exitErr.ExitStatus(), stderr)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetNodeProxyConfigResponse{Success: true}, nil
+}
+
+// Invoke dispatches the call to the go implementation, applying the same defaults
+// and error wrapping as calls to the python backend.
+func (b *goBackend) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	return b.interceptor(ctx, method, args, reply, nil, b.invoke, opts...)
+}
+
+func (b *goBackend) invoke(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+	name := path.Base(method)
+	if reason, ok := goBackendUnsupported[name]; ok {
+		return status.Errorf(codes.Unimplemented, "%s, not supported with backend = \"go\", use backend = \"python\"", reason)
+	}
+
+	handler, ok := goBackendMethods[name]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "not supported by the go backend, use backend = \"python\"")
+	}
+
+	return handler(b, ctx, req, reply)
+}
+
+func (b *goBackend) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "%s: streams are not supported by the go backend", path.Base(method))
+}
+
+// Close closes the ssh connection.
+func (b *goBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.client == nil {
+		return nil
+	}
+	return b.client.Close()
+}
+
+// goBackendError classifies failed commands by their output like the python backend.
+func goBackendError(err error, stderr []byte, format string, args ...any) error {
+	if _, ok := status.FromError(err); ok || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err // connection failures and timeouts are classified already
+	}
+
+	code := codes.Unknown
+	switch {
+	case bytes.Contains(stderr, []byte("already exists")):
+		code = codes.AlreadyExists
+	case bytes.Contains(stderr, []byte("does not exist")), bytes.Contains(stderr, []byte("not found")), bytes.Contains(stderr, []byte("No such file")), bytes.Contains(stderr, []byte("ENOENT")):
+		code = codes.NotFound
+	}
+
+	return status.Errorf(code, "%s: %s - %s", fmt.Sprintf(format, args...), err, stderr)
+}
+
+// shellQuote quotes s as single shell argument.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGoBackendUnsupported(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		wantReason string
+	}{
+		{name: "cloud secret", method: "/protos.CloudService/GetCloudSecretByName", wantReason: "patroni database"},
+		{name: "unknown", method: "/protos.CloudService/GetMasterKubeconfig"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&goBackend{}).invoke(context.Background(), tt.method, &pb.GetCloudSecretByNameRequest{}, &pb.GetCloudSecretByNameResponse{}, nil)
+			if status.Code(err) != codes.Unimplemented {
+				t.Fatalf("invoke() error = %v, want Unimplemented", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantReason) || !strings.Contains(err.Error(), `backend = "python"`) {
+				t.Errorf("invoke() error = %s, want reason %q and the python backend hint", err, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestPveshCommand(t *testing.T) {
+	pem := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+
+	tests := []struct {
+		name string
+		args map[string]string
+		want string
+	}{
+		{
+			name: "multi line value",
+			args: map[string]string{"--certificates": pem},
+			want: "pvesh create '/nodes/pve1/certificates/custom' '--certificates' '" + pem + "'",
+		},
+		{
+			name: "array parameter",
+			args: map[string]string{"--map": "node=pve1,path=0000:01:00\nnode=pve2,path=0000:02:00"},
+			want: "pvesh create '/nodes/pve1/certificates/custom' '--map' 'node=pve1,path=0000:01:00' '--map' 'node=pve2,path=0000:02:00'",
+		},
+		{
+			name: "sorted keys",
+			args: map[string]string{"--force": "1", "--restart": "1"},
+			want: "pvesh create '/nodes/pve1/certificates/custom' '--force' '1' '--restart' '1'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pveshCommand("create", "/nodes/pve1/certificates/custom", tt.args); got != tt.want {
+				t.Errorf("pveshCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
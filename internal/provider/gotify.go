@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gotifyConn holds the effective connection settings of a gotify resource.
+type gotifyConn struct {
+	host      string
+	adminPw   string
+	tlsConfig *tls.Config
+}
+
+// resolveGotifyConn merges the connection attributes of a resource with the provider
+// gotify block, attributes set on the resource take precedence.
+func resolveGotifyConn(cfg *GotifyProviderModel, resourceCfg GotifyProviderModel) (gotifyConn, error) {
+	if cfg == nil {
+		cfg = &GotifyProviderModel{}
+	}
+
+	pick := func(resourceValue types.String, providerValue types.String) string {
+		if !resourceValue.IsNull() {
+			return resourceValue.ValueString()
+		}
+		return providerValue.ValueString()
+	}
+
+	conn := gotifyConn{
+		host:    pick(resourceCfg.Host, cfg.Host),
+		adminPw: pick(resourceCfg.AdminPw, cfg.AdminPw),
+	}
+	if conn.host == "" {
+		return conn, fmt.Errorf("no gotify host, set gotify_host on the resource or host in the provider gotify block")
+	}
+
+	allowInsecure := cfg.AllowInsecure.ValueBool()
+	if !resourceCfg.AllowInsecure.IsNull() {
+		allowInsecure = resourceCfg.AllowInsecure.ValueBool()
+	}
+	conn.tlsConfig = &tls.Config{InsecureSkipVerify: allowInsecure}
+
+	// verify self signed deployments against their ca instead of skipping verification
+	caCertPem := pick(resourceCfg.CaCertPem, cfg.CaCertPem)
+	if caCertPem != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCertPem)) {
+			return conn, fmt.Errorf("ca_cert_pem contains no valid pem certificate")
+		}
+		conn.tlsConfig.RootCAs = pool
+	}
+
+	clientCertPem := pick(resourceCfg.ClientCertPem, cfg.ClientCertPem)
+	clientKeyPem := pick(resourceCfg.ClientKeyPem, cfg.ClientKeyPem)
+	if clientCertPem != "" || clientKeyPem != "" {
+		clientCert, err := tls.X509KeyPair([]byte(clientCertPem), []byte(clientKeyPem))
+		if err != nil {
+			return conn, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		conn.tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return conn, nil
+}
+
+func (c gotifyConn) client() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: c.tlsConfig,
+		},
+	}
+}
+
+func (c gotifyConn) url(apiPath string) string {
+	return fmt.Sprintf("https://%s%s", c.host, apiPath)
+}
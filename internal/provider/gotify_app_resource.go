@@ -6,20 +6,27 @@ package provider
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-  "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -32,16 +39,23 @@ func NewGotifyAppResource() resource.Resource {
 
 // GotifyAppResource defines the resource implementation.
 type GotifyAppResource struct {
+	cloudInventory CloudInventory
 }
 
 // GotifyAppResourceModel describes the resource data model.
 type GotifyAppResourceModel struct {
-	GotifyHost						types.String `tfsdk:"gotify_host"`
-	GotifyAdminPw					types.String `tfsdk:"gotify_admin_pw"`
-	AppName							types.String `tfsdk:"app_name"`
-	AllowInsecure					types.Bool 	 `tfsdk:"allow_insecure"`
-	AppToken						types.String `tfsdk:"app_token"`
-	AppId							types.Int64	 `tfsdk:"app_id"`
+	GotifyHost      types.String `tfsdk:"gotify_host"`
+	GotifyAdminPw   types.String `tfsdk:"gotify_admin_pw"`
+	AppName         types.String `tfsdk:"app_name"`
+	Description     types.String `tfsdk:"description"`
+	DefaultPriority types.Int64  `tfsdk:"default_priority"`
+	Image           types.String `tfsdk:"image"`
+	AllowInsecure   types.Bool   `tfsdk:"allow_insecure"`
+	CaCertPem       types.String `tfsdk:"ca_cert_pem"`
+	ClientCertPem   types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPem    types.String `tfsdk:"client_key_pem"`
+	AppToken        types.String `tfsdk:"app_token"`
+	AppId           types.Int64  `tfsdk:"app_id"`
 }
 
 func (r *GotifyAppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,43 +64,80 @@ func (r *GotifyAppResource) Metadata(ctx context.Context, req resource.MetadataR
 
 func (r *GotifyAppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Create a gotify application",
+		MarkdownDescription: "Create a gotify application. Import with the numeric `<app_id>`, the gotify connection is taken from the provider gotify block.",
 
 		Attributes: map[string]schema.Attribute{
 			"gotify_host": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Gotify host to connect to (e.g. gotify.example.com).",
+				Optional:            true,
+				MarkdownDescription: "Gotify host to connect to (e.g. gotify.example.com), defaults to the host of the provider gotify block.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(), // changing host forces replace
 				},
 			},
 			"gotify_admin_pw": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Password for the root gotify admin user needed to make api calls.",
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password for the root gotify admin user needed to make api calls, defaults to the admin_pw of the provider gotify block.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(), // changes are irrelevant
 				},
 			},
 			"app_name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The name of the gotify app that will be created.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // changing host forces replace
-				},
+				MarkdownDescription: "The name of the gotify app that will be created. Renames are applied in place, keeping app id and token.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Description of the gotify app, changes are applied in place.",
+			},
+			"default_priority": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Default priority of messages sent by the app without explicit priority.",
+			},
+			"image": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a local image file that is uploaded as app image, so alert apps are distinguishable in the gotify ui. Uploaded again when the path changes.",
 			},
 			"allow_insecure": schema.BoolAttribute{
-				MarkdownDescription: "Allows connection to an insecure gotify serving a self signed certificate via https. Needed for e2e tests.",
-				Optional: 					 true,
-				Default: 						 booldefault.StaticBool(false),
-				Computed: 					 true,
+				MarkdownDescription: "Allows connection to an insecure gotify serving a self signed certificate via https, disables all tls verification. Prefer ca_cert_pem for self signed deployments. Defaults to the allow_insecure of the provider gotify block.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded ca certificate to verify the gotify server against, defaults to the ca_cert_pem of the provider gotify block.",
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded client certificate for mutual tls, requires client_key_pem.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM encoded private key of client_cert_pem.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
 			},
 			"app_token": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Application token for the created gotify app.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(), // stays the same on updates
+				},
 			},
 			"app_id": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "Application id for later deletion.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -97,11 +148,25 @@ func (r *GotifyAppResource) Configure(ctx context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
 }
 
 type GotifyAppResponse struct {
-    AppToken string `json:"token"`
-    Id       int64  `json:"id"`
+	AppToken        string `json:"token"`
+	Id              int64  `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	DefaultPriority int64  `json:"defaultPriority"`
 }
 
 func (r *GotifyAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -114,15 +179,16 @@ func (r *GotifyAppResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: data.AllowInsecure.ValueBool()},
-		},
+	conn, err := resolveGotifyConn(r.cloudInventory.Gotify, data.gotifyConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Gotify Config Error", err.Error())
+		return
 	}
+	client := conn.client()
 
-	postUrl := fmt.Sprintf("https://%s/application", data.GotifyHost.ValueString())
+	postUrl := conn.url("/application")
 
-	body, _ := json.Marshal(map[string]string{"name": data.AppName.ValueString()})
+	body, _ := json.Marshal(gotifyAppBody(data))
 
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", postUrl, bytes.NewBuffer(body))
 	if err != nil {
@@ -131,7 +197,7 @@ func (r *GotifyAppResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-  	httpReq.SetBasicAuth("admin", data.GotifyAdminPw.ValueString())
+	httpReq.SetBasicAuth("admin", conn.adminPw)
 
 	httpResp, err := client.Do(httpReq)
 	if err != nil {
@@ -150,13 +216,21 @@ func (r *GotifyAppResource) Create(ctx context.Context, req resource.CreateReque
 	var response GotifyAppResponse
 	err = json.Unmarshal(bodyBytes, &response)
 	if err != nil {
-			resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Error unmarshalling: %s", err))
+		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Error unmarshalling: %s", err))
 	}
 
 	// save token and id for later delete
 	data.AppToken = types.StringValue(response.AppToken)
 	data.AppId = types.Int64Value(response.Id)
-	
+
+	if !data.Image.IsNull() {
+		err = uploadGotifyAppImage(ctx, conn, data)
+		if err != nil {
+			// the app exists already, keep it in state so it gets cleaned up
+			resp.Diagnostics.AddError("Image Upload Failed", err.Error())
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -171,24 +245,28 @@ func (r *GotifyAppResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	conn, err := resolveGotifyConn(r.cloudInventory.Gotify, data.gotifyConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Gotify Config Error", err.Error())
+		return
+	}
+
+	app, err := getGotifyApp(ctx, conn, data.AppId.ValueInt64())
+	if removeIfMissing(ctx, app != nil, err, "gotify app", resp) {
+		return
+	}
+
+	// a token regenerated in the ui would otherwise silently break the notification chain
+	data.AppToken = types.StringValue(app.AppToken)
+	data.AppName = types.StringValue(app.Name)
+	data.Description = types.StringValue(app.Description)
+	data.DefaultPriority = types.Int64Value(app.DefaultPriority)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GotifyAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"This resource does not support in-place updates. Any change to these attributes "+
-		"should have triggered a replacement. This is a provider bug.",
-  )
 	var data GotifyAppResourceModel
 
 	// Read Terraform plan data into the model
@@ -198,13 +276,70 @@ func (r *GotifyAppResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	conn, err := resolveGotifyConn(r.cloudInventory.Gotify, data.gotifyConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Gotify Config Error", err.Error())
+		return
+	}
+	client := conn.client()
+
+	// updating in place keeps the app id and the token proxmox uses
+	putUrl := conn.url(fmt.Sprintf("/application/%d", data.AppId.ValueInt64()))
+
+	body, _ := json.Marshal(gotifyAppBody(data))
+
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", putUrl, bytes.NewBuffer(body))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create update request: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.SetBasicAuth("admin", conn.adminPw)
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Request error", fmt.Sprintf("Error calling gotify: %s", err))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Response error", fmt.Sprintf("Failed to read body: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Update Failed",
+			fmt.Sprintf("Update failed with code %d, message: %s", httpResp.StatusCode, string(bodyBytes)),
+		)
+		return
+	}
+
+	var response GotifyAppResponse
+	err = json.Unmarshal(bodyBytes, &response)
+	if err != nil {
+		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Error unmarshalling: %s", err))
+		return
+	}
+
+	data.AppToken = types.StringValue(response.AppToken)
+
+	var state GotifyAppResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Image.IsNull() && !data.Image.Equal(state.Image) {
+		err = uploadGotifyAppImage(ctx, conn, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Image Upload Failed", err.Error())
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -220,13 +355,14 @@ func (r *GotifyAppResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: data.AllowInsecure.ValueBool()},
-		},
+	conn, err := resolveGotifyConn(r.cloudInventory.Gotify, data.gotifyConfig())
+	if err != nil {
+		resp.Diagnostics.AddError("Gotify Config Error", err.Error())
+		return
 	}
+	client := conn.client()
 
-	postUrl := fmt.Sprintf("https://%s/application/%d", data.GotifyHost.ValueString(), data.AppId.ValueInt64())
+	postUrl := conn.url(fmt.Sprintf("/application/%d", data.AppId.ValueInt64()))
 
 	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", postUrl, nil)
 	if err != nil {
@@ -234,7 +370,7 @@ func (r *GotifyAppResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
- 	httpReq.SetBasicAuth("admin", data.GotifyAdminPw.ValueString())
+	httpReq.SetBasicAuth("admin", conn.adminPw)
 
 	httpResp, err := client.Do(httpReq)
 	if err != nil {
@@ -255,5 +391,119 @@ func (r *GotifyAppResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *GotifyAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
\ No newline at end of file
+	appId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected the numeric <app_id> as import id, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appId)...)
+}
+
+// getGotifyApp lists the gotify applications and returns the one matching the app id, nil if it is gone.
+func getGotifyApp(ctx context.Context, conn gotifyConn, appId int64) (*GotifyAppResponse, error) {
+	client := conn.client()
+
+	getUrl := conn.url("/application")
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", getUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.SetBasicAuth("admin", conn.adminPw)
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("list applications failed with code %d, message: %s", httpResp.StatusCode, string(bodyBytes))
+	}
+
+	var apps []GotifyAppResponse
+	err = json.Unmarshal(bodyBytes, &apps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps {
+		if app.Id == appId {
+			return &app, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// gotifyConfig returns the connection attributes of the resource for resolveGotifyConn.
+func (data GotifyAppResourceModel) gotifyConfig() GotifyProviderModel {
+	return GotifyProviderModel{
+		Host:          data.GotifyHost,
+		AdminPw:       data.GotifyAdminPw,
+		AllowInsecure: data.AllowInsecure,
+		CaCertPem:     data.CaCertPem,
+		ClientCertPem: data.ClientCertPem,
+		ClientKeyPem:  data.ClientKeyPem,
+	}
+}
+
+// gotifyAppBody builds the json body for creating and updating an application.
+func gotifyAppBody(data GotifyAppResourceModel) map[string]interface{} {
+	return map[string]interface{}{
+		"name":            data.AppName.ValueString(),
+		"description":     data.Description.ValueString(),
+		"defaultPriority": data.DefaultPriority.ValueInt64(),
+	}
+}
+
+// uploadGotifyAppImage uploads the image file as multipart form to the application.
+func uploadGotifyAppImage(ctx context.Context, conn gotifyConn, data GotifyAppResourceModel) error {
+	image, err := os.ReadFile(data.Image.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filepath.Base(data.Image.ValueString()))
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(image); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	postUrl := conn.url(fmt.Sprintf("/application/%d/image", data.AppId.ValueInt64()))
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", postUrl, &body)
+	if err != nil {
+		return err
+	}
+
+	httpReq.Header.Set("Content-Type", writer.FormDataContentType())
+	httpReq.SetBasicAuth("admin", conn.adminPw)
+
+	httpResp, err := conn.client().Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("image upload failed with code %d, message: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
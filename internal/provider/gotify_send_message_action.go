@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &GotifySendMessageAction{}
+var _ action.ActionWithConfigure = &GotifySendMessageAction{}
+
+func NewGotifySendMessageAction() action.Action {
+	return &GotifySendMessageAction{}
+}
+
+// GotifySendMessageAction defines the action implementation.
+type GotifySendMessageAction struct {
+	cloudInventory CloudInventory
+}
+
+// GotifySendMessageActionModel describes the action data model.
+type GotifySendMessageActionModel struct {
+	GotifyHost    types.String `tfsdk:"gotify_host"`
+	AllowInsecure types.Bool   `tfsdk:"allow_insecure"`
+	CaCertPem     types.String `tfsdk:"ca_cert_pem"`
+	AppToken      types.String `tfsdk:"app_token"`
+	Title         types.String `tfsdk:"title"`
+	Message       types.String `tfsdk:"message"`
+	Priority      types.Int64  `tfsdk:"priority"`
+}
+
+func (a *GotifySendMessageAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gotify_send_message"
+}
+
+func (a *GotifySendMessageAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a message through a gotify app, useful to verify the notification chain after creating a pxc_gotify_app and pxc_pve_gotify_target.",
+
+		Attributes: map[string]schema.Attribute{
+			"gotify_host": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Gotify host to connect to (e.g. gotify.example.com), defaults to the host of the provider gotify block.",
+			},
+			"allow_insecure": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Disables tls verification, defaults to the allow_insecure of the provider gotify block.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded ca certificate to verify the gotify server against, defaults to the ca_cert_pem of the provider gotify block.",
+			},
+			"app_token": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Token of the gotify app to send the message as, e.g. pxc_gotify_app.app_token.",
+			},
+			"title": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Title of the message.",
+			},
+			"message": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Message body.",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Priority of the message, the default priority of the app is used if not set.",
+			},
+		},
+	}
+}
+
+func (a *GotifySendMessageAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *GotifySendMessageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data GotifySendMessageActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := resolveGotifyConn(a.cloudInventory.Gotify, GotifyProviderModel{Host: data.GotifyHost, AllowInsecure: data.AllowInsecure, CaCertPem: data.CaCertPem})
+	if err != nil {
+		resp.Diagnostics.AddError("Gotify Config Error", err.Error())
+		return
+	}
+
+	message := map[string]interface{}{
+		"title":   data.Title.ValueString(),
+		"message": data.Message.ValueString(),
+	}
+	if !data.Priority.IsNull() {
+		message["priority"] = data.Priority.ValueInt64()
+	}
+	body, _ := json.Marshal(message)
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", conn.url("/message"), bytes.NewBuffer(body))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Gotify-Key", data.AppToken.ValueString())
+
+	httpResp, err := conn.client().Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Request error", fmt.Sprintf("Error calling gotify: %s", err))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError(
+			"Send Failed",
+			fmt.Sprintf("Sending message failed with code %d, message: %s", httpResp.StatusCode, string(respBody)),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Message sent to %s", conn.host)})
+}
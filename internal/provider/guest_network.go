@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+)
+
+// guestInterface is a network interface of a guest as reported by the qemu guest agent or lxc.
+type guestInterface struct {
+	Name        string
+	MacAddress  string
+	IpAddresses []string // in cidr notation
+}
+
+// qemu guest agent network-get-interfaces output
+type qemuAgentInterfaces struct {
+	Result []struct {
+		Name        string `json:"name"`
+		MacAddress  string `json:"hardware-address"`
+		IpAddresses []struct {
+			Address string `json:"ip-address"`
+			Prefix  int    `json:"prefix"`
+		} `json:"ip-addresses"`
+	} `json:"result"`
+}
+
+// lxc interfaces output, inet and inet6 are in cidr notation
+type lxcInterface struct {
+	Name   string `json:"name"`
+	Hwaddr string `json:"hwaddr"`
+	Inet   string `json:"inet"`
+	Inet6  string `json:"inet6"`
+}
+
+// getGuestInterfaces reads the interfaces of a running guest, loopback interfaces are skipped.
+// For qemu vms this requires the guest agent.
+func getGuestInterfaces(ctx context.Context, client pb.CloudServiceClient, targetPve string, node string, guestType string, vmid int64) ([]guestInterface, error) {
+	var interfaces []guestInterface
+
+	switch guestType {
+	case "qemu":
+		var agentResp qemuAgentInterfaces
+		err := getPveApiJson(ctx, client, targetPve, fmt.Sprintf("/nodes/%s/qemu/%d/agent/network-get-interfaces", node, vmid), &agentResp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, agentIface := range agentResp.Result {
+			iface := guestInterface{Name: agentIface.Name, MacAddress: agentIface.MacAddress}
+			for _, ip := range agentIface.IpAddresses {
+				iface.IpAddresses = append(iface.IpAddresses, fmt.Sprintf("%s/%d", ip.Address, ip.Prefix))
+			}
+			interfaces = append(interfaces, iface)
+		}
+	case "lxc":
+		var lxcResp []lxcInterface
+		err := getPveApiJson(ctx, client, targetPve, fmt.Sprintf("/nodes/%s/lxc/%d/interfaces", node, vmid), &lxcResp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lxcIface := range lxcResp {
+			iface := guestInterface{Name: lxcIface.Name, MacAddress: lxcIface.Hwaddr}
+			for _, cidr := range []string{lxcIface.Inet, lxcIface.Inet6} {
+				if cidr != "" {
+					iface.IpAddresses = append(iface.IpAddresses, cidr)
+				}
+			}
+			interfaces = append(interfaces, iface)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported guest type %s", guestType)
+	}
+
+	// drop loopback
+	filtered := []guestInterface{}
+	for _, iface := range interfaces {
+		if iface.Name != "lo" && !strings.HasPrefix(iface.Name, "Loopback") {
+			filtered = append(filtered, iface)
+		}
+	}
+
+	return filtered, nil
+}
+
+// guestIpAddresses returns the global unicast addresses of the interfaces without prefix.
+func guestIpAddresses(interfaces []guestInterface) []string {
+	addresses := []string{}
+	for _, iface := range interfaces {
+		for _, cidr := range iface.IpAddresses {
+			ip, _, err := net.ParseCIDR(cidr)
+			if err == nil && ip.IsGlobalUnicast() {
+				addresses = append(addresses, ip.String())
+			}
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HaGroupResource{}
+var _ resource.ResourceWithImportState = &HaGroupResource{}
+
+func NewHaGroupResource() resource.Resource {
+	return &HaGroupResource{}
+}
+
+// HaGroupResource defines the resource implementation.
+type HaGroupResource struct {
+	cloudInventory CloudInventory
+}
+
+// HaGroupResourceModel describes the resource data model.
+type HaGroupResourceModel struct {
+	Group      types.String     `tfsdk:"group"`
+	Nodes      map[string]int64 `tfsdk:"nodes"`
+	Restricted types.Bool       `tfsdk:"restricted"`
+	NoFailback types.Bool       `tfsdk:"nofailback"`
+	Comment    types.String     `tfsdk:"comment"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// HaGroup is the subset of pvesh get /cluster/ha/groups/{group} we manage.
+type HaGroup struct {
+	Nodes      string  `json:"nodes"`
+	Restricted pveBool `json:"restricted"`
+	NoFailback pveBool `json:"nofailback"`
+	Comment    string  `json:"comment"`
+}
+
+func (r *HaGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_group"
+}
+
+func (r *HaGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a high availability group, which restricts and orders the nodes ha resources run on. Import with `<group>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the ha group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nodes": schema.MapAttribute{
+				ElementType:         types.Int64Type,
+				Required:            true,
+				MarkdownDescription: "Member nodes mapped to their priority, resources run on the available node with the highest priority. Use 0 for nodes without priority.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueInt64sAre(int64validator.Between(0, 1000)),
+				},
+			},
+			"restricted": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Resources of the group only run on member nodes and are stopped if none is available.",
+			},
+			"nofailback": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Resources don't move back to a higher priority node once it comes back online.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the group.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *HaGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// nodesSpec builds the `node[:priority],...` list of the group.
+func (data HaGroupResourceModel) nodesSpec() string {
+	nodes := make([]string, 0, len(data.Nodes))
+	for node, priority := range data.Nodes {
+		if priority > 0 {
+			nodes = append(nodes, fmt.Sprintf("%s:%d", node, priority))
+		} else {
+			nodes = append(nodes, node)
+		}
+	}
+	// sorted for stable api calls
+	sort.Strings(nodes)
+	return strings.Join(nodes, ",")
+}
+
+// parseHaNodes parses the `node[:priority],...` list of a group.
+func parseHaNodes(spec string) map[string]int64 {
+	nodes := map[string]int64{}
+	for _, entry := range splitPveList(spec) {
+		node, priorityStr, _ := strings.Cut(strings.TrimSpace(entry), ":")
+		priority, _ := strconv.ParseInt(priorityStr, 10, 64)
+		nodes[node] = priority
+	}
+	return nodes
+}
+
+// groupArgs returns the pvesh args of the group without its name.
+func (data HaGroupResourceModel) groupArgs() map[string]string {
+	args := map[string]string{
+		"--nodes":      data.nodesSpec(),
+		"--restricted": boolToPve(data.Restricted.ValueBool()),
+		"--nofailback": boolToPve(data.NoFailback.ValueBool()),
+	}
+	if !data.Comment.IsNull() {
+		args["--comment"] = data.Comment.ValueString()
+	}
+	return args
+}
+
+func (r *HaGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HaGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := data.groupArgs()
+	createArgs["--group"] = data.Group.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/ha/groups", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating ha group, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HaGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HaGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/ha/groups", "group", data.Group.ValueString())
+	if removeIfMissing(ctx, exists, err, "ha group", resp) {
+		return
+	}
+
+	var group HaGroup
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/ha/groups/%s", data.Group.ValueString()), &group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ha group, got error: %s", err))
+		return
+	}
+
+	data.Nodes = parseHaNodes(group.Nodes)
+	data.Restricted = types.BoolValue(bool(group.Restricted))
+	data.NoFailback = types.BoolValue(bool(group.NoFailback))
+	data.Comment = optionalString(group.Comment)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HaGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HaGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs := data.groupArgs()
+	if data.Comment.IsNull() {
+		setArgs["--delete"] = "comment"
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/ha/groups/%s", data.Group.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating ha group, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HaGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HaGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to delete groups that still have resources
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/ha/groups/%s", data.Group.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting ha group, got error: %s", err))
+		return
+	}
+}
+
+func (r *HaGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group"), req, resp)
+}
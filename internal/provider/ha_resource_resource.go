@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HaResourceResource{}
+var _ resource.ResourceWithImportState = &HaResourceResource{}
+
+// ha resource ids, vm:<vmid> or ct:<vmid>
+var haSidRe = regexp.MustCompile(`^(vm|ct):\d+$`)
+
+func NewHaResourceResource() resource.Resource {
+	return &HaResourceResource{}
+}
+
+// HaResourceResource defines the resource implementation.
+type HaResourceResource struct {
+	cloudInventory CloudInventory
+}
+
+// HaResourceResourceModel describes the resource data model.
+type HaResourceResourceModel struct {
+	Sid         types.String `tfsdk:"sid"`
+	Group       types.String `tfsdk:"group"`
+	State       types.String `tfsdk:"state"`
+	MaxRestart  types.Int64  `tfsdk:"max_restart"`
+	MaxRelocate types.Int64  `tfsdk:"max_relocate"`
+	Comment     types.String `tfsdk:"comment"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// HaResource is the subset of pvesh get /cluster/ha/resources/{sid} we manage.
+type HaResource struct {
+	Group       string      `json:"group"`
+	State       string      `json:"state"`
+	MaxRestart  json.Number `json:"max_restart"`
+	MaxRelocate json.Number `json:"max_relocate"`
+	Comment     string      `json:"comment"`
+}
+
+func (r *HaResourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_resource"
+}
+
+func (r *HaResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enrolls a vm or lxc container in high availability, the ha manager then restarts or relocates it on failures. Import with `<sid>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"sid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the ha resource, `vm:<vmid>` for qemu vms or `ct:<vmid>` for lxc containers.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(haSidRe, "must be vm:<vmid> or ct:<vmid>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ha group restricting the nodes of the resource.",
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("started"),
+				MarkdownDescription: "Requested state, one of started, stopped, disabled or ignored.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("started", "stopped", "disabled", "ignored"),
+				},
+			},
+			"max_restart": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Restart attempts on the same node after a failed start.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"max_relocate": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Relocations to other nodes after the restarts failed.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the ha resource.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *HaResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// resourceArgs returns the pvesh args of the ha resource without the sid, together
+// with the optional keys that are unset.
+func (data HaResourceResourceModel) resourceArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--state":        data.State.ValueString(),
+		"--max_restart":  strconv.FormatInt(data.MaxRestart.ValueInt64(), 10),
+		"--max_relocate": strconv.FormatInt(data.MaxRelocate.ValueInt64(), 10),
+	}
+	deletes := []string{}
+
+	if !data.Group.IsNull() {
+		args["--group"] = data.Group.ValueString()
+	} else {
+		deletes = append(deletes, "group")
+	}
+	if !data.Comment.IsNull() {
+		args["--comment"] = data.Comment.ValueString()
+	} else {
+		deletes = append(deletes, "comment")
+	}
+
+	return args, deletes
+}
+
+func (r *HaResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HaResourceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.resourceArgs()
+	createArgs["--sid"] = data.Sid.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/ha/resources", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating ha resource, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HaResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HaResourceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/ha/resources", "sid", data.Sid.ValueString())
+	if removeIfMissing(ctx, exists, err, "ha resource", resp) {
+		return
+	}
+
+	var haResource HaResource
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/ha/resources/%s", data.Sid.ValueString()), &haResource)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ha resource, got error: %s", err))
+		return
+	}
+
+	data.Group = optionalString(haResource.Group)
+	data.Comment = optionalString(haResource.Comment)
+	// pve reports the legacy enabled state for started resources
+	if haResource.State != "" && haResource.State != "enabled" {
+		data.State = types.StringValue(haResource.State)
+	}
+	if maxRestart, err := haResource.MaxRestart.Int64(); err == nil {
+		data.MaxRestart = types.Int64Value(maxRestart)
+	}
+	if maxRelocate, err := haResource.MaxRelocate.Int64(); err == nil {
+		data.MaxRelocate = types.Int64Value(maxRelocate)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HaResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HaResourceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// unset optional values have to be deleted explicitly
+	setArgs, deletes := data.resourceArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/ha/resources/%s", data.Sid.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating ha resource, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HaResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HaResourceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the guest itself keeps running, it is only no longer managed by ha
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/ha/resources/%s", data.Sid.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting ha resource, got error: %s", err))
+		return
+	}
+}
+
+func (r *HaResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("sid"), req, resp)
+}
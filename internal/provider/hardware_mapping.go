@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+)
+
+// ids of hardware mappings, guests reference them with mapping=<id>
+var hardwareMappingIdRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_\-]*$`)
+
+// pveHardwareMapping is the subset of /cluster/mapping/{pci,usb}/{id} we manage.
+type pveHardwareMapping struct {
+	Map         []string `json:"map"`
+	Description string   `json:"description"`
+	Mdev        pveBool  `json:"mdev"`
+}
+
+// hardwareMappingPath returns the api path of a pci or usb mapping.
+func hardwareMappingPath(kind string, id string) string {
+	return fmt.Sprintf("/cluster/mapping/%s/%s", kind, id)
+}
+
+// readHardwareMapping reads a pci or usb mapping, nil if it doesn't exist.
+func readHardwareMapping(ctx context.Context, client pb.CloudServiceClient, targetPve string, kind string, id string) (*pveHardwareMapping, error) {
+	exists, err := pveApiEntryExists(ctx, client, targetPve, "/cluster/mapping/"+kind, "id", id)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	var mapping pveHardwareMapping
+	err = getPveApiJson(ctx, client, targetPve, hardwareMappingPath(kind, id), &mapping)
+	if err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HardwareMappingPciResource{}
+var _ resource.ResourceWithImportState = &HardwareMappingPciResource{}
+
+// pci addresses with or without function, the latter maps all functions of the device
+var pciPathRe = regexp.MustCompile(`^([0-9a-fA-F]{4}:)?[0-9a-fA-F]{2}:[0-9a-fA-F]{2}(\.[0-7])?$`)
+
+// vendor:device ids as shown by lspci -n and lsusb
+var hardwareIdRe = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`)
+
+func NewHardwareMappingPciResource() resource.Resource {
+	return &HardwareMappingPciResource{}
+}
+
+// HardwareMappingPciResource defines the resource implementation.
+type HardwareMappingPciResource struct {
+	cloudInventory CloudInventory
+}
+
+// HardwareMappingPciResourceModel describes the resource data model.
+type HardwareMappingPciResourceModel struct {
+	Id          types.String             `tfsdk:"id"`
+	Description types.String             `tfsdk:"description"`
+	Mdev        types.Bool               `tfsdk:"mdev"`
+	Map         []HardwareMappingPciNode `tfsdk:"map"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// HardwareMappingPciNode describes the device of a node.
+type HardwareMappingPciNode struct {
+	Node        types.String `tfsdk:"node"`
+	Path        types.String `tfsdk:"path"`
+	DeviceId    types.String `tfsdk:"device_id"`
+	SubsystemId types.String `tfsdk:"subsystem_id"`
+	IommuGroup  types.Int64  `tfsdk:"iommu_group"`
+}
+
+func (r *HardwareMappingPciResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hardware_mapping_pci"
+}
+
+func (r *HardwareMappingPciResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a cluster wide pci mapping, e.g. of a gpu, that vms pass through with `hostpciN: mapping=<id>` on whichever node they run. " +
+			"Import with `<id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the mapping.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hardwareMappingIdRe, "must start with a letter and only contain letters, digits, - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the mapping.",
+			},
+			"mdev": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Marks the devices as mediated device capable, e.g. vgpus.",
+			},
+			"map": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Devices per node, a node can map multiple devices that guests pick from.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Node the device is in.",
+						},
+						"path": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Pci address, e.g. `0000:01:00.0`. Without function, e.g. `0000:01:00`, all functions are mapped.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(pciPathRe, "must be a pci address"),
+							},
+						},
+						"device_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Vendor and device id, e.g. `10de:1b80`. Proxmox refuses to pass through the mapping if the device at path changed.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(hardwareIdRe, "must be <vendor>:<device> in hex"),
+							},
+						},
+						"subsystem_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Subsystem vendor and device id, checked like device_id.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(hardwareIdRe, "must be <vendor>:<device> in hex"),
+							},
+						},
+						"iommu_group": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Expected iommu group of the device, checked like device_id.",
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *HardwareMappingPciResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// mappingArgs returns the pvesh args of the mapping without its id.
+func (data HardwareMappingPciResourceModel) mappingArgs() map[string]string {
+	// one --map arg per node device
+	entries := []string{}
+	for _, node := range data.Map {
+		entry := fmt.Sprintf("node=%s,path=%s,id=%s", node.Node.ValueString(), node.Path.ValueString(), node.DeviceId.ValueString())
+		if !node.SubsystemId.IsNull() {
+			entry += ",subsystem-id=" + node.SubsystemId.ValueString()
+		}
+		if !node.IommuGroup.IsNull() {
+			entry += fmt.Sprintf(",iommugroup=%d", node.IommuGroup.ValueInt64())
+		}
+		entries = append(entries, entry)
+	}
+
+	args := map[string]string{
+		"--map":  strings.Join(entries, "\n"),
+		"--mdev": boolToPve(data.Mdev.ValueBool()),
+	}
+	if !data.Description.IsNull() {
+		args["--description"] = data.Description.ValueString()
+	}
+	return args
+}
+
+func (r *HardwareMappingPciResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HardwareMappingPciResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := data.mappingArgs()
+	createArgs["--id"] = data.Id.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/mapping/pci", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating pci mapping, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HardwareMappingPciResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HardwareMappingPciResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	mapping, err := readHardwareMapping(ctx, client, r.cloudInventory.TargetPve, "pci", data.Id.ValueString())
+	if removeIfMissing(ctx, mapping != nil, err, "pci mapping", resp) {
+		return
+	}
+
+	data.Description = optionalString(mapping.Description)
+	data.Mdev = types.BoolValue(bool(mapping.Mdev))
+
+	data.Map = []HardwareMappingPciNode{}
+	for _, entry := range mapping.Map {
+		_, props := parsePveProps(entry)
+		node := HardwareMappingPciNode{
+			Node:        types.StringValue(props["node"]),
+			Path:        types.StringValue(props["path"]),
+			DeviceId:    types.StringValue(props["id"]),
+			SubsystemId: optionalString(props["subsystem-id"]),
+			IommuGroup:  types.Int64Null(),
+		}
+		if group, err := strconv.ParseInt(props["iommugroup"], 10, 64); err == nil {
+			node.IommuGroup = types.Int64Value(group)
+		}
+		data.Map = append(data.Map, node)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HardwareMappingPciResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HardwareMappingPciResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs := data.mappingArgs()
+	if data.Description.IsNull() {
+		setArgs["--delete"] = "description"
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, hardwareMappingPath("pci", data.Id.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating pci mapping, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HardwareMappingPciResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HardwareMappingPciResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, hardwareMappingPath("pci", data.Id.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting pci mapping, got error: %s", err))
+		return
+	}
+}
+
+func (r *HardwareMappingPciResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HardwareMappingUsbResource{}
+var _ resource.ResourceWithImportState = &HardwareMappingUsbResource{}
+
+// usb ports as <bus>-<port>[.<port>...]
+var usbPathRe = regexp.MustCompile(`^[0-9]+-[0-9]+(\.[0-9]+)*$`)
+
+func NewHardwareMappingUsbResource() resource.Resource {
+	return &HardwareMappingUsbResource{}
+}
+
+// HardwareMappingUsbResource defines the resource implementation.
+type HardwareMappingUsbResource struct {
+	cloudInventory CloudInventory
+}
+
+// HardwareMappingUsbResourceModel describes the resource data model.
+type HardwareMappingUsbResourceModel struct {
+	Id          types.String             `tfsdk:"id"`
+	Description types.String             `tfsdk:"description"`
+	Map         []HardwareMappingUsbNode `tfsdk:"map"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// HardwareMappingUsbNode describes the device of a node.
+type HardwareMappingUsbNode struct {
+	Node     types.String `tfsdk:"node"`
+	DeviceId types.String `tfsdk:"device_id"`
+	Path     types.String `tfsdk:"path"`
+}
+
+func (r *HardwareMappingUsbResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hardware_mapping_usb"
+}
+
+func (r *HardwareMappingUsbResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a cluster wide usb mapping, e.g. of a license dongle, that vms pass through with `usbN: mapping=<id>` on whichever node they run. " +
+			"Import with `<id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the mapping.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hardwareMappingIdRe, "must start with a letter and only contain letters, digits, - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the mapping.",
+			},
+			"map": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Device per node.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Node the device is in.",
+						},
+						"device_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Vendor and device id as shown by lsusb, e.g. `0529:0001`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(hardwareIdRe, "must be <vendor>:<device> in hex"),
+							},
+						},
+						"path": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Usb port, e.g. `1-2.3`, to map whatever device is plugged in there instead of the device with device_id.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(usbPathRe, "must be a usb port like 1-2.3"),
+							},
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *HardwareMappingUsbResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// mappingArgs returns the pvesh args of the mapping without its id.
+func (data HardwareMappingUsbResourceModel) mappingArgs() map[string]string {
+	// one --map arg per node device
+	entries := []string{}
+	for _, node := range data.Map {
+		entry := fmt.Sprintf("node=%s,id=%s", node.Node.ValueString(), node.DeviceId.ValueString())
+		if !node.Path.IsNull() {
+			entry += ",path=" + node.Path.ValueString()
+		}
+		entries = append(entries, entry)
+	}
+
+	args := map[string]string{
+		"--map": strings.Join(entries, "\n"),
+	}
+	if !data.Description.IsNull() {
+		args["--description"] = data.Description.ValueString()
+	}
+	return args
+}
+
+func (r *HardwareMappingUsbResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HardwareMappingUsbResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := data.mappingArgs()
+	createArgs["--id"] = data.Id.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/mapping/usb", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating usb mapping, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HardwareMappingUsbResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HardwareMappingUsbResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	mapping, err := readHardwareMapping(ctx, client, r.cloudInventory.TargetPve, "usb", data.Id.ValueString())
+	if removeIfMissing(ctx, mapping != nil, err, "usb mapping", resp) {
+		return
+	}
+
+	data.Description = optionalString(mapping.Description)
+
+	data.Map = []HardwareMappingUsbNode{}
+	for _, entry := range mapping.Map {
+		_, props := parsePveProps(entry)
+		data.Map = append(data.Map, HardwareMappingUsbNode{
+			Node:     types.StringValue(props["node"]),
+			DeviceId: types.StringValue(props["id"]),
+			Path:     optionalString(props["path"]),
+		})
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HardwareMappingUsbResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HardwareMappingUsbResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs := data.mappingArgs()
+	if data.Description.IsNull() {
+		setArgs["--delete"] = "description"
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, hardwareMappingPath("usb", data.Id.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating usb mapping, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HardwareMappingUsbResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HardwareMappingUsbResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, hardwareMappingPath("usb", data.Id.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting usb mapping, got error: %s", err))
+		return
+	}
+}
+
+func (r *HardwareMappingUsbResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
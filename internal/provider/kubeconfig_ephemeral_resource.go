@@ -74,7 +74,7 @@ func (r *KubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.Op
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
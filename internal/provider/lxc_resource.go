@@ -0,0 +1,691 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LxcResource{}
+var _ resource.ResourceWithImportState = &LxcResource{}
+
+func NewLxcResource() resource.Resource {
+	return &LxcResource{}
+}
+
+// LxcResource defines the resource implementation.
+type LxcResource struct {
+	cloudInventory CloudInventory
+}
+
+// LxcResourceModel describes the resource data model.
+type LxcResourceModel struct {
+	VmId          types.Int64       `tfsdk:"vmid"`
+	Node          types.String      `tfsdk:"node"`
+	Hostname      types.String      `tfsdk:"hostname"`
+	OsTemplate    types.String      `tfsdk:"ostemplate"`
+	Unprivileged  types.Bool        `tfsdk:"unprivileged"`
+	Cores         types.Int64       `tfsdk:"cores"`
+	Memory        types.Int64       `tfsdk:"memory"`
+	Started       types.Bool        `tfsdk:"started"`
+	Tags          []string          `tfsdk:"tags"`
+	SshPublicKeys []string          `tfsdk:"ssh_public_keys"`
+	RootFs        *LxcRootFsModel   `tfsdk:"rootfs"`
+	Networks      []LxcNetworkModel `tfsdk:"networks"`
+	Features      *LxcFeaturesModel `tfsdk:"features"`
+	Wait          types.Bool        `tfsdk:"wait_for_completion"`
+	Timeout       types.Int64       `tfsdk:"timeout"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// LxcRootFsModel describes the root disk of the container.
+type LxcRootFsModel struct {
+	Storage types.String `tfsdk:"storage"`
+	Size    types.Int64  `tfsdk:"size"`
+}
+
+// LxcNetworkModel describes a nic of the container, the list index maps to netX.
+type LxcNetworkModel struct {
+	Name     types.String `tfsdk:"name"`
+	Bridge   types.String `tfsdk:"bridge"`
+	Ip       types.String `tfsdk:"ip"`
+	Gateway  types.String `tfsdk:"gateway"`
+	VlanTag  types.Int64  `tfsdk:"vlan_tag"`
+	Firewall types.Bool   `tfsdk:"firewall"`
+}
+
+// LxcFeaturesModel describes the enabled container features.
+type LxcFeaturesModel struct {
+	Nesting types.Bool `tfsdk:"nesting"`
+	Keyctl  types.Bool `tfsdk:"keyctl"`
+	Fuse    types.Bool `tfsdk:"fuse"`
+}
+
+func (r *LxcResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lxc"
+}
+
+func (r *LxcResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a lxc container on the target_pve cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique vmid of the container.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the container runs on. Changing it migrates the container, running containers are restarted on the target. Migrations done outside of terraform, e.g. by ha, show up as drift.",
+			},
+			"hostname": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Hostname of the container.",
+			},
+			"ostemplate": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Template volume the container is created from, e.g. `local:vztmpl/debian-12-standard_12.7-1_amd64.tar.zst`. Proxmox doesn't keep it in the config, imported containers need `ignore_changes` on it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unprivileged": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Run the container unprivileged.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"cores": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Number of cpu cores.",
+			},
+			"memory": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(512),
+				MarkdownDescription: "Memory in MiB.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Waits for the create, power and destroy tasks to finish and fails if they exit with an error.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				MarkdownDescription: "Seconds to wait for a task to finish.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"started": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the container should be running.",
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Proxmox tags of the container.",
+			},
+			"ssh_public_keys": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Public ssh keys authorized for root, only applied on creation.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"rootfs": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Root disk of the container.",
+				Attributes: map[string]schema.Attribute{
+					"storage": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Proxmox storage the root disk is allocated on.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"size": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "Size of the root disk in GiB. The disk can only grow.",
+					},
+				},
+			},
+			"networks": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Network interfaces of the container, the list index maps to net0, net1, ...",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Interface name inside the container, e.g. `eth0`.",
+						},
+						"bridge": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Bridge the nic is attached to.",
+						},
+						"ip": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("dhcp"),
+							MarkdownDescription: "Ipv4 address in cidr notation, `dhcp` or `manual`.",
+						},
+						"gateway": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Ipv4 gateway.",
+						},
+						"vlan_tag": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Vlan tag of the nic.",
+						},
+						"firewall": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Enable the proxmox firewall on the nic.",
+						},
+					},
+				},
+			},
+			"features": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Advanced container features, most of them require root@pam.",
+				Attributes: map[string]schema.Attribute{
+					"nesting": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Allow nested containers, needed for systemd in recent distributions.",
+					},
+					"keyctl": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Allow the keyctl() syscall, needed for docker in unprivileged containers.",
+					},
+					"fuse": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: "Allow fuse mounts.",
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *LxcResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (data *LxcResourceModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/lxc/%d", data.Node.ValueString(), data.VmId.ValueInt64())
+}
+
+// netSpec builds the netX property string, hwaddr is kept if known so proxmox doesn't regenerate it.
+func (nic LxcNetworkModel) netSpec(hwaddr string) string {
+	spec := fmt.Sprintf("name=%s,bridge=%s,ip=%s", nic.Name.ValueString(), nic.Bridge.ValueString(), nic.Ip.ValueString())
+	if hwaddr != "" {
+		spec += ",hwaddr=" + hwaddr
+	}
+	if !nic.Gateway.IsNull() {
+		spec += ",gw=" + nic.Gateway.ValueString()
+	}
+	if !nic.VlanTag.IsNull() {
+		spec += fmt.Sprintf(",tag=%d", nic.VlanTag.ValueInt64())
+	}
+	if nic.Firewall.ValueBool() {
+		spec += ",firewall=1"
+	}
+	return spec
+}
+
+// featuresSpec builds the features property string.
+func (features *LxcFeaturesModel) featuresSpec() string {
+	enabled := []string{}
+	for name, value := range map[string]types.Bool{"nesting": features.Nesting, "keyctl": features.Keyctl, "fuse": features.Fuse} {
+		if value.ValueBool() {
+			enabled = append(enabled, name+"=1")
+		}
+	}
+	sort.Strings(enabled)
+	return strings.Join(enabled, ",")
+}
+
+// setPower starts or stops the container.
+func (r *LxcResource) setPower(ctx context.Context, client pb.CloudServiceClient, data *LxcResourceModel, started bool) error {
+	action := "stop"
+	if started {
+		action = "start"
+	}
+	return pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("%s/status/%s", data.apiPath(), action), map[string]string{}, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+}
+
+// isRunning checks the current power state of the container.
+func (r *LxcResource) isRunning(ctx context.Context, client pb.CloudServiceClient, data *LxcResourceModel) (bool, error) {
+	var status struct {
+		Status string `json:"status"`
+	}
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/status/current", data.apiPath()), &status)
+	if err != nil {
+		return false, err
+	}
+	return status.Status == "running", nil
+}
+
+// migrate moves the container to the target node, running containers are stopped and started
+// again on the target. The task is always awaited since the following calls go to the target node.
+func (r *LxcResource) migrate(ctx context.Context, client pb.CloudServiceClient, data *LxcResourceModel, target string) error {
+	running, err := r.isRunning(ctx, client, data)
+	if err != nil {
+		return err
+	}
+
+	args := map[string]string{"--target": target}
+	if running {
+		args["--restart"] = "1"
+	}
+	return pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("%s/migrate", data.apiPath()), args, true, data.Timeout.ValueInt64())
+}
+
+func (r *LxcResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LxcResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{
+		"--vmid":         strconv.FormatInt(data.VmId.ValueInt64(), 10),
+		"--hostname":     data.Hostname.ValueString(),
+		"--ostemplate":   data.OsTemplate.ValueString(),
+		"--unprivileged": boolToPve(data.Unprivileged.ValueBool()),
+		"--cores":        strconv.FormatInt(data.Cores.ValueInt64(), 10),
+		"--memory":       strconv.FormatInt(data.Memory.ValueInt64(), 10),
+		"--rootfs":       fmt.Sprintf("%s:%d", data.RootFs.Storage.ValueString(), data.RootFs.Size.ValueInt64()),
+	}
+	if len(data.Tags) > 0 {
+		createArgs["--tags"] = strings.Join(data.Tags, ";")
+	}
+	if len(data.SshPublicKeys) > 0 {
+		createArgs["--ssh-public-keys"] = strings.Join(data.SshPublicKeys, "\n")
+	}
+	for i, nic := range data.Networks {
+		createArgs[fmt.Sprintf("--net%d", i)] = nic.netSpec("")
+	}
+	if data.Features != nil && data.Features.featuresSpec() != "" {
+		createArgs["--features"] = data.Features.featuresSpec()
+	}
+
+	// perform the request
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/lxc", data.Node.ValueString()), createArgs, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to create container, got error: %s", err))
+		return
+	}
+
+	if data.Started.ValueBool() {
+		err = r.setPower(ctx, client, &data, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to start container, got error: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LxcResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LxcResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the container might have been migrated, resolve its current node
+	guest, err := findPveGuest(ctx, client, r.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if removeIfMissing(ctx, guest != nil && guest.Type == "lxc", err, "container", resp) {
+		return
+	}
+	data.Node = types.StringValue(guest.Node)
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read container config, got error: %s", err))
+		return
+	}
+
+	running, err := r.isRunning(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read container status, got error: %s", err))
+		return
+	}
+
+	data.readConfig(config)
+	data.Started = types.BoolValue(running)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readConfig refreshes the model from a pvesh get /nodes/{node}/lxc/{vmid}/config response.
+func (data *LxcResourceModel) readConfig(config map[string]interface{}) {
+	if hostname, ok := pveConfigString(config, "hostname"); ok {
+		data.Hostname = types.StringValue(hostname)
+	}
+	if unprivileged, ok := pveConfigInt(config, "unprivileged"); ok {
+		data.Unprivileged = types.BoolValue(unprivileged == 1)
+	} else {
+		data.Unprivileged = types.BoolValue(false)
+	}
+	if cores, ok := pveConfigInt(config, "cores"); ok {
+		data.Cores = types.Int64Value(cores)
+	} else {
+		data.Cores = types.Int64Value(1)
+	}
+	if memory, ok := pveConfigInt(config, "memory"); ok {
+		data.Memory = types.Int64Value(memory)
+	} else {
+		data.Memory = types.Int64Value(512)
+	}
+
+	data.Tags = nil
+	if tags, ok := pveConfigString(config, "tags"); ok && tags != "" {
+		data.Tags = strings.Split(tags, ";")
+	}
+
+	if rootfs, ok := pveConfigString(config, "rootfs"); ok {
+		volume, props := parsePveProps(rootfs)
+		storage, _, _ := strings.Cut(volume, ":")
+		data.RootFs = &LxcRootFsModel{Storage: types.StringValue(storage), Size: types.Int64Value(parsePveSizeGiB(props["size"]))}
+	}
+
+	// nics, the list index maps to netX
+	netKeys := []string{}
+	for key := range config {
+		if vmNetRe.MatchString(key) {
+			netKeys = append(netKeys, key)
+		}
+	}
+	sort.Slice(netKeys, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.TrimPrefix(netKeys[i], "net"))
+		b, _ := strconv.Atoi(strings.TrimPrefix(netKeys[j], "net"))
+		return a < b
+	})
+	var nics []LxcNetworkModel
+	for _, key := range netKeys {
+		_, props := parsePveProps(fmt.Sprint(config[key]))
+		nic := LxcNetworkModel{
+			Name:     types.StringValue(props["name"]),
+			Bridge:   types.StringValue(props["bridge"]),
+			Ip:       types.StringValue(props["ip"]),
+			Gateway:  types.StringNull(),
+			VlanTag:  types.Int64Null(),
+			Firewall: types.BoolValue(props["firewall"] == "1"),
+		}
+		if gw, ok := props["gw"]; ok {
+			nic.Gateway = types.StringValue(gw)
+		}
+		if tag, err := strconv.ParseInt(props["tag"], 10, 64); err == nil {
+			nic.VlanTag = types.Int64Value(tag)
+		}
+		nics = append(nics, nic)
+	}
+	data.Networks = nics
+
+	featuresSpec, ok := pveConfigString(config, "features")
+	if !ok || featuresSpec == "" {
+		// keep an explicitly configured all disabled block
+		if data.Features != nil && data.Features.featuresSpec() == "" {
+			return
+		}
+		data.Features = nil
+		return
+	}
+	_, props := parsePveProps(featuresSpec)
+	data.Features = &LxcFeaturesModel{
+		Nesting: types.BoolValue(props["nesting"] == "1"),
+		Keyctl:  types.BoolValue(props["keyctl"] == "1"),
+		Fuse:    types.BoolValue(props["fuse"] == "1"),
+	}
+}
+
+func (r *LxcResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LxcResourceModel
+	var state LxcResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	if state.RootFs != nil && data.RootFs.Size.ValueInt64() < state.RootFs.Size.ValueInt64() {
+		resp.Diagnostics.AddError("Unsupported Change", "Shrinking the rootfs is not supported.")
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	if !data.Node.Equal(state.Node) {
+		err = r.migrate(ctx, client, &state, data.Node.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to migrate container to %s, got error: %s", data.Node.ValueString(), err))
+			return
+		}
+	}
+
+	// current config to preserve nic mac addresses
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read container config, got error: %s", err))
+		return
+	}
+
+	setArgs := map[string]string{
+		"--hostname": data.Hostname.ValueString(),
+		"--cores":    strconv.FormatInt(data.Cores.ValueInt64(), 10),
+		"--memory":   strconv.FormatInt(data.Memory.ValueInt64(), 10),
+	}
+	deletes := []string{}
+
+	if len(data.Tags) > 0 {
+		setArgs["--tags"] = strings.Join(data.Tags, ";")
+	} else {
+		deletes = append(deletes, "tags")
+	}
+
+	for i, nic := range data.Networks {
+		key := fmt.Sprintf("net%d", i)
+		current, _ := pveConfigString(config, key)
+		_, props := parsePveProps(current)
+		setArgs["--"+key] = nic.netSpec(props["hwaddr"])
+	}
+	for i := len(data.Networks); i < len(state.Networks); i++ {
+		deletes = append(deletes, fmt.Sprintf("net%d", i))
+	}
+
+	if data.Features != nil && data.Features.featuresSpec() != "" {
+		setArgs["--features"] = data.Features.featuresSpec()
+	} else {
+		deletes = append(deletes, "features")
+	}
+
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to update container config, got error: %s", err))
+		return
+	}
+
+	if state.RootFs == nil || data.RootFs.Size.ValueInt64() > state.RootFs.Size.ValueInt64() {
+		err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/resize", data.apiPath()), map[string]string{
+			"--disk": "rootfs",
+			"--size": fmt.Sprintf("%dG", data.RootFs.Size.ValueInt64()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to resize rootfs, got error: %s", err))
+			return
+		}
+	}
+
+	if data.Started.ValueBool() != state.Started.ValueBool() {
+		err = r.setPower(ctx, client, &data, data.Started.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to change container power state, got error: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LxcResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LxcResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to destroy running containers
+	running, err := r.isRunning(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read container status, got error: %s", err))
+		return
+	}
+	if running {
+		err = r.setPower(ctx, client, &data, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to stop container, got error: %s", err))
+			return
+		}
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", data.apiPath(), nil, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to delete container, got error: %s", err))
+		return
+	}
+}
+
+func (r *LxcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	node, vmId, found := strings.Cut(req.ID, "/")
+	parsedVmId, err := strconv.ParseInt(vmId, 10, 64)
+	if !found || err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format <node>/<vmid>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), node)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), parsedVmId)...)
+	// not part of the pve config, start with the defaults
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(600))...)
+}
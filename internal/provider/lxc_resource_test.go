@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLxcResourceReadResolvesNode(t *testing.T) {
+	config := `{"hostname": "dns-1", "cores": 2, "memory": 1024, "rootfs": "local-lvm:vm-200-disk-0,size=8G"}`
+
+	tests := []struct {
+		name        string
+		resources   string
+		wantRemoved bool
+		wantNode    string
+	}{
+		{
+			name:      "on its node",
+			resources: `[{"type": "lxc", "vmid": 200, "node": "pve1", "status": "running"}]`,
+			wantNode:  "pve1",
+		},
+		{
+			name:      "migrated",
+			resources: `[{"type": "lxc", "vmid": 201, "node": "pve1"}, {"type": "lxc", "vmid": 200, "node": "pve2", "status": "running"}]`,
+			wantNode:  "pve2",
+		},
+		{
+			name:        "missing",
+			resources:   `[{"type": "lxc", "vmid": 201, "node": "pve1"}]`,
+			wantRemoved: true,
+		},
+		{
+			name:        "vmid reused by a vm",
+			resources:   `[{"type": "qemu", "vmid": 200, "node": "pve1"}]`,
+			wantRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &LxcResource{cloudInventory: testInventory(fakePveApi(map[string]string{
+				"/cluster/resources":                                tt.resources,
+				"/nodes/" + tt.wantNode + "/lxc/200/config":         config,
+				"/nodes/" + tt.wantNode + "/lxc/200/status/current": `{"status": "running"}`,
+			}))}
+
+			state := readResource(t, r, map[string]tftypes.Value{
+				"vmid":     tftypes.NewValue(tftypes.Number, 200),
+				"node":     tftypes.NewValue(tftypes.String, "pve1"),
+				"hostname": tftypes.NewValue(tftypes.String, "dns-1"),
+			})
+			if removed := state.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Fatalf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+			if tt.wantRemoved {
+				return
+			}
+
+			var data LxcResourceModel
+			if diags := state.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("state.Get() diagnostics: %v", diags)
+			}
+			if data.Node.ValueString() != tt.wantNode {
+				t.Errorf("node = %s, want %s", data.Node, tt.wantNode)
+			}
+			if data.Cores.ValueInt64() != 2 || data.Memory.ValueInt64() != 1024 {
+				t.Errorf("config read from the wrong node, cores = %s, memory = %s", data.Cores, data.Memory)
+			}
+		})
+	}
+}
+
+func TestLxcResourceMigrate(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		wantArgs map[string]string
+	}{
+		{name: "running", status: "running", wantArgs: map[string]string{"--target": "pve2", "--restart": "1"}},
+		{name: "stopped", status: "stopped", wantArgs: map[string]string{"--target": "pve2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := fakePveApi(map[string]string{"/nodes/pve1/lxc/200/status/current": `{"status": "` + tt.status + `"}`})
+			conn.handlers["CreateProxmoxApi"] = func(req proto.Message) (proto.Message, error) {
+				return &pb.CreateProxmoxApiResponse{Success: true, Output: "UPID:pve1:000A1B2C:0F1E2D3C:67000000:vzmigrate:200:root@pam:"}, nil
+			}
+			conn.handlers["WaitForTask"] = func(req proto.Message) (proto.Message, error) {
+				return &pb.WaitForTaskResponse{Finished: true, ExitStatus: "OK"}, nil
+			}
+			r := &LxcResource{cloudInventory: testInventory(conn)}
+			client, _ := GetCloudRpcService(context.Background(), r.cloudInventory)
+
+			// waits even without wait_for_completion, the update continues on the target node
+			data := LxcResourceModel{VmId: types.Int64Value(200), Node: types.StringValue("pve1"), Wait: types.BoolValue(false), Timeout: types.Int64Value(600)}
+			if err := r.migrate(context.Background(), client, &data, "pve2"); err != nil {
+				t.Fatalf("migrate() error = %s", err)
+			}
+
+			calls := conn.called("CreateProxmoxApi")
+			if len(calls) != 1 {
+				t.Fatalf("got %d create calls, want 1", len(calls))
+			}
+			creq := calls[0].(*pb.CreateProxmoxApiRequest)
+			if creq.ApiPath != "/nodes/pve1/lxc/200/migrate" {
+				t.Errorf("api path = %s", creq.ApiPath)
+			}
+			if !maps.Equal(creq.CreateArgs, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", creq.CreateArgs, tt.wantArgs)
+			}
+			if len(conn.called("WaitForTask")) != 1 {
+				t.Error("migration task wasn't awaited")
+			}
+		})
+	}
+}
+
+func TestLxcResourceCreateSshPublicKeys(t *testing.T) {
+	keys := []string{"ssh-ed25519 AAAAC3Nza1 admin@example.com", "ssh-ed25519 AAAAC3Nza2 ci@example.com"}
+
+	conn := &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+		"CreateProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			return &pb.CreateProxmoxApiResponse{Success: true}, nil
+		},
+	}}
+	r := &LxcResource{cloudInventory: testInventory(conn)}
+
+	keyValues := make([]tftypes.Value, len(keys))
+	for i, key := range keys {
+		keyValues[i] = tftypes.NewValue(tftypes.String, key)
+	}
+	createResource(t, r, map[string]tftypes.Value{
+		"vmid":            tftypes.NewValue(tftypes.Number, 200),
+		"node":            tftypes.NewValue(tftypes.String, "pve1"),
+		"hostname":        tftypes.NewValue(tftypes.String, "dns-1"),
+		"ostemplate":      tftypes.NewValue(tftypes.String, "local:vztmpl/debian-12-standard_12.7-1_amd64.tar.zst"),
+		"ssh_public_keys": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, keyValues),
+		"rootfs": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"storage": tftypes.String, "size": tftypes.Number}}, map[string]tftypes.Value{
+			"storage": tftypes.NewValue(tftypes.String, "local-lvm"),
+			"size":    tftypes.NewValue(tftypes.Number, 8),
+		}),
+		"started": tftypes.NewValue(tftypes.Bool, false),
+	})
+
+	calls := conn.called("CreateProxmoxApi")
+	if len(calls) != 1 {
+		t.Fatalf("got %d create calls, want 1", len(calls))
+	}
+	args := calls[0].(*pb.CreateProxmoxApiRequest).CreateArgs
+	want := strings.Join(keys, "\n")
+	if args["--ssh-public-keys"] != want {
+		t.Errorf("--ssh-public-keys = %q, want %q", args["--ssh-public-keys"], want)
+	}
+
+	// pve takes the keys as one newline separated value
+	command := pveshCommand("create", "/nodes/pve1/lxc", args)
+	if !strings.Contains(command, "'--ssh-public-keys' "+shellQuote(want)) {
+		t.Errorf("pvesh command %q does not pass the keys as one argument", command)
+	}
+}
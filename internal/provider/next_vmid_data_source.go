@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NextVmIdDataSource{}
+
+func NewNextVmIdDataSource() datasource.DataSource {
+	return &NextVmIdDataSource{}
+}
+
+// NextVmIdDataSource defines the data source implementation.
+type NextVmIdDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// NextVmIdDataSourceModel describes the data source data model.
+type NextVmIdDataSourceModel struct {
+	VmId types.Int64 `tfsdk:"vmid"`
+}
+
+func (d *NextVmIdDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_next_vmid"
+}
+
+func (d *NextVmIdDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the next free vmid of the cluster from `/cluster/nextid`. The id isn't reserved, " +
+			"stacks provisioning vms concurrently should allocate ids with `pxc_vmid_range` instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Lowest vmid not used by any guest, within the next-id range of the datacenter options.",
+			},
+		},
+	}
+}
+
+func (d *NextVmIdDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *NextVmIdDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NextVmIdDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	vmId, err := pveNextVmId(ctx, client, d.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch the next vmid, got error: %s", err))
+		return
+	}
+	data.VmId = types.Int64Value(vmId)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,411 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeAcmeCertificateResource{}
+var _ resource.ResourceWithImportState = &NodeAcmeCertificateResource{}
+
+// pve supports the domains acmedomain0 to acmedomain5 per node
+const maxAcmeDomains = 6
+
+// certificate of the web interface, pve falls back to the self signed pve-ssl.pem without it
+const pveProxyCertificate = "pveproxy-ssl.pem"
+
+func NewNodeAcmeCertificateResource() resource.Resource {
+	return &NodeAcmeCertificateResource{}
+}
+
+// NodeAcmeCertificateResource defines the resource implementation.
+type NodeAcmeCertificateResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeAcmeCertificateResourceModel describes the resource data model.
+type NodeAcmeCertificateResourceModel struct {
+	Node            types.String      `tfsdk:"node"`
+	Account         types.String      `tfsdk:"account"`
+	Domains         []AcmeDomainModel `tfsdk:"domains"`
+	RenewBeforeDays types.Int64       `tfsdk:"renew_before_days"`
+	Timeout         types.Int64       `tfsdk:"timeout"`
+	Fingerprint     types.String      `tfsdk:"fingerprint"`
+	NotAfter        types.String      `tfsdk:"not_after"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// AcmeDomainModel describes a domain of the certificate.
+type AcmeDomainModel struct {
+	Domain types.String `tfsdk:"domain"`
+	Plugin types.String `tfsdk:"plugin"`
+	Alias  types.String `tfsdk:"alias"`
+}
+
+// pveCertificateInfo is an entry of /nodes/{node}/certificates/info.
+type pveCertificateInfo struct {
+	Filename    string `json:"filename"`
+	Fingerprint string `json:"fingerprint"`
+	NotAfter    int64  `json:"notafter"`
+}
+
+func (r *NodeAcmeCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_acme_certificate"
+}
+
+func (r *NodeAcmeCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Orders the certificate of the web interface of a node from the directory of a `pxc_acme_account`. " +
+			"Proxmox renews it itself, additionally a refresh within `renew_before_days` of the expiry plans ordering it again. " +
+			"Destroying the resource revokes the certificate, the node falls back to its self signed certificate. Import with `<node>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the certificate is for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the acme account the certificate is ordered with.",
+			},
+			"domains": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Domains of the certificate, the first one is its common name.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, maxAcmeDomains),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Domain name, e.g. `pve1.example.com`.",
+						},
+						"plugin": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Id of the acme dns plugin validating the domain, without it the node answers the http challenge on port 80 itself.",
+						},
+						"alias": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Alias domain the dns challenge is delegated to with a CNAME of `_acme-challenge.<domain>`.",
+						},
+					},
+				},
+			},
+			"renew_before_days": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+				MarkdownDescription: "Days before the expiry a refresh plans ordering the certificate again.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				MarkdownDescription: "Seconds to wait for the order task, dns validation waits for the propagation of the challenge records.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sha256 fingerprint of the certificate.",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiry of the certificate in RFC3339.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeAcmeCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// configArgs returns the pvesh args of the acme node config, together with the
+// acmedomain keys that are unused.
+func (data NodeAcmeCertificateResourceModel) configArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--acme": "account=" + data.Account.ValueString(),
+	}
+	deletes := []string{}
+
+	for i := range maxAcmeDomains {
+		key := fmt.Sprintf("acmedomain%d", i)
+		if i >= len(data.Domains) {
+			deletes = append(deletes, key)
+			continue
+		}
+
+		domain := data.Domains[i]
+		entry := "domain=" + domain.Domain.ValueString()
+		if !domain.Plugin.IsNull() {
+			entry += ",plugin=" + domain.Plugin.ValueString()
+		}
+		if !domain.Alias.IsNull() {
+			entry += ",alias=" + domain.Alias.ValueString()
+		}
+		args["--"+key] = entry
+	}
+
+	return args, deletes
+}
+
+// order writes the acme config of the node, orders the certificate and sets the computed attributes.
+func (r *NodeAcmeCertificateResource) order(ctx context.Context, client pb.CloudServiceClient, data *NodeAcmeCertificateResourceModel) error {
+	nodePath := "/nodes/" + data.Node.ValueString()
+
+	setArgs, deletes := data.configArgs()
+	setArgs["--delete"] = strings.Join(deletes, ",")
+	err := pveApiSet(ctx, client, r.cloudInventory.TargetPve, nodePath+"/config", setArgs)
+	if err != nil {
+		return err
+	}
+
+	// force replaces an existing certificate, e.g. a custom one or one with other domains
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", nodePath+"/certificates/acme/certificate", map[string]string{"--force": "1"}, true, data.Timeout.ValueInt64())
+	if err != nil {
+		return err
+	}
+
+	info, err := readProxyCertificate(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return fmt.Errorf("%s is missing after the order", pveProxyCertificate)
+	}
+	data.setCertificate(info)
+	return nil
+}
+
+// readProxyCertificate reads the web interface certificate of a node, nil if it uses the self signed one.
+func readProxyCertificate(ctx context.Context, client pb.CloudServiceClient, targetPve string, node string) (*pveCertificateInfo, error) {
+	var certificates []pveCertificateInfo
+	err := getPveApiJson(ctx, client, targetPve, fmt.Sprintf("/nodes/%s/certificates/info", node), &certificates)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, certificate := range certificates {
+		if certificate.Filename == pveProxyCertificate {
+			return &certificate, nil
+		}
+	}
+	return nil, nil
+}
+
+func (data *NodeAcmeCertificateResourceModel) setCertificate(info *pveCertificateInfo) {
+	data.Fingerprint = types.StringValue(info.Fingerprint)
+	data.NotAfter = types.StringValue(time.Unix(info.NotAfter, 0).UTC().Format(time.RFC3339))
+}
+
+func (r *NodeAcmeCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeAcmeCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.order(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error ordering certificate for %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeAcmeCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeAcmeCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	info, err := readProxyCertificate(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if removeIfMissing(ctx, info != nil, err, "certificate of "+data.Node.ValueString(), resp) {
+		return
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/config", data.Node.ValueString()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	acme, _ := pveConfigString(config, "acme")
+	_, acmeProps := parsePveProps(acme)
+	data.Account = optionalString(acmeProps["account"])
+
+	data.Domains = nil
+	for i := range maxAcmeDomains {
+		entry, ok := pveConfigString(config, fmt.Sprintf("acmedomain%d", i))
+		if !ok {
+			continue
+		}
+		// the domain is either the leading value or the domain property
+		lead, props := parsePveProps(entry)
+		if lead != "" {
+			props["domain"] = lead
+		}
+		data.Domains = append(data.Domains, AcmeDomainModel{
+			Domain: types.StringValue(props["domain"]),
+			Plugin: optionalString(props["plugin"]),
+			Alias:  optionalString(props["alias"]),
+		})
+	}
+
+	data.setCertificate(info)
+
+	// the domains are unknown for an expiring certificate, clearing them plans the new order
+	renewAt := time.Unix(info.NotAfter, 0).AddDate(0, 0, -int(data.RenewBeforeDays.ValueInt64()))
+	if time.Now().After(renewAt) {
+		tflog.Warn(ctx, fmt.Sprintf("certificate of %s expires at %s, planning a new order", data.Node.ValueString(), data.NotAfter.ValueString()))
+		data.Domains = nil
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeAcmeCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeAcmeCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.order(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error ordering certificate for %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeAcmeCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeAcmeCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	nodePath := "/nodes/" + data.Node.ValueString()
+
+	// revokes the certificate and restarts pveproxy with the self signed one
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", nodePath+"/certificates/acme/certificate", nil, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error revoking certificate of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	_, deletes := NodeAcmeCertificateResourceModel{}.configArgs()
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, nodePath+"/config", map[string]string{"--delete": strings.Join(append(deletes, "acme"), ",")})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error removing acme config of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+}
+
+func (r *NodeAcmeCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("renew_before_days"), int64(30))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(600))...)
+}
@@ -0,0 +1,364 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeAptRepositoryResource{}
+var _ resource.ResourceWithImportState = &NodeAptRepositoryResource{}
+
+// handles of the proxmox standard repositories, e.g. `no-subscription` or `ceph-squid-enterprise`
+var aptRepositoryHandleRe = regexp.MustCompile(`^(ceph-[a-z]+-)?(enterprise|no-subscription|test)$`)
+
+func NewNodeAptRepositoryResource() resource.Resource {
+	return &NodeAptRepositoryResource{}
+}
+
+// NodeAptRepositoryResource defines the resource implementation.
+type NodeAptRepositoryResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeAptRepositoryResourceModel describes the resource data model.
+type NodeAptRepositoryResourceModel struct {
+	Node    types.String `tfsdk:"node"`
+	Handle  types.String `tfsdk:"handle"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Name    types.String `tfsdk:"name"`
+	Path    types.String `tfsdk:"path"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// pveAptRepositories is the subset of /nodes/{node}/apt/repositories we need.
+type pveAptRepositories struct {
+	Digest string `json:"digest"`
+	Files  []struct {
+		Path         string `json:"path"`
+		Repositories []struct {
+			URIs       []string `json:"URIs"`
+			Components []string `json:"Components"`
+			Enabled    pveBool  `json:"Enabled"`
+		} `json:"repositories"`
+	} `json:"files"`
+	StandardRepos []struct {
+		Handle string   `json:"handle"`
+		Name   string   `json:"name"`
+		Status *pveBool `json:"status"`
+	} `json:"standard-repos"`
+}
+
+// find returns the path and index of the repository entry of a standard repository handle, the path is empty if there is none.
+// Entries are matched by the url path and component proxmox uses for the handle.
+func (repos pveAptRepositories) find(handle string) (string, int) {
+	match := aptRepositoryHandleRe.FindStringSubmatch(handle)
+	if match == nil {
+		return "", 0
+	}
+
+	// pve repositories live at /debian/pve with prefixed components, ceph ones at /debian/ceph-<release>
+	uriPath := "/debian/pve"
+	components := []string{"pve-" + match[2]}
+	if match[2] == "test" {
+		components = append(components, "pvetest")
+	}
+	if match[1] != "" {
+		uriPath = "/debian/" + strings.TrimSuffix(match[1], "-")
+		components = []string{match[2]}
+	}
+
+	for _, file := range repos.Files {
+		for index, repo := range file.Repositories {
+			uriMatches := slices.ContainsFunc(repo.URIs, func(uri string) bool {
+				return strings.HasSuffix(strings.TrimSuffix(uri, "/"), uriPath)
+			})
+			componentMatches := slices.ContainsFunc(repo.Components, func(component string) bool {
+				return slices.Contains(components, component)
+			})
+			if uriMatches && componentMatches {
+				return file.Path, index
+			}
+		}
+	}
+	return "", 0
+}
+
+func (r *NodeAptRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_apt_repository"
+}
+
+func (r *NodeAptRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables or disables a proxmox standard apt repository of a node, e.g. the enterprise or no-subscription repository of pve or ceph. " +
+			"Use `for_each` over `pxc_pve_nodes` to keep the package sources consistent across the cluster. " +
+			"The repository is added if the node doesn't have it, destroying the resource disables it. Import with `<node>/<handle>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the repository is configured on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"handle": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Handle of the standard repository, `enterprise`, `no-subscription` or `test`, for ceph prefixed with `ceph-<release>-`, e.g. `ceph-squid-no-subscription`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(aptRepositoryHandleRe, "must be a standard repository handle"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If apt uses the repository.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Display name of the repository.",
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sources file the repository is configured in.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeAptRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (data NodeAptRepositoryResourceModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/apt/repositories", data.Node.ValueString())
+}
+
+// apply sets the enabled state of the repository, adding it first if the node doesn't have it and add is set.
+func (r *NodeAptRepositoryResource) apply(ctx context.Context, client pb.CloudServiceClient, data *NodeAptRepositoryResourceModel, add bool) error {
+	var repos pveAptRepositories
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &repos)
+	if err != nil {
+		return err
+	}
+
+	filePath, index := repos.find(data.Handle.ValueString())
+	if filePath == "" && !add {
+		return nil
+	}
+	if filePath == "" {
+		// adds the repository enabled, to its own sources file
+		err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), map[string]string{"--handle": data.Handle.ValueString(), "--digest": repos.Digest})
+		if err != nil {
+			return err
+		}
+
+		repos = pveAptRepositories{}
+		err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &repos)
+		if err != nil {
+			return err
+		}
+		filePath, index = repos.find(data.Handle.ValueString())
+		if filePath == "" {
+			return fmt.Errorf("repository %s is missing after adding it", data.Handle.ValueString())
+		}
+	}
+
+	setArgs := map[string]string{
+		"--path":    filePath,
+		"--index":   fmt.Sprint(index),
+		"--enabled": boolToPve(data.Enabled.ValueBool()),
+		"--digest":  repos.Digest,
+	}
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), setArgs)
+	if err != nil {
+		return err
+	}
+
+	for _, standardRepo := range repos.StandardRepos {
+		if standardRepo.Handle == data.Handle.ValueString() {
+			data.Name = types.StringValue(standardRepo.Name)
+		}
+	}
+	data.Path = types.StringValue(filePath)
+	return nil
+}
+
+func (r *NodeAptRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeAptRepositoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.apply(ctx, client, &data, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error configuring apt repository, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeAptRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeAptRepositoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var repos pveAptRepositories
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &repos)
+	filePath, index := repos.find(data.Handle.ValueString())
+	if removeIfMissing(ctx, filePath != "", err, "apt repository "+data.Handle.ValueString(), resp) {
+		return
+	}
+
+	for _, file := range repos.Files {
+		if file.Path == filePath {
+			data.Enabled = types.BoolValue(bool(file.Repositories[index].Enabled))
+		}
+	}
+	for _, standardRepo := range repos.StandardRepos {
+		if standardRepo.Handle == data.Handle.ValueString() {
+			data.Name = types.StringValue(standardRepo.Name)
+		}
+	}
+	data.Path = types.StringValue(filePath)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeAptRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeAptRepositoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.apply(ctx, client, &data, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error configuring apt repository, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeAptRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeAptRepositoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the api can't remove repositories, only disable them
+	data.Enabled = types.BoolValue(false)
+	err = r.apply(ctx, client, &data, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error disabling apt repository, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeAptRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	node, handle, found := strings.Cut(req.ID, "/")
+	if !found || node == "" || !aptRepositoryHandleRe.MatchString(handle) {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <node>/<handle>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), node)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("handle"), handle)...)
+}
@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeCertificateResource{}
+var _ resource.ResourceWithImportState = &NodeCertificateResource{}
+
+func NewNodeCertificateResource() resource.Resource {
+	return &NodeCertificateResource{}
+}
+
+// NodeCertificateResource defines the resource implementation.
+type NodeCertificateResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeCertificateResourceModel describes the resource data model.
+type NodeCertificateResourceModel struct {
+	Node           types.String `tfsdk:"node"`
+	Certificate    types.String `tfsdk:"certificate"`
+	Key            types.String `tfsdk:"key"`
+	Restart        types.Bool   `tfsdk:"restart"`
+	WarnBeforeDays types.Int64  `tfsdk:"warn_before_days"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+	NotAfter       types.String `tfsdk:"not_after"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// pemCertificate parses the leaf of a pem certificate chain.
+func pemCertificate(chain string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(chain))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no pem encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// certificateFingerprint formats the sha256 fingerprint like pve, e.g. `AB:CD:...`.
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
+
+func (r *NodeCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_certificate"
+}
+
+func (r *NodeCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads an externally issued certificate, e.g. of the internal ca of the cloud stored as cloud secret, as certificate of the web interface of a node. " +
+			"The fingerprint is compared on every refresh, the certificate is uploaded again if it was replaced outside of terraform. " +
+			"Destroying the resource removes it, the node falls back to its self signed certificate. Import with `<node>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the certificate is for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Pem encoded certificate chain, starting with the certificate of the node.",
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Pem encoded private key of the certificate.",
+			},
+			"restart": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Restarts pveproxy so the web interface serves the new certificate right away.",
+			},
+			"warn_before_days": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+				MarkdownDescription: "Days before the expiry refreshes start warning about it.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sha256 fingerprint of the certificate.",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiry of the certificate in RFC3339.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// upload replaces the custom certificate of the node and sets the computed attributes.
+func (r *NodeCertificateResource) upload(ctx context.Context, data *NodeCertificateResourceModel) error {
+	cert, err := pemCertificate(data.Certificate.ValueString())
+	if err != nil {
+		return fmt.Errorf("invalid certificate: %w", err)
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return err
+	}
+
+	uploadArgs := map[string]string{
+		"--certificates": data.Certificate.ValueString(),
+		"--key":          data.Key.ValueString(),
+		"--force":        "1",
+		"--restart":      boolToPve(data.Restart.ValueBool()),
+	}
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/certificates/custom", data.Node.ValueString()), uploadArgs)
+	if err != nil {
+		return err
+	}
+
+	data.Fingerprint = types.StringValue(certificateFingerprint(cert))
+	data.NotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	return nil
+}
+
+func (r *NodeCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	err := r.upload(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error uploading certificate to %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	info, err := readProxyCertificate(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if removeIfMissing(ctx, info != nil, err, "certificate of "+data.Node.ValueString(), resp) {
+		return
+	}
+
+	// the certificate is unknown after outside changes, clearing it plans the upload
+	if !strings.EqualFold(info.Fingerprint, data.Fingerprint.ValueString()) {
+		data.Certificate = types.StringNull()
+		data.Fingerprint = types.StringValue(info.Fingerprint)
+	}
+
+	notAfter := time.Unix(info.NotAfter, 0)
+	data.NotAfter = types.StringValue(notAfter.UTC().Format(time.RFC3339))
+	if time.Now().AddDate(0, 0, int(data.WarnBeforeDays.ValueInt64())).After(notAfter) {
+		resp.Diagnostics.AddWarning("Certificate Expiring",
+			fmt.Sprintf("The certificate of %s expires at %s, update certificate and key with a renewed one.", data.Node.ValueString(), data.NotAfter.ValueString()))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	err := r.upload(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error uploading certificate to %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	_, err = pveApiCall(ctx, client, r.cloudInventory.TargetPve, "DELETE", fmt.Sprintf("/nodes/%s/certificates/custom", data.Node.ValueString()), map[string]string{"--restart": boolToPve(data.Restart.ValueBool())})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error removing certificate of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+}
+
+// ImportState takes `<node>`, certificate and key are uploaded on the next apply.
+func (r *NodeCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restart"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("warn_before_days"), int64(30))...)
+}
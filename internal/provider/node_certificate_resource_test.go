@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// testCertificate returns a pem encoded self signed certificate and its key.
+func testCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "pve1.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate: %s", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestNodeCertificateResourceUploadsPem(t *testing.T) {
+	cert, key := testCertificate(t)
+	conn := &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+		"CreateProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			return &pb.CreateProxmoxApiResponse{Success: true}, nil
+		},
+	}}
+	r := &NodeCertificateResource{cloudInventory: testInventory(conn)}
+
+	createResource(t, r, map[string]tftypes.Value{
+		"node":        tftypes.NewValue(tftypes.String, "pve1"),
+		"certificate": tftypes.NewValue(tftypes.String, cert),
+		"key":         tftypes.NewValue(tftypes.String, key),
+	})
+
+	reqs := conn.called("CreateProxmoxApi")
+	if len(reqs) != 1 {
+		t.Fatalf("CreateProxmoxApi called %d times, want 1", len(reqs))
+	}
+	args := reqs[0].(*pb.CreateProxmoxApiRequest).CreateArgs
+	if args["--certificates"] != cert {
+		t.Errorf("--certificates = %q, want %q", args["--certificates"], cert)
+	}
+	if args["--key"] != key {
+		t.Errorf("--key = %q, want %q", args["--key"], key)
+	}
+
+	// the go backend passes each pem as a single argument
+	command := pveshCommand("create", "/nodes/pve1/certificates/custom", args)
+	for _, value := range []string{cert, key} {
+		if !strings.Contains(command, " "+shellQuote(value)) {
+			t.Errorf("pvesh command %q does not pass %q as one argument", command, value)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeDnsResource{}
+var _ resource.ResourceWithImportState = &NodeDnsResource{}
+
+func NewNodeDnsResource() resource.Resource {
+	return &NodeDnsResource{}
+}
+
+// NodeDnsResource defines the resource implementation.
+type NodeDnsResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeDnsResourceModel describes the resource data model.
+type NodeDnsResourceModel struct {
+	Node    types.String   `tfsdk:"node"`
+	Search  types.String   `tfsdk:"search"`
+	Servers []types.String `tfsdk:"servers"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// pveNodeDns is the response of /nodes/{node}/dns.
+type pveNodeDns struct {
+	Search string `json:"search"`
+	Dns1   string `json:"dns1"`
+	Dns2   string `json:"dns2"`
+	Dns3   string `json:"dns3"`
+}
+
+func (r *NodeDnsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_dns"
+}
+
+func (r *NodeDnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the search domain and nameservers in `/etc/resolv.conf` of a node, so the cloud domains resolve the same on every pve host. " +
+			"Every node always has a dns config, destroying the resource leaves it in place. Import with `<node>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the dns settings are for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"search": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Search domain for host name lookups.",
+			},
+			"servers": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Ip addresses of the nameservers, in the order they are queried.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeDnsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (data NodeDnsResourceModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/dns", data.Node.ValueString())
+}
+
+// write sets search domain and nameservers, unused nameserver slots are cleared.
+func (r *NodeDnsResource) write(ctx context.Context, client pb.CloudServiceClient, data NodeDnsResourceModel) error {
+	setArgs := map[string]string{
+		"--search": data.Search.ValueString(),
+	}
+	deletes := []string{}
+	for i := range 3 {
+		key := fmt.Sprintf("dns%d", i+1)
+		if i < len(data.Servers) {
+			setArgs["--"+key] = data.Servers[i].ValueString()
+		} else {
+			deletes = append(deletes, key)
+		}
+	}
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	return pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), setArgs)
+}
+
+func (r *NodeDnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeDnsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.write(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error setting dns of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeDnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeDnsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var dns pveNodeDns
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &dns)
+	if removeIfMissing(ctx, true, err, "dns of "+data.Node.ValueString(), resp) {
+		return
+	}
+
+	data.Search = optionalString(dns.Search)
+	data.Servers = nil
+	for _, server := range []string{dns.Dns1, dns.Dns2, dns.Dns3} {
+		if server != "" {
+			data.Servers = append(data.Servers, types.StringValue(server))
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeDnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeDnsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.write(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error setting dns of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, a node can't be without dns config.
+func (r *NodeDnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *NodeDnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node"), req, resp)
+}
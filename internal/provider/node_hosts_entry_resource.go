@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeHostsEntryResource{}
+var _ resource.ResourceWithImportState = &NodeHostsEntryResource{}
+
+// nodeHostsMu serializes the edits of the hosts files of one apply, they are written as a whole.
+var nodeHostsMu sync.Mutex
+
+func NewNodeHostsEntryResource() resource.Resource {
+	return &NodeHostsEntryResource{}
+}
+
+// NodeHostsEntryResource defines the resource implementation.
+type NodeHostsEntryResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeHostsEntryResourceModel describes the resource data model.
+type NodeHostsEntryResourceModel struct {
+	Node      types.String   `tfsdk:"node"`
+	Ip        types.String   `tfsdk:"ip"`
+	Hostnames []types.String `tfsdk:"hostnames"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// pveNodeHosts is the response of /nodes/{node}/hosts.
+type pveNodeHosts struct {
+	Data   string `json:"data"`
+	Digest string `json:"digest"`
+}
+
+// hostsLineIp returns the address of a line of a hosts file, empty for comments and blank lines.
+func hostsLineIp(line string) string {
+	entry, _, _ := strings.Cut(line, "#")
+	fields := strings.Fields(entry)
+	if len(fields) < 2 {
+		return ""
+	}
+	return fields[0]
+}
+
+// hostsLookup returns the host names of the first line of ip, nil if there is none.
+func hostsLookup(hosts string, ip string) []string {
+	for _, line := range strings.Split(hosts, "\n") {
+		if hostsLineIp(line) == ip {
+			entry, _, _ := strings.Cut(line, "#")
+			return strings.Fields(entry)[1:]
+		}
+	}
+	return nil
+}
+
+// hostsReplace replaces the lines of ip with a line of hostnames, removing them if hostnames is empty.
+// New entries are appended.
+func hostsReplace(hosts string, ip string, hostnames []string) string {
+	lines := []string{}
+	replaced := false
+	for _, line := range strings.Split(strings.TrimRight(hosts, "\n"), "\n") {
+		if hostsLineIp(line) != ip {
+			lines = append(lines, line)
+			continue
+		}
+		if !replaced && len(hostnames) > 0 {
+			lines = append(lines, ip+" "+strings.Join(hostnames, " "))
+		}
+		replaced = true
+	}
+	if !replaced && len(hostnames) > 0 {
+		lines = append(lines, ip+" "+strings.Join(hostnames, " "))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (r *NodeHostsEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_hosts_entry"
+}
+
+func (r *NodeHostsEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the line of an address in `/etc/hosts` of a node, e.g. to resolve cloud domains on every pve host without relying on dns. " +
+			"Other lines of the file are left untouched. Import with `<node>/<ip>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the entry is added on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Ipv4 or ipv6 address of the entry, a node can only have one entry per address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostnames": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Host names resolving to ip, the first one is the canonical name.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeHostsEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (data NodeHostsEntryResourceModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/hosts", data.Node.ValueString())
+}
+
+// write sets the hostnames of the entry in the hosts file, an empty list removes it.
+func (r *NodeHostsEntryResource) write(ctx context.Context, client pb.CloudServiceClient, data NodeHostsEntryResourceModel, hostnames []types.String) error {
+	if net.ParseIP(data.Ip.ValueString()) == nil {
+		return fmt.Errorf("%s is not an ip address", data.Ip.ValueString())
+	}
+
+	nodeHostsMu.Lock()
+	defer nodeHostsMu.Unlock()
+
+	var hosts pveNodeHosts
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &hosts)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(hostnames))
+	for i, hostname := range hostnames {
+		names[i] = hostname.ValueString()
+	}
+
+	// the digest fails the write if the file was changed since reading it
+	setArgs := map[string]string{
+		"--data":   hostsReplace(hosts.Data, data.Ip.ValueString(), names),
+		"--digest": hosts.Digest,
+	}
+	return pveApiCreate(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), setArgs)
+}
+
+func (r *NodeHostsEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeHostsEntryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.write(ctx, client, data, data.Hostnames)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error adding hosts entry on %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeHostsEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeHostsEntryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var hosts pveNodeHosts
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &hosts)
+	hostnames := hostsLookup(hosts.Data, data.Ip.ValueString())
+	if removeIfMissing(ctx, hostnames != nil, err, "hosts entry "+data.Ip.ValueString(), resp) {
+		return
+	}
+
+	data.Hostnames = make([]types.String, len(hostnames))
+	for i, hostname := range hostnames {
+		data.Hostnames[i] = types.StringValue(hostname)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeHostsEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeHostsEntryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.write(ctx, client, data, data.Hostnames)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating hosts entry on %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeHostsEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeHostsEntryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.write(ctx, client, data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error removing hosts entry on %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+}
+
+func (r *NodeHostsEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ipv6 addresses contain colons, the node is split off at the first slash
+	node, ip, found := strings.Cut(req.ID, "/")
+	if !found || node == "" || net.ParseIP(ip) == nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <node>/<ip>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), node)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), ip)...)
+}
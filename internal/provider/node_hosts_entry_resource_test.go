@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNodeHostsEntryResourceWritesWholeFile(t *testing.T) {
+	hosts := "127.0.0.1 localhost.localdomain localhost\n# cluster nodes\n10.0.0.1 pve1.example.com pve1\n"
+	hostsJson, _ := json.Marshal(pveNodeHosts{Data: hosts, Digest: "abc123"})
+
+	conn := fakePveApi(map[string]string{"/nodes/pve1/hosts": string(hostsJson)})
+	conn.handlers["CreateProxmoxApi"] = func(req proto.Message) (proto.Message, error) {
+		return &pb.CreateProxmoxApiResponse{Success: true}, nil
+	}
+	r := &NodeHostsEntryResource{cloudInventory: testInventory(conn)}
+
+	createResource(t, r, map[string]tftypes.Value{
+		"node": tftypes.NewValue(tftypes.String, "pve1"),
+		"ip":   tftypes.NewValue(tftypes.String, "10.0.0.2"),
+		"hostnames": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "pve2.example.com"),
+			tftypes.NewValue(tftypes.String, "pve2"),
+		}),
+	})
+
+	reqs := conn.called("CreateProxmoxApi")
+	if len(reqs) != 1 {
+		t.Fatalf("CreateProxmoxApi called %d times, want 1", len(reqs))
+	}
+	args := reqs[0].(*pb.CreateProxmoxApiRequest).CreateArgs
+	want := hosts + "10.0.0.2 pve2.example.com pve2\n"
+	if args["--data"] != want {
+		t.Errorf("--data = %q, want %q", args["--data"], want)
+	}
+	if args["--digest"] != "abc123" {
+		t.Errorf("--digest = %q, want abc123", args["--digest"])
+	}
+
+	// the go backend passes the hosts file as a single argument
+	command := pveshCommand("create", "/nodes/pve1/hosts", args)
+	if !strings.Contains(command, " "+shellQuote(want)) {
+		t.Errorf("pvesh command %q does not pass the hosts file as one argument", command)
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeMetricsDataSource{}
+
+func NewNodeMetricsDataSource() datasource.DataSource {
+	return &NodeMetricsDataSource{}
+}
+
+// NodeMetricsDataSource defines the data source implementation.
+type NodeMetricsDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeMetricsDataSourceModel describes the data source data model.
+type NodeMetricsDataSourceModel struct {
+	Node          types.String       `tfsdk:"node"`
+	Timeframe     types.String       `tfsdk:"timeframe"`
+	Cf            types.String       `tfsdk:"cf"`
+	CpuAvg        types.Float64      `tfsdk:"cpu_avg"`
+	CpuMax        types.Float64      `tfsdk:"cpu_max"`
+	IoWaitAvg     types.Float64      `tfsdk:"iowait_avg"`
+	IoWaitMax     types.Float64      `tfsdk:"iowait_max"`
+	MemoryUsedAvg types.Float64      `tfsdk:"memory_used_avg"`
+	MemoryUsedMax types.Float64      `tfsdk:"memory_used_max"`
+	Points        []NodeMetricsPoint `tfsdk:"points"`
+}
+
+// NodeMetricsPoint describes a single rrd data point.
+type NodeMetricsPoint struct {
+	Time        types.Int64   `tfsdk:"time"`
+	Cpu         types.Float64 `tfsdk:"cpu"`
+	IoWait      types.Float64 `tfsdk:"iowait"`
+	LoadAvg     types.Float64 `tfsdk:"loadavg"`
+	MemoryUsed  types.Float64 `tfsdk:"memory_used"`
+	MemoryTotal types.Float64 `tfsdk:"memory_total"`
+	NetIn       types.Float64 `tfsdk:"netin"`
+	NetOut      types.Float64 `tfsdk:"netout"`
+}
+
+// pveRrdPoint is an entry of /nodes/{node}/rrddata, values are missing while the node was down.
+type pveRrdPoint struct {
+	Time     int64    `json:"time"`
+	Cpu      *float64 `json:"cpu"`
+	IoWait   *float64 `json:"iowait"`
+	LoadAvg  *float64 `json:"loadavg"`
+	MemUsed  *float64 `json:"memused"`
+	MemTotal *float64 `json:"memtotal"`
+	NetIn    *float64 `json:"netin"`
+	NetOut   *float64 `json:"netout"`
+}
+
+// rrdAvgMax aggregates the present values, null if there are none.
+func rrdAvgMax(points []pveRrdPoint, value func(pveRrdPoint) *float64) (types.Float64, types.Float64) {
+	var sum, maxValue float64
+	count := 0
+	for _, point := range points {
+		if v := value(point); v != nil {
+			sum += *v
+			maxValue = max(maxValue, *v)
+			count++
+		}
+	}
+
+	if count == 0 {
+		return types.Float64Null(), types.Float64Null()
+	}
+	return types.Float64Value(sum / float64(count)), types.Float64Value(maxValue)
+}
+
+func (d *NodeMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_metrics"
+}
+
+func (d *NodeMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the rrd metrics of a node from `/nodes/{node}/rrddata` for a timeframe, " +
+			"e.g. for capacity aware placement of vms or to assert utilization in check blocks.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the node.",
+			},
+			"timeframe": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "`hour`, `day`, `week`, `month` or `year`, defaults to `hour`. Longer timeframes have coarser points.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("hour", "day", "week", "month", "year"),
+				},
+			},
+			"cf": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Consolidation function of the points, `AVERAGE` or `MAX`, defaults to `AVERAGE`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("AVERAGE", "MAX"),
+				},
+			},
+			"cpu_avg": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Average cpu utilization over the timeframe, between 0 and 1.",
+			},
+			"cpu_max": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Highest cpu utilization of the points.",
+			},
+			"iowait_avg": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Average share of cpu time waiting for io, between 0 and 1.",
+			},
+			"iowait_max": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Highest io wait of the points.",
+			},
+			"memory_used_avg": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Average used memory in bytes.",
+			},
+			"memory_used_max": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Highest used memory of the points in bytes.",
+			},
+			"points": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Data points ordered by time, values are null where the node didn't report them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Unix timestamp of the point.",
+						},
+						"cpu": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Cpu utilization, between 0 and 1.",
+						},
+						"iowait": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Share of cpu time waiting for io, between 0 and 1.",
+						},
+						"loadavg": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Load average.",
+						},
+						"memory_used": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Used memory in bytes.",
+						},
+						"memory_total": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Total memory in bytes.",
+						},
+						"netin": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Received bytes per second.",
+						},
+						"netout": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Sent bytes per second.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *NodeMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	args := map[string]string{"--timeframe": "hour", "--cf": "AVERAGE"}
+	if !data.Timeframe.IsNull() {
+		args["--timeframe"] = data.Timeframe.ValueString()
+	}
+	if !data.Cf.IsNull() {
+		args["--cf"] = data.Cf.ValueString()
+	}
+
+	var points []pveRrdPoint
+	err = getPveApiJsonArgs(ctx, client, d.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/rrddata", data.Node.ValueString()), args, &points)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get metrics of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.CpuAvg, data.CpuMax = rrdAvgMax(points, func(p pveRrdPoint) *float64 { return p.Cpu })
+	data.IoWaitAvg, data.IoWaitMax = rrdAvgMax(points, func(p pveRrdPoint) *float64 { return p.IoWait })
+	data.MemoryUsedAvg, data.MemoryUsedMax = rrdAvgMax(points, func(p pveRrdPoint) *float64 { return p.MemUsed })
+
+	data.Points = []NodeMetricsPoint{}
+	for _, point := range points {
+		data.Points = append(data.Points, NodeMetricsPoint{
+			Time:        types.Int64Value(point.Time),
+			Cpu:         types.Float64PointerValue(point.Cpu),
+			IoWait:      types.Float64PointerValue(point.IoWait),
+			LoadAvg:     types.Float64PointerValue(point.LoadAvg),
+			MemoryUsed:  types.Float64PointerValue(point.MemUsed),
+			MemoryTotal: types.Float64PointerValue(point.MemTotal),
+			NetIn:       types.Float64PointerValue(point.NetIn),
+			NetOut:      types.Float64PointerValue(point.NetOut),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vlans are named <device>.<tag> or vlan<tag> together with vlan_raw_device
+var nodeNetworkVlanRe = regexp.MustCompile(`^(\S+\.\d+|vlan\d+)$`)
+
+// seconds to wait for the network reload of a node
+const nodeNetworkApplyTimeout = 300
+
+// nodeNetworkApplyMu serializes the reloads of the interfaces of one apply.
+var nodeNetworkApplyMu sync.Mutex
+
+// NodeNetworkModel describes the attributes shared by all interface types, it is
+// embedded into the models of the interface resources.
+type NodeNetworkModel struct {
+	Node      types.String `tfsdk:"node"`
+	Iface     types.String `tfsdk:"iface"`
+	Autostart types.Bool   `tfsdk:"autostart"`
+	Cidr      types.String `tfsdk:"cidr"`
+	Gateway   types.String `tfsdk:"gateway"`
+	Cidr6     types.String `tfsdk:"cidr6"`
+	Gateway6  types.String `tfsdk:"gateway6"`
+	Mtu       types.Int64  `tfsdk:"mtu"`
+	Comment   types.String `tfsdk:"comment"`
+}
+
+// nodeNetworkAttributes adds the shared attributes to the type specific ones.
+func nodeNetworkAttributes(ifaceDescription string, ifaceValidators []validator.String, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	optional := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	attributes["node"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Node the interface is configured on.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["iface"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: ifaceDescription,
+		Validators:          ifaceValidators,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["autostart"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Brings the interface up on boot.",
+	}
+	attributes["cidr"] = optional("Ipv4 address of the interface in cidr notation, e.g. `10.0.0.2/24`.")
+	attributes["gateway"] = optional("Default ipv4 gateway, only one interface of a node can have one.")
+	attributes["cidr6"] = optional("Ipv6 address of the interface in cidr notation.")
+	attributes["gateway6"] = optional("Default ipv6 gateway.")
+	attributes["mtu"] = schema.Int64Attribute{
+		Optional:            true,
+		MarkdownDescription: "Mtu of the interface.",
+		Validators: []validator.Int64{
+			int64validator.Between(1280, 65520),
+		},
+	}
+	attributes["comment"] = optional("Comment of the interface.")
+
+	return attributes
+}
+
+// args returns the shared pvesh args of the interface, together with the optional keys that are unset.
+func (data NodeNetworkModel) args() (map[string]string, []string) {
+	args := map[string]string{
+		"--autostart": boolToPve(data.Autostart.ValueBool()),
+	}
+	deletes := []string{}
+
+	optionalKeys := []string{"cidr", "gateway", "cidr6", "gateway6", "comments"}
+	for i, value := range []types.String{data.Cidr, data.Gateway, data.Cidr6, data.Gateway6, data.Comment} {
+		if !value.IsNull() {
+			args["--"+optionalKeys[i]] = value.ValueString()
+		} else {
+			deletes = append(deletes, optionalKeys[i])
+		}
+	}
+	if !data.Mtu.IsNull() {
+		args["--mtu"] = strconv.FormatInt(data.Mtu.ValueInt64(), 10)
+	} else {
+		deletes = append(deletes, "mtu")
+	}
+
+	return args, deletes
+}
+
+// read takes the shared values of the interface config over into the model.
+func (data *NodeNetworkModel) read(config map[string]interface{}) {
+	value := func(key string) types.String {
+		configValue, _ := pveConfigString(config, key)
+		return optionalString(configValue)
+	}
+
+	autostart, _ := pveConfigInt(config, "autostart")
+	data.Autostart = types.BoolValue(autostart == 1)
+	data.Cidr = value("cidr")
+	data.Gateway = value("gateway")
+	data.Cidr6 = value("cidr6")
+	data.Gateway6 = value("gateway6")
+	// pve keeps a trailing newline of multi line comments
+	comment, _ := pveConfigString(config, "comments")
+	data.Comment = optionalString(strings.TrimRight(comment, "\n"))
+	if mtu, ok := pveConfigInt(config, "mtu"); ok {
+		data.Mtu = types.Int64Value(mtu)
+	} else {
+		data.Mtu = types.Int64Null()
+	}
+}
+
+// apiPath returns the api path of the interface.
+func (data NodeNetworkModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/network/%s", data.Node.ValueString(), data.Iface.ValueString())
+}
+
+// readNodeNetwork reads the config of the interface including pending changes, nil if it doesn't exist.
+func readNodeNetwork(ctx context.Context, client pb.CloudServiceClient, targetPve string, data NodeNetworkModel) (map[string]interface{}, error) {
+	exists, err := pveApiEntryExists(ctx, client, targetPve, fmt.Sprintf("/nodes/%s/network", data.Node.ValueString()), "iface", data.Iface.ValueString())
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, targetPve, data.apiPath(), &config)
+	return config, err
+}
+
+// applyNodeNetwork reloads the network of the node, which applies all of its pending interface changes.
+func applyNodeNetwork(ctx context.Context, client pb.CloudServiceClient, targetPve string, node string) error {
+	nodeNetworkApplyMu.Lock()
+	defer nodeNetworkApplyMu.Unlock()
+
+	return pveApiCallWait(ctx, client, targetPve, "PUT", fmt.Sprintf("/nodes/%s/network", node), nil, true, nodeNetworkApplyTimeout)
+}
+
+// importNodeNetwork imports an interface by `<node>/<iface>`.
+func importNodeNetwork(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	node, iface, found := strings.Cut(req.ID, "/")
+	if !found || node == "" || iface == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <node>/<iface>, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), node)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("iface"), iface)...)
+}
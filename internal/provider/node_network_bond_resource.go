@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeNetworkBondResource{}
+var _ resource.ResourceWithImportState = &NodeNetworkBondResource{}
+
+func NewNodeNetworkBondResource() resource.Resource {
+	return &NodeNetworkBondResource{}
+}
+
+// NodeNetworkBondResource defines the resource implementation.
+type NodeNetworkBondResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeNetworkBondResourceModel describes the resource data model.
+type NodeNetworkBondResourceModel struct {
+	NodeNetworkModel
+	Slaves         []string     `tfsdk:"slaves"`
+	Mode           types.String `tfsdk:"mode"`
+	Primary        types.String `tfsdk:"primary"`
+	XmitHashPolicy types.String `tfsdk:"xmit_hash_policy"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *NodeNetworkBondResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_network_bond"
+}
+
+func (r *NodeNetworkBondResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a linux bond of a node, aggregating nics for redundancy or bandwidth. " +
+			"The network of the node is reloaded on every change, which also applies other pending interface changes of the node. Import with `<node>/<iface>`.",
+
+		Attributes: nodeNetworkAttributes("Name of the bond, e.g. `bond0`.", nil, map[string]schema.Attribute{
+			"slaves": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Nics of the bond.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Bonding mode, e.g. `active-backup` or `802.3ad` (lacp, needs switch support).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"),
+				},
+			},
+			"primary": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Preferred nic of an `active-backup` bond.",
+			},
+			"xmit_hash_policy": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Hash policy distributing the traffic of `802.3ad` and `balance-xor` bonds.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("layer2", "layer2+3", "layer3+4"),
+				},
+			},
+		}),
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeNetworkBondResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// bondArgs returns the pvesh args of the bond, together with the optional keys that are unset.
+func (data NodeNetworkBondResourceModel) bondArgs() (map[string]string, []string) {
+	args, deletes := data.args()
+	args["--type"] = "bond"
+	args["--slaves"] = strings.Join(sortedStrings(data.Slaves), " ")
+	args["--bond_mode"] = data.Mode.ValueString()
+
+	if !data.Primary.IsNull() {
+		args["--bond-primary"] = data.Primary.ValueString()
+	} else {
+		deletes = append(deletes, "bond-primary")
+	}
+	if !data.XmitHashPolicy.IsNull() {
+		args["--bond_xmit_hash_policy"] = data.XmitHashPolicy.ValueString()
+	} else {
+		deletes = append(deletes, "bond_xmit_hash_policy")
+	}
+
+	return args, deletes
+}
+
+func (r *NodeNetworkBondResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeNetworkBondResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.bondArgs()
+	createArgs["--iface"] = data.Iface.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/network", data.Node.ValueString()), createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating bond, got error: %s", err))
+		return
+	}
+
+	// the bond exists from here on, also if the reload fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkBondResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeNetworkBondResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	config, err := readNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.NodeNetworkModel)
+	if removeIfMissing(ctx, config != nil, err, "bond", resp) {
+		return
+	}
+
+	data.read(config)
+	slaves, _ := pveConfigString(config, "slaves")
+	mode, _ := pveConfigString(config, "bond_mode")
+	primary, _ := pveConfigString(config, "bond-primary")
+	xmitHashPolicy, _ := pveConfigString(config, "bond_xmit_hash_policy")
+	data.Slaves = strings.Fields(slaves)
+	data.Mode = types.StringValue(mode)
+	data.Primary = optionalString(primary)
+	data.XmitHashPolicy = optionalString(xmitHashPolicy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeNetworkBondResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeNetworkBondResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs, deletes := data.bondArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating bond, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkBondResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeNetworkBondResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.apiPath())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting bond, got error: %s", err))
+		return
+	}
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkBondResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNodeNetwork(ctx, req, resp)
+}
@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeNetworkBridgeResource{}
+var _ resource.ResourceWithImportState = &NodeNetworkBridgeResource{}
+
+func NewNodeNetworkBridgeResource() resource.Resource {
+	return &NodeNetworkBridgeResource{}
+}
+
+// NodeNetworkBridgeResource defines the resource implementation.
+type NodeNetworkBridgeResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeNetworkBridgeResourceModel describes the resource data model.
+type NodeNetworkBridgeResourceModel struct {
+	NodeNetworkModel
+	Ports     []string     `tfsdk:"ports"`
+	VlanAware types.Bool   `tfsdk:"vlan_aware"`
+	Vids      types.String `tfsdk:"vids"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *NodeNetworkBridgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_network_bridge"
+}
+
+func (r *NodeNetworkBridgeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a linux bridge of a node. The network of the node is reloaded on every change, which also applies other pending interface changes of the node. " +
+			"Import with `<node>/<iface>`.",
+
+		Attributes: nodeNetworkAttributes("Name of the bridge, e.g. `vmbr1`.", nil, map[string]schema.Attribute{
+			"ports": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Interfaces attached to the bridge, e.g. a bond or physical nic. An internal bridge is created if not set.",
+			},
+			"vlan_aware": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Lets guests use vlan tags on the bridge.",
+			},
+			"vids": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Vlan ids allowed on a vlan aware bridge, e.g. `2-4094` (the proxmox default) or `10 20 100-200`.",
+			},
+		}),
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeNetworkBridgeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// bridgeArgs returns the pvesh args of the bridge, together with the optional keys that are unset.
+func (data NodeNetworkBridgeResourceModel) bridgeArgs() (map[string]string, []string) {
+	args, deletes := data.args()
+	args["--type"] = "bridge"
+	args["--bridge_vlan_aware"] = boolToPve(data.VlanAware.ValueBool())
+
+	if len(data.Ports) > 0 {
+		args["--bridge_ports"] = strings.Join(sortedStrings(data.Ports), " ")
+	} else {
+		deletes = append(deletes, "bridge_ports")
+	}
+	if !data.Vids.IsNull() {
+		args["--bridge_vids"] = data.Vids.ValueString()
+	} else {
+		deletes = append(deletes, "bridge_vids")
+	}
+
+	return args, deletes
+}
+
+func (r *NodeNetworkBridgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeNetworkBridgeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.bridgeArgs()
+	createArgs["--iface"] = data.Iface.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/network", data.Node.ValueString()), createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating bridge, got error: %s", err))
+		return
+	}
+
+	// the bridge exists from here on, also if the reload fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkBridgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeNetworkBridgeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	config, err := readNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.NodeNetworkModel)
+	if removeIfMissing(ctx, config != nil, err, "bridge", resp) {
+		return
+	}
+
+	data.read(config)
+	ports, _ := pveConfigString(config, "bridge_ports")
+	if len(strings.Fields(ports)) > 0 || data.Ports != nil {
+		data.Ports = strings.Fields(ports)
+	}
+	vlanAware, _ := pveConfigInt(config, "bridge_vlan_aware")
+	data.VlanAware = types.BoolValue(vlanAware == 1)
+	vids, _ := pveConfigString(config, "bridge_vids")
+	data.Vids = optionalString(vids)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeNetworkBridgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeNetworkBridgeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs, deletes := data.bridgeArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating bridge, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkBridgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeNetworkBridgeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.apiPath())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting bridge, got error: %s", err))
+		return
+	}
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkBridgeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNodeNetwork(ctx, req, resp)
+}
@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeNetworkVlanResource{}
+var _ resource.ResourceWithImportState = &NodeNetworkVlanResource{}
+
+func NewNodeNetworkVlanResource() resource.Resource {
+	return &NodeNetworkVlanResource{}
+}
+
+// NodeNetworkVlanResource defines the resource implementation.
+type NodeNetworkVlanResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeNetworkVlanResourceModel describes the resource data model.
+type NodeNetworkVlanResourceModel struct {
+	NodeNetworkModel
+	RawDevice types.String `tfsdk:"raw_device"`
+	VlanId    types.Int64  `tfsdk:"vlan_id"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *NodeNetworkVlanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_network_vlan"
+}
+
+func (r *NodeNetworkVlanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a vlan interface of a node, e.g. for a dedicated migration or storage network. " +
+			"The network of the node is reloaded on every change, which also applies other pending interface changes of the node. Import with `<node>/<iface>`.",
+
+		Attributes: nodeNetworkAttributes("Name of the vlan, either `<device>.<tag>` like `bond0.100` or `vlan<tag>` together with raw_device and vlan_id.", []validator.String{
+			stringvalidator.RegexMatches(nodeNetworkVlanRe, "must be <device>.<tag> or vlan<tag>"),
+		}, map[string]schema.Attribute{
+			"raw_device": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Device the vlan is tagged on, derived from `<device>.<tag>` names.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Vlan tag, derived from `<device>.<tag>` names.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeNetworkVlanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// vlanArgs returns the pvesh args of the vlan, together with the optional keys that are unset.
+func (data NodeNetworkVlanResourceModel) vlanArgs() (map[string]string, []string) {
+	args, deletes := data.args()
+	args["--type"] = "vlan"
+
+	// <device>.<tag> names carry both in the name already
+	if !data.RawDevice.IsUnknown() && !data.RawDevice.IsNull() && !strings.Contains(data.Iface.ValueString(), ".") {
+		args["--vlan-raw-device"] = data.RawDevice.ValueString()
+	}
+	if !data.VlanId.IsUnknown() && !data.VlanId.IsNull() && !strings.Contains(data.Iface.ValueString(), ".") {
+		args["--vlan-id"] = strconv.FormatInt(data.VlanId.ValueInt64(), 10)
+	}
+
+	return args, deletes
+}
+
+// readVlan takes the vlan device and tag over, pve derives them from <device>.<tag> names.
+func (data *NodeNetworkVlanResourceModel) readVlan(config map[string]interface{}) {
+	rawDevice, _ := pveConfigString(config, "vlan-raw-device")
+	data.RawDevice = types.StringValue(rawDevice)
+	if vlanId, ok := pveConfigInt(config, "vlan-id"); ok {
+		data.VlanId = types.Int64Value(vlanId)
+	} else {
+		data.VlanId = types.Int64Null()
+	}
+}
+
+func (r *NodeNetworkVlanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeNetworkVlanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.vlanArgs()
+	createArgs["--iface"] = data.Iface.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/network", data.Node.ValueString()), createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating vlan, got error: %s", err))
+		return
+	}
+
+	// resolves device and tag of <device>.<tag> names
+	config, err := readNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.NodeNetworkModel)
+	if err != nil || config == nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to read the created vlan, got error: %v", err))
+		return
+	}
+	data.readVlan(config)
+
+	// the vlan exists from here on, also if the reload fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkVlanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeNetworkVlanResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	config, err := readNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.NodeNetworkModel)
+	if removeIfMissing(ctx, config != nil, err, "vlan", resp) {
+		return
+	}
+
+	data.read(config)
+	data.readVlan(config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeNetworkVlanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeNetworkVlanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs, deletes := data.vlanArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating vlan, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkVlanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeNetworkVlanResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.apiPath())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting vlan, got error: %s", err))
+		return
+	}
+
+	err = applyNodeNetwork(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error reloading node network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NodeNetworkVlanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNodeNetwork(ctx, req, resp)
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeStatusDataSource{}
+
+func NewNodeStatusDataSource() datasource.DataSource {
+	return &NodeStatusDataSource{}
+}
+
+// NodeStatusDataSource defines the data source implementation.
+type NodeStatusDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeStatusDataSourceModel describes the data source data model.
+type NodeStatusDataSourceModel struct {
+	Node        types.String    `tfsdk:"node"`
+	LoadAvg     []types.Float64 `tfsdk:"loadavg"`
+	Cpu         types.Float64   `tfsdk:"cpu"`
+	Cpus        types.Int64     `tfsdk:"cpus"`
+	CpuModel    types.String    `tfsdk:"cpu_model"`
+	MemoryTotal types.Int64     `tfsdk:"memory_total"`
+	MemoryUsed  types.Int64     `tfsdk:"memory_used"`
+	SwapTotal   types.Int64     `tfsdk:"swap_total"`
+	SwapUsed    types.Int64     `tfsdk:"swap_used"`
+	RootfsTotal types.Int64     `tfsdk:"rootfs_total"`
+	RootfsUsed  types.Int64     `tfsdk:"rootfs_used"`
+	Uptime      types.Int64     `tfsdk:"uptime"`
+	Kernel      types.String    `tfsdk:"kernel"`
+	PveVersion  types.String    `tfsdk:"pve_version"`
+	BootMode    types.String    `tfsdk:"boot_mode"`
+	SecureBoot  types.Bool      `tfsdk:"secure_boot"`
+}
+
+// pveNodeUsage is the usage of memory, swap or the root filesystem.
+type pveNodeUsage struct {
+	Total int64 `json:"total"`
+	Used  int64 `json:"used"`
+}
+
+// pveNodeStatus is the subset of /nodes/{node}/status we expose.
+type pveNodeStatus struct {
+	LoadAvg []string     `json:"loadavg"`
+	Cpu     float64      `json:"cpu"`
+	Memory  pveNodeUsage `json:"memory"`
+	Swap    pveNodeUsage `json:"swap"`
+	Rootfs  pveNodeUsage `json:"rootfs"`
+	Uptime  int64        `json:"uptime"`
+	CpuInfo struct {
+		Cpus  int64  `json:"cpus"`
+		Model string `json:"model"`
+	} `json:"cpuinfo"`
+	KVersion      string `json:"kversion"`
+	CurrentKernel struct {
+		Release string `json:"release"`
+	} `json:"current-kernel"`
+	PveVersion string `json:"pveversion"`
+	BootInfo   struct {
+		Mode       string  `json:"mode"`
+		SecureBoot pveBool `json:"secureboot"`
+	} `json:"boot-info"`
+}
+
+func (d *NodeStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_status"
+}
+
+func (d *NodeStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches load, memory, kernel and boot mode of a node from `/nodes/{node}/status`, " +
+			"e.g. to assert in check blocks that a node runs the expected kernel or has memory left before changes are applied.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the node, it has to be online.",
+			},
+			"loadavg": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Float64Type,
+				MarkdownDescription: "Load average over 1, 5 and 15 minutes.",
+			},
+			"cpu": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Cpu utilization, between 0 and 1.",
+			},
+			"cpus": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of cpu threads.",
+			},
+			"cpu_model": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Model name of the cpu.",
+			},
+			"memory_total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total memory in bytes.",
+			},
+			"memory_used": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Used memory in bytes.",
+			},
+			"swap_total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total swap in bytes.",
+			},
+			"swap_used": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Used swap in bytes.",
+			},
+			"rootfs_total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the root filesystem in bytes.",
+			},
+			"rootfs_used": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Used space of the root filesystem in bytes.",
+			},
+			"uptime": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Uptime in seconds.",
+			},
+			"kernel": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Release of the running kernel, e.g. `6.8.12-4-pve`.",
+			},
+			"pve_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Version of pve-manager, e.g. `8.2.4`.",
+			},
+			"boot_mode": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`efi` or `legacy-bios`, null on proxmox versions that don't report it.",
+			},
+			"secure_boot": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "If the node booted with secure boot enabled.",
+			},
+		},
+	}
+}
+
+func (d *NodeStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *NodeStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var status pveNodeStatus
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/status", data.Node.ValueString()), &status)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get status of node %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	data.LoadAvg = []types.Float64{}
+	for _, load := range status.LoadAvg {
+		value, err := strconv.ParseFloat(load, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse load average %s, got error: %s", load, err))
+			return
+		}
+		data.LoadAvg = append(data.LoadAvg, types.Float64Value(value))
+	}
+
+	// older versions only report the uname string, e.g. `Linux 6.2.16-3-pve #1 SMP ...`
+	kernel := status.CurrentKernel.Release
+	if kernel == "" {
+		if fields := strings.Fields(status.KVersion); len(fields) > 1 {
+			kernel = fields[1]
+		}
+	}
+
+	// pve-manager/<version>/<commit>
+	pveVersion := status.PveVersion
+	if parts := strings.Split(pveVersion, "/"); len(parts) > 1 {
+		pveVersion = parts[1]
+	}
+
+	data.Cpu = types.Float64Value(status.Cpu)
+	data.Cpus = types.Int64Value(status.CpuInfo.Cpus)
+	data.CpuModel = types.StringValue(status.CpuInfo.Model)
+	data.MemoryTotal = types.Int64Value(status.Memory.Total)
+	data.MemoryUsed = types.Int64Value(status.Memory.Used)
+	data.SwapTotal = types.Int64Value(status.Swap.Total)
+	data.SwapUsed = types.Int64Value(status.Swap.Used)
+	data.RootfsTotal = types.Int64Value(status.Rootfs.Total)
+	data.RootfsUsed = types.Int64Value(status.Rootfs.Used)
+	data.Uptime = types.Int64Value(status.Uptime)
+	data.Kernel = types.StringValue(kernel)
+	data.PveVersion = types.StringValue(pveVersion)
+	data.BootMode = optionalString(status.BootInfo.Mode)
+	data.SecureBoot = types.BoolValue(bool(status.BootInfo.SecureBoot))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
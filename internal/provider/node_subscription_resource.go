@@ -0,0 +1,285 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeSubscriptionResource{}
+var _ resource.ResourceWithImportState = &NodeSubscriptionResource{}
+
+func NewNodeSubscriptionResource() resource.Resource {
+	return &NodeSubscriptionResource{}
+}
+
+// NodeSubscriptionResource defines the resource implementation.
+type NodeSubscriptionResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeSubscriptionResourceModel describes the resource data model.
+type NodeSubscriptionResourceModel struct {
+	Node        types.String `tfsdk:"node"`
+	Key         types.String `tfsdk:"key"`
+	Status      types.String `tfsdk:"status"`
+	Level       types.String `tfsdk:"level"`
+	ProductName types.String `tfsdk:"product_name"`
+	NextDueDate types.String `tfsdk:"next_due_date"`
+	ServerId    types.String `tfsdk:"server_id"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// pveSubscription is the response of /nodes/{node}/subscription.
+type pveSubscription struct {
+	Status      string `json:"status"`
+	Key         string `json:"key"`
+	Level       string `json:"level"`
+	ProductName string `json:"productname"`
+	NextDueDate string `json:"nextduedate"`
+	ServerId    string `json:"serverid"`
+}
+
+func (r *NodeSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_subscription"
+}
+
+func (r *NodeSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the subscription key of a node and checks it with the proxmox shop, required for the enterprise repositories of `pxc_node_apt_repository`. " +
+			"Destroying the resource removes the key from the node. Import with `<node>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the subscription is for, every key is bound to a single node.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Subscription key, e.g. `pve2c-0123456789`.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the subscription, `active` if the key is valid for the node.",
+			},
+			"level": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Subscription level, e.g. `c` for community.",
+			},
+			"product_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the subscription product.",
+			},
+			"next_due_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date the subscription has to be renewed.",
+			},
+			"server_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Server id of the node the key is bound to.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (data NodeSubscriptionResourceModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/subscription", data.Node.ValueString())
+}
+
+// readSubscription reads the subscription of the node into data, returning false if the node has none.
+func (r *NodeSubscriptionResource) readSubscription(ctx context.Context, client pb.CloudServiceClient, data *NodeSubscriptionResourceModel) (bool, error) {
+	var subscription pveSubscription
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &subscription)
+	if err != nil || subscription.Status == "notfound" {
+		return false, err
+	}
+
+	if subscription.Key != "" {
+		data.Key = types.StringValue(subscription.Key)
+	}
+	data.Status = types.StringValue(subscription.Status)
+	data.Level = optionalString(subscription.Level)
+	data.ProductName = optionalString(subscription.ProductName)
+	data.NextDueDate = optionalString(subscription.NextDueDate)
+	data.ServerId = optionalString(subscription.ServerId)
+	return true, nil
+}
+
+// setKey sets the key, checks it with the shop and reads the resulting subscription.
+func (r *NodeSubscriptionResource) setKey(ctx context.Context, client pb.CloudServiceClient, data *NodeSubscriptionResourceModel) error {
+	err := pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), map[string]string{"--key": data.Key.ValueString()})
+	if err != nil {
+		return err
+	}
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), map[string]string{"--force": "1"})
+	if err != nil {
+		return err
+	}
+
+	found, err := r.readSubscription(ctx, client, data)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("subscription is missing after setting the key")
+	}
+	if data.Status.ValueString() != "active" {
+		return fmt.Errorf("subscription isn't active, status: %s", data.Status.ValueString())
+	}
+	return nil
+}
+
+func (r *NodeSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeSubscriptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.setKey(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error setting subscription of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeSubscriptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	found, err := r.readSubscription(ctx, client, &data)
+	if removeIfMissing(ctx, found, err, "subscription of "+data.Node.ValueString(), resp) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeSubscriptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.setKey(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error setting subscription of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeSubscriptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.apiPath())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error removing subscription of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+}
+
+func (r *NodeSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node"), req, resp)
+}
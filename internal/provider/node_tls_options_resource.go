@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"sort"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NodeTlsOptionsResource{}
+var _ resource.ResourceWithImportState = &NodeTlsOptionsResource{}
+
+// seconds to wait for pveproxy to come back after a config change
+const pveproxyRestartTimeout = 60
+
+func NewNodeTlsOptionsResource() resource.Resource {
+	return &NodeTlsOptionsResource{}
+}
+
+// NodeTlsOptionsResource defines the resource implementation.
+type NodeTlsOptionsResource struct {
+	cloudInventory CloudInventory
+}
+
+// NodeTlsOptionsResourceModel describes the resource data model.
+type NodeTlsOptionsResourceModel struct {
+	Node             types.String `tfsdk:"node"`
+	Ciphers          types.String `tfsdk:"ciphers"`
+	CipherSuites     types.String `tfsdk:"cipher_suites"`
+	MinTlsVersion    types.String `tfsdk:"min_tls_version"`
+	HonorCipherOrder types.Bool   `tfsdk:"honor_cipher_order"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *NodeTlsOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_tls_options"
+}
+
+func (r *NodeTlsOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the TLS options of the proxmox web interface (pveproxy) on a single node. " +
+			"Proxmox exposes these only via /etc/default/pveproxy, other options in the file are kept. Changes restart pveproxy, destroying the resource restores the proxmox defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the proxmox node inside target_pve.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // options are bound to the node
+				},
+			},
+			"ciphers": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "OpenSSL cipher list for TLS <= 1.2 (CIPHERS), e.g. `ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384`.",
+			},
+			"cipher_suites": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "OpenSSL cipher suites for TLS 1.3 (CIPHERSUITES), e.g. `TLS_AES_256_GCM_SHA384`.",
+			},
+			"min_tls_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Minimum accepted TLS version, `1.2` or `1.3`. Setting `1.3` disables TLS 1.2 in pveproxy.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.2", "1.3"),
+				},
+			},
+			"honor_cipher_order": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether the server cipher order is preferred over the clients (HONOR_CIPHER_ORDER).",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *NodeTlsOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// /etc/default/pveproxy keys managed by the resource, other options like ALLOW_FROM are kept
+var tlsProxyConfigKeys = []string{"CIPHERS", "CIPHERSUITES", "DISABLE_TLS_1_2", "HONOR_CIPHER_ORDER"}
+
+// matches the option names of /etc/default/pveproxy
+var proxyConfigKeyRe = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// parseProxyConfig parses the KEY="value" lines of /etc/default/pveproxy, comments are skipped.
+func parseProxyConfig(content string) map[string]string {
+	config := map[string]string{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || !proxyConfigKeyRe.MatchString(key) {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		config[key] = value
+	}
+	return config
+}
+
+// formatProxyConfig renders config as /etc/default/pveproxy, sorted for reproducible files.
+func formatProxyConfig(config map[string]string) (string, error) {
+	keys := make([]string, 0, len(config))
+	for key, value := range config {
+		if !proxyConfigKeyRe.MatchString(key) || strings.ContainsAny(value, "\"\n") {
+			return "", fmt.Errorf("invalid pveproxy option %s", key)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var content strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&content, "%s=\"%s\"\n", key, config[key])
+	}
+	return content.String(), nil
+}
+
+// proxyConfig converts the model into /etc/default/pveproxy keys, unset attributes are left out
+// so pveproxy falls back to its defaults for them.
+func (data *NodeTlsOptionsResourceModel) proxyConfig() map[string]string {
+	config := map[string]string{}
+
+	if !data.Ciphers.IsNull() {
+		config["CIPHERS"] = data.Ciphers.ValueString()
+	}
+	if !data.CipherSuites.IsNull() {
+		config["CIPHERSUITES"] = data.CipherSuites.ValueString()
+	}
+	if data.MinTlsVersion.ValueString() == "1.3" {
+		config["DISABLE_TLS_1_2"] = "1"
+	}
+	if !data.HonorCipherOrder.IsNull() {
+		config["HONOR_CIPHER_ORDER"] = "0"
+		if data.HonorCipherOrder.ValueBool() {
+			config["HONOR_CIPHER_ORDER"] = "1"
+		}
+	}
+
+	return config
+}
+
+// readProxyConfig refreshes the model from the node's pveproxy config. Only attributes managed
+// by the user are refreshed, so unset attributes dont produce a diff.
+func (data *NodeTlsOptionsResourceModel) readProxyConfig(config map[string]string) {
+	if val, ok := config["CIPHERS"]; ok || !data.Ciphers.IsNull() {
+		data.Ciphers = types.StringValue(val)
+	}
+	if val, ok := config["CIPHERSUITES"]; ok || !data.CipherSuites.IsNull() {
+		data.CipherSuites = types.StringValue(val)
+	}
+	if config["DISABLE_TLS_1_2"] == "1" {
+		data.MinTlsVersion = types.StringValue("1.3")
+	} else if !data.MinTlsVersion.IsNull() {
+		data.MinTlsVersion = types.StringValue("1.2")
+	}
+	if val, ok := config["HONOR_CIPHER_ORDER"]; ok || !data.HonorCipherOrder.IsNull() {
+		data.HonorCipherOrder = types.BoolValue(val != "0")
+	}
+}
+
+// mergeProxyConfig replaces the managed tls keys of current with tls, the other options are kept.
+func mergeProxyConfig(current map[string]string, tls map[string]string) map[string]string {
+	merged := maps.Clone(current)
+	for _, key := range tlsProxyConfigKeys {
+		delete(merged, key)
+	}
+	maps.Copy(merged, tls)
+	return merged
+}
+
+// applyProxyConfig writes the tls options into the pveproxy config of node and restarts
+// pveproxy, nothing is done if the config is up to date already.
+func (r *NodeTlsOptionsResource) applyProxyConfig(ctx context.Context, node string, tls map[string]string) error {
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return fmt.Errorf("unable to init client, got error: %s", err)
+	}
+
+	gresp, err := client.GetNodeProxyConfig(ctx, &pb.GetNodeProxyConfigRequest{TargetPve: r.cloudInventory.TargetPve, Node: node})
+	if err != nil {
+		return fmt.Errorf("unable to get proxy config, got error: %s", err)
+	}
+
+	config := mergeProxyConfig(gresp.Config, tls)
+	if maps.Equal(config, gresp.Config) {
+		return nil
+	}
+
+	cresp, err := client.SetNodeProxyConfig(ctx, &pb.SetNodeProxyConfigRequest{TargetPve: r.cloudInventory.TargetPve, Node: node, Config: config})
+	if err != nil {
+		return fmt.Errorf("unable make set proxy config request, got error: %s", err)
+	}
+
+	if !cresp.Success {
+		return fmt.Errorf("error on server side setting proxy config, got error: %s", cresp.ErrMessage)
+	}
+
+	// pveproxy only reads its config on start
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/services/pveproxy/restart", node), nil, true, pveproxyRestartTimeout)
+	if err != nil {
+		return fmt.Errorf("unable to restart pveproxy, got error: %s", err)
+	}
+
+	return nil
+}
+
+func (r *NodeTlsOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodeTlsOptionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	if err := r.applyProxyConfig(ctx, data.Node.ValueString(), data.proxyConfig()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeTlsOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodeTlsOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.GetNodeProxyConfig(ctx, &pb.GetNodeProxyConfigRequest{TargetPve: r.cloudInventory.TargetPve, Node: data.Node.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get proxy config, got error: %s", err))
+		return
+	}
+
+	data.readProxyConfig(cresp.Config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeTlsOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NodeTlsOptionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	// all managed keys are replaced, removed attributes get reset to the defaults
+	if err := r.applyProxyConfig(ctx, data.Node.ValueString(), data.proxyConfig()); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodeTlsOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodeTlsOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	// without tls options pveproxy falls back to the proxmox defaults
+	if err := r.applyProxyConfig(ctx, data.Node.ValueString(), map[string]string{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+}
+
+func (r *NodeTlsOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node"), req, resp)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNodeTlsOptionsProxyConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		data NodeTlsOptionsResourceModel
+		want map[string]string
+	}{
+		{
+			name: "unset",
+			data: NodeTlsOptionsResourceModel{Ciphers: types.StringNull(), CipherSuites: types.StringNull(), MinTlsVersion: types.StringNull(), HonorCipherOrder: types.BoolNull()},
+			want: map[string]string{},
+		},
+		{
+			name: "cipher list",
+			data: NodeTlsOptionsResourceModel{Ciphers: types.StringValue("ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384"), CipherSuites: types.StringNull(), MinTlsVersion: types.StringNull(), HonorCipherOrder: types.BoolNull()},
+			want: map[string]string{"CIPHERS": "ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384"},
+		},
+		{
+			name: "tls 1.3 only",
+			data: NodeTlsOptionsResourceModel{Ciphers: types.StringNull(), CipherSuites: types.StringValue("TLS_AES_256_GCM_SHA384"), MinTlsVersion: types.StringValue("1.3"), HonorCipherOrder: types.BoolValue(false)},
+			want: map[string]string{"CIPHERSUITES": "TLS_AES_256_GCM_SHA384", "DISABLE_TLS_1_2": "1", "HONOR_CIPHER_ORDER": "0"},
+		},
+		{
+			name: "tls 1.2 is the default",
+			data: NodeTlsOptionsResourceModel{Ciphers: types.StringNull(), CipherSuites: types.StringNull(), MinTlsVersion: types.StringValue("1.2"), HonorCipherOrder: types.BoolValue(true)},
+			want: map[string]string{"HONOR_CIPHER_ORDER": "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.data.proxyConfig(); !maps.Equal(got, tt.want) {
+				t.Errorf("proxyConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeTlsOptionsReadProxyConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		prior  NodeTlsOptionsResourceModel
+		config map[string]string
+		want   NodeTlsOptionsResourceModel
+	}{
+		{
+			name:   "cipher list refreshed",
+			prior:  NodeTlsOptionsResourceModel{Ciphers: types.StringValue("A:B"), CipherSuites: types.StringNull(), MinTlsVersion: types.StringNull(), HonorCipherOrder: types.BoolNull()},
+			config: map[string]string{"CIPHERS": "A:B:C", "ALLOW_FROM": "10.0.0.0/8"},
+			want:   NodeTlsOptionsResourceModel{Ciphers: types.StringValue("A:B:C"), CipherSuites: types.StringNull(), MinTlsVersion: types.StringNull(), HonorCipherOrder: types.BoolNull()},
+		},
+		{
+			name:   "cipher list removed out of band",
+			prior:  NodeTlsOptionsResourceModel{Ciphers: types.StringValue("A:B"), CipherSuites: types.StringNull(), MinTlsVersion: types.StringValue("1.3"), HonorCipherOrder: types.BoolValue(true)},
+			config: map[string]string{},
+			want:   NodeTlsOptionsResourceModel{Ciphers: types.StringValue(""), CipherSuites: types.StringNull(), MinTlsVersion: types.StringValue("1.2"), HonorCipherOrder: types.BoolValue(true)},
+		},
+		{
+			name:   "unmanaged options show up",
+			prior:  NodeTlsOptionsResourceModel{Ciphers: types.StringNull(), CipherSuites: types.StringNull(), MinTlsVersion: types.StringNull(), HonorCipherOrder: types.BoolNull()},
+			config: map[string]string{"DISABLE_TLS_1_2": "1", "HONOR_CIPHER_ORDER": "0"},
+			want:   NodeTlsOptionsResourceModel{Ciphers: types.StringNull(), CipherSuites: types.StringNull(), MinTlsVersion: types.StringValue("1.3"), HonorCipherOrder: types.BoolValue(false)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.prior
+			data.readProxyConfig(tt.config)
+			if data != tt.want {
+				t.Errorf("readProxyConfig() = %+v, want %+v", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestProxyConfigRoundTrip(t *testing.T) {
+	current := parseProxyConfig("# managed by hand\nALLOW_FROM=\"10.0.0.0/8\"\nCIPHERS='A:B'\nDISABLE_TLS_1_2=1\n\ninvalid line\n")
+	want := map[string]string{"ALLOW_FROM": "10.0.0.0/8", "CIPHERS": "A:B", "DISABLE_TLS_1_2": "1"}
+	if !maps.Equal(current, want) {
+		t.Fatalf("parseProxyConfig() = %v, want %v", current, want)
+	}
+
+	// the tls keys are replaced, ALLOW_FROM is kept
+	merged := mergeProxyConfig(current, map[string]string{"CIPHERS": "C:D"})
+	want = map[string]string{"ALLOW_FROM": "10.0.0.0/8", "CIPHERS": "C:D"}
+	if !maps.Equal(merged, want) {
+		t.Fatalf("mergeProxyConfig() = %v, want %v", merged, want)
+	}
+
+	content, err := formatProxyConfig(merged)
+	if err != nil {
+		t.Fatalf("formatProxyConfig() error = %s", err)
+	}
+	if content != "ALLOW_FROM=\"10.0.0.0/8\"\nCIPHERS=\"C:D\"\n" {
+		t.Errorf("formatProxyConfig() = %q", content)
+	}
+	if got := parseProxyConfig(content); !maps.Equal(got, merged) {
+		t.Errorf("parseProxyConfig(formatProxyConfig()) = %v, want %v", got, merged)
+	}
+
+	if _, err := formatProxyConfig(map[string]string{"CIPHERS": "A\"; rm -rf /"}); err == nil {
+		t.Error("formatProxyConfig() accepted a value with quotes")
+	}
+}
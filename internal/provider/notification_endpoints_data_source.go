@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NotificationEndpointsDataSource{}
+
+func NewNotificationEndpointsDataSource() datasource.DataSource {
+	return &NotificationEndpointsDataSource{}
+}
+
+// NotificationEndpointsDataSource defines the data source implementation.
+type NotificationEndpointsDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// NotificationEndpointsDataSourceModel describes the data source data model.
+type NotificationEndpointsDataSourceModel struct {
+	Endpoints []NotificationEndpointModel `tfsdk:"endpoints"`
+}
+
+// NotificationEndpointModel describes a single endpoint of the data source.
+type NotificationEndpointModel struct {
+	Name     types.String      `tfsdk:"name"`
+	Type     types.String      `tfsdk:"type"`
+	Comment  types.String      `tfsdk:"comment"`
+	Disabled types.Bool        `tfsdk:"disabled"`
+	Config   map[string]string `tfsdk:"config"`
+	Matchers []string          `tfsdk:"matchers"`
+}
+
+// PveNotificationTarget is an entry of pvesh get /cluster/notifications/targets.
+type PveNotificationTarget struct {
+	Name    string  `json:"name"`
+	Type    string  `json:"type"`
+	Comment string  `json:"comment"`
+	Disable pveBool `json:"disable"`
+}
+
+// PveNotificationMatcher is the subset of pvesh get /cluster/notifications/matchers we join on.
+type PveNotificationMatcher struct {
+	Name   string          `json:"name"`
+	Target json.RawMessage `json:"target"`
+}
+
+// keys of endpoint configs that hold secrets
+var notificationSecretKeys = []string{"token", "password", "secret"}
+
+// keys that are already exposed as dedicated attributes
+var notificationSkipKeys = []string{"name", "type", "comment", "disable", "digest", "origin"}
+
+func (d *NotificationEndpointsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_endpoints"
+}
+
+func (d *NotificationEndpointsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the notification endpoints of the target_pve cluster together with the matchers routing to them. Secrets like tokens and passwords are redacted as `***`.",
+
+		Attributes: map[string]schema.Attribute{
+			"endpoints": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Configured notification endpoints.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the endpoint.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Endpoint type, e.g. gotify, smtp, sendmail or webhook.",
+						},
+						"comment": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Comment of the endpoint.",
+						},
+						"disabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the endpoint is disabled.",
+						},
+						"config": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Type specific endpoint config with secrets redacted. Non string values are json encoded.",
+						},
+						"matchers": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Names of the matchers that route notifications to this endpoint.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NotificationEndpointsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *NotificationEndpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NotificationEndpointsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// all targets of every endpoint type
+	var targets []PveNotificationTarget
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, "/cluster/notifications/targets", &targets)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get notification targets, got error: %s", err))
+		return
+	}
+
+	// type specific configs, only fetched for types that are in use
+	configs := map[string]map[string]string{}
+	fetchedTypes := map[string]bool{}
+	for _, target := range targets {
+		if fetchedTypes[target.Type] {
+			continue
+		}
+		fetchedTypes[target.Type] = true
+
+		var endpoints []map[string]interface{}
+		err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/endpoints/%s", target.Type), &endpoints)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get %s notification endpoints, got error: %s", target.Type, err))
+			return
+		}
+
+		for _, endpoint := range endpoints {
+			configs[fmt.Sprint(endpoint["name"])] = redactNotificationConfig(endpoint)
+		}
+	}
+
+	var matchers []PveNotificationMatcher
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, "/cluster/notifications/matchers", &matchers)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get notification matchers, got error: %s", err))
+		return
+	}
+
+	data.Endpoints = []NotificationEndpointModel{}
+	for _, target := range targets {
+		endpoint := NotificationEndpointModel{
+			Name:     types.StringValue(target.Name),
+			Type:     types.StringValue(target.Type),
+			Comment:  types.StringValue(target.Comment),
+			Disabled: types.BoolValue(bool(target.Disable)),
+			Config:   configs[target.Name],
+			Matchers: []string{},
+		}
+		if endpoint.Config == nil {
+			endpoint.Config = map[string]string{}
+		}
+
+		for _, matcher := range matchers {
+			if slices.Contains(pveStringList(matcher.Target), target.Name) {
+				endpoint.Matchers = append(endpoint.Matchers, matcher.Name)
+			}
+		}
+
+		data.Endpoints = append(data.Endpoints, endpoint)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// redactNotificationConfig flattens an endpoint config to strings and masks secret values.
+func redactNotificationConfig(endpoint map[string]interface{}) map[string]string {
+	config := map[string]string{}
+	for key, value := range endpoint {
+		if slices.Contains(notificationSkipKeys, key) {
+			continue
+		}
+
+		if slices.Contains(notificationSecretKeys, key) {
+			config[key] = "***"
+			continue
+		}
+
+		if str, ok := value.(string); ok {
+			config[key] = str
+			continue
+		}
+
+		encoded, _ := json.Marshal(value)
+		config[key] = string(encoded)
+	}
+
+	return config
+}
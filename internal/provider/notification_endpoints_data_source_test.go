@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestRedactNotificationConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint map[string]interface{}
+		want     map[string]string
+	}{
+		{
+			name:     "gotify token",
+			endpoint: map[string]interface{}{"name": "gotify-web", "type": "gotify", "server": "https://gotify.example.com", "token": "AbCdEf", "digest": "1a2b"},
+			want:     map[string]string{"server": "https://gotify.example.com", "token": "***"},
+		},
+		{
+			name:     "smtp password",
+			endpoint: map[string]interface{}{"name": "mail", "server": "smtp.example.com", "port": float64(587), "username": "pve", "password": "hunter2", "mailto": []interface{}{"ops@example.com"}},
+			want:     map[string]string{"server": "smtp.example.com", "port": "587", "username": "pve", "password": "***", "mailto": `["ops@example.com"]`},
+		},
+		{
+			name:     "webhook secret",
+			endpoint: map[string]interface{}{"name": "hook", "url": "https://hooks.example.com", "secret": []interface{}{"name=key,value=abc"}, "comment": "ops"},
+			want:     map[string]string{"url": "https://hooks.example.com", "secret": "***"},
+		},
+		{
+			name:     "sendmail without secrets",
+			endpoint: map[string]interface{}{"name": "mail-to-root", "mailto-user": []interface{}{"root@pam"}, "origin": "builtin"},
+			want:     map[string]string{"mailto-user": `["root@pam"]`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactNotificationConfig(tt.endpoint); !maps.Equal(got, tt.want) {
+				t.Errorf("redactNotificationConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationEndpointsDataSourceRead(t *testing.T) {
+	d := &NotificationEndpointsDataSource{cloudInventory: testInventory(fakePveApi(map[string]string{
+		"/cluster/notifications/targets": `[
+			{"name": "gotify-web", "type": "gotify", "comment": "stack web"},
+			{"name": "mail-to-root", "type": "sendmail", "disable": 1}
+		]`,
+		"/cluster/notifications/endpoints/gotify":   `[{"name": "gotify-web", "server": "https://gotify.example.com", "token": "AbCdEf"}]`,
+		"/cluster/notifications/endpoints/sendmail": `[{"name": "mail-to-root", "mailto-user": ["root@pam"]}]`,
+		"/cluster/notifications/matchers": `[
+			{"name": "errors", "target": ["gotify-web", "mail-to-root"]},
+			{"name": "web-matcher", "target": "gotify-web"},
+			{"name": "unrouted"}
+		]`,
+	}))}
+
+	var data NotificationEndpointsDataSourceModel
+	state := readDataSource(t, d, nil)
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("state.Get() diagnostics: %v", diags)
+	}
+
+	tests := []struct {
+		name         string
+		wantDisabled bool
+		wantConfig   map[string]string
+		wantMatchers []string
+	}{
+		{name: "gotify-web", wantConfig: map[string]string{"server": "https://gotify.example.com", "token": "***"}, wantMatchers: []string{"errors", "web-matcher"}},
+		{name: "mail-to-root", wantDisabled: true, wantConfig: map[string]string{"mailto-user": `["root@pam"]`}, wantMatchers: []string{"errors"}},
+	}
+
+	if len(data.Endpoints) != len(tests) {
+		t.Fatalf("got %d endpoints, want %d", len(data.Endpoints), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := data.Endpoints[i]
+			if endpoint.Name.ValueString() != tt.name {
+				t.Fatalf("name = %s, want %s", endpoint.Name, tt.name)
+			}
+			if endpoint.Disabled.ValueBool() != tt.wantDisabled {
+				t.Errorf("disabled = %s, want %t", endpoint.Disabled, tt.wantDisabled)
+			}
+			if !maps.Equal(endpoint.Config, tt.wantConfig) {
+				t.Errorf("config = %v, want %v", endpoint.Config, tt.wantConfig)
+			}
+			if !slices.Equal(endpoint.Matchers, tt.wantMatchers) {
+				t.Errorf("matchers = %v, want %v", endpoint.Matchers, tt.wantMatchers)
+			}
+		})
+	}
+}
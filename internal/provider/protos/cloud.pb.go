@@ -21,6 +21,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	ErrorCode_NOT_FOUND              ErrorCode = 1
+	ErrorCode_CONFLICT               ErrorCode = 2
+	ErrorCode_UNREACHABLE            ErrorCode = 3
+	ErrorCode_AUTH                   ErrorCode = 4
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_UNSPECIFIED",
+		1: "NOT_FOUND",
+		2: "CONFLICT",
+		3: "UNREACHABLE",
+		4: "AUTH",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED": 0,
+		"NOT_FOUND":              1,
+		"CONFLICT":               2,
+		"UNREACHABLE":            3,
+		"AUTH":                   4,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_cloud_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_protos_cloud_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{0}
+}
+
 type GetSshKeyRequest_KeyType int32
 
 const (
@@ -51,11 +106,11 @@ func (x GetSshKeyRequest_KeyType) String() string {
 }
 
 func (GetSshKeyRequest_KeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_protos_cloud_proto_enumTypes[0].Descriptor()
+	return file_protos_cloud_proto_enumTypes[1].Descriptor()
 }
 
 func (GetSshKeyRequest_KeyType) Type() protoreflect.EnumType {
-	return &file_protos_cloud_proto_enumTypes[0]
+	return &file_protos_cloud_proto_enumTypes[1]
 }
 
 func (x GetSshKeyRequest_KeyType) Number() protoreflect.EnumNumber {
@@ -64,7 +119,53 @@ func (x GetSshKeyRequest_KeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetSshKeyRequest_KeyType.Descriptor instead.
 func (GetSshKeyRequest_KeyType) EnumDescriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{10, 0}
+	return file_protos_cloud_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type CephCommandRequest_Tool int32
+
+const (
+	CephCommandRequest_CEPH CephCommandRequest_Tool = 0
+	CephCommandRequest_RBD  CephCommandRequest_Tool = 1
+)
+
+// Enum value maps for CephCommandRequest_Tool.
+var (
+	CephCommandRequest_Tool_name = map[int32]string{
+		0: "CEPH",
+		1: "RBD",
+	}
+	CephCommandRequest_Tool_value = map[string]int32{
+		"CEPH": 0,
+		"RBD":  1,
+	}
+)
+
+func (x CephCommandRequest_Tool) Enum() *CephCommandRequest_Tool {
+	p := new(CephCommandRequest_Tool)
+	*p = x
+	return p
+}
+
+func (x CephCommandRequest_Tool) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CephCommandRequest_Tool) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_cloud_proto_enumTypes[2].Descriptor()
+}
+
+func (CephCommandRequest_Tool) Type() protoreflect.EnumType {
+	return &file_protos_cloud_proto_enumTypes[2]
+}
+
+func (x CephCommandRequest_Tool) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CephCommandRequest_Tool.Descriptor instead.
+func (CephCommandRequest_Tool) EnumDescriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type GetPveInventoryRequest struct {
@@ -419,6 +520,7 @@ type CreateProxmoxApiResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
+	Output        string                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -467,10 +569,18 @@ func (x *CreateProxmoxApiResponse) GetErrMessage() string {
 	return ""
 }
 
+func (x *CreateProxmoxApiResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
 type DeleteProxmoxApiRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
 	ApiPath       string                 `protobuf:"bytes,2,opt,name=api_path,json=apiPath,proto3" json:"api_path,omitempty"`
+	DeleteArgs    map[string]string      `protobuf:"bytes,3,rep,name=delete_args,json=deleteArgs,proto3" json:"delete_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -519,10 +629,18 @@ func (x *DeleteProxmoxApiRequest) GetApiPath() string {
 	return ""
 }
 
+func (x *DeleteProxmoxApiRequest) GetDeleteArgs() map[string]string {
+	if x != nil {
+		return x.DeleteArgs
+	}
+	return nil
+}
+
 type DeleteProxmoxApiResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
+	Output        string                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -571,28 +689,36 @@ func (x *DeleteProxmoxApiResponse) GetErrMessage() string {
 	return ""
 }
 
-type GetSshKeyRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	TargetPve     string                   `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
-	KeyType       GetSshKeyRequest_KeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=protos.GetSshKeyRequest_KeyType" json:"key_type,omitempty"`
+func (x *DeleteProxmoxApiResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type SetProxmoxApiRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	ApiPath       string                 `protobuf:"bytes,2,opt,name=api_path,json=apiPath,proto3" json:"api_path,omitempty"`
+	SetArgs       map[string]string      `protobuf:"bytes,3,rep,name=set_args,json=setArgs,proto3" json:"set_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSshKeyRequest) Reset() {
-	*x = GetSshKeyRequest{}
+func (x *SetProxmoxApiRequest) Reset() {
+	*x = SetProxmoxApiRequest{}
 	mi := &file_protos_cloud_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSshKeyRequest) String() string {
+func (x *SetProxmoxApiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSshKeyRequest) ProtoMessage() {}
+func (*SetProxmoxApiRequest) ProtoMessage() {}
 
-func (x *GetSshKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *SetProxmoxApiRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,46 +730,55 @@ func (x *GetSshKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSshKeyRequest.ProtoReflect.Descriptor instead.
-func (*GetSshKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProxmoxApiRequest.ProtoReflect.Descriptor instead.
+func (*SetProxmoxApiRequest) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetSshKeyRequest) GetTargetPve() string {
+func (x *SetProxmoxApiRequest) GetTargetPve() string {
 	if x != nil {
 		return x.TargetPve
 	}
 	return ""
 }
 
-func (x *GetSshKeyRequest) GetKeyType() GetSshKeyRequest_KeyType {
+func (x *SetProxmoxApiRequest) GetApiPath() string {
 	if x != nil {
-		return x.KeyType
+		return x.ApiPath
 	}
-	return GetSshKeyRequest_AUTOMATION
+	return ""
 }
 
-type GetSshKeyResponse struct {
+func (x *SetProxmoxApiRequest) GetSetArgs() map[string]string {
+	if x != nil {
+		return x.SetArgs
+	}
+	return nil
+}
+
+type SetProxmoxApiResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
+	Output        string                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSshKeyResponse) Reset() {
-	*x = GetSshKeyResponse{}
+func (x *SetProxmoxApiResponse) Reset() {
+	*x = SetProxmoxApiResponse{}
 	mi := &file_protos_cloud_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSshKeyResponse) String() string {
+func (x *SetProxmoxApiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSshKeyResponse) ProtoMessage() {}
+func (*SetProxmoxApiResponse) ProtoMessage() {}
 
-func (x *GetSshKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *SetProxmoxApiResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,39 +790,56 @@ func (x *GetSshKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSshKeyResponse.ProtoReflect.Descriptor instead.
-func (*GetSshKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProxmoxApiResponse.ProtoReflect.Descriptor instead.
+func (*SetProxmoxApiResponse) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetSshKeyResponse) GetKey() string {
+func (x *SetProxmoxApiResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Key
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetProxmoxApiResponse) GetErrMessage() string {
+	if x != nil {
+		return x.ErrMessage
 	}
 	return ""
 }
 
-type GetCephAccessRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetProxmoxApiResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
 }
 
-func (x *GetCephAccessRequest) Reset() {
-	*x = GetCephAccessRequest{}
+type WaitForTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve      string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	Upid           string                 `protobuf:"bytes,2,opt,name=upid,proto3" json:"upid,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	LogLines       int64                  `protobuf:"varint,4,opt,name=log_lines,json=logLines,proto3" json:"log_lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WaitForTaskRequest) Reset() {
+	*x = WaitForTaskRequest{}
 	mi := &file_protos_cloud_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCephAccessRequest) String() string {
+func (x *WaitForTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCephAccessRequest) ProtoMessage() {}
+func (*WaitForTaskRequest) ProtoMessage() {}
 
-func (x *GetCephAccessRequest) ProtoReflect() protoreflect.Message {
+func (x *WaitForTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,40 +851,62 @@ func (x *GetCephAccessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCephAccessRequest.ProtoReflect.Descriptor instead.
-func (*GetCephAccessRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WaitForTaskRequest.ProtoReflect.Descriptor instead.
+func (*WaitForTaskRequest) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetCephAccessRequest) GetTargetPve() string {
+func (x *WaitForTaskRequest) GetTargetPve() string {
 	if x != nil {
 		return x.TargetPve
 	}
 	return ""
 }
 
-type GetCephAccessResponse struct {
+func (x *WaitForTaskRequest) GetUpid() string {
+	if x != nil {
+		return x.Upid
+	}
+	return ""
+}
+
+func (x *WaitForTaskRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *WaitForTaskRequest) GetLogLines() int64 {
+	if x != nil {
+		return x.LogLines
+	}
+	return 0
+}
+
+type WaitForTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CephConf      string                 `protobuf:"bytes,1,opt,name=ceph_conf,json=cephConf,proto3" json:"ceph_conf,omitempty"`
-	AdminKeyring  string                 `protobuf:"bytes,2,opt,name=admin_keyring,json=adminKeyring,proto3" json:"admin_keyring,omitempty"`
+	Finished      bool                   `protobuf:"varint,1,opt,name=finished,proto3" json:"finished,omitempty"`
+	ExitStatus    string                 `protobuf:"bytes,2,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
+	LogTail       string                 `protobuf:"bytes,3,opt,name=log_tail,json=logTail,proto3" json:"log_tail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCephAccessResponse) Reset() {
-	*x = GetCephAccessResponse{}
+func (x *WaitForTaskResponse) Reset() {
+	*x = WaitForTaskResponse{}
 	mi := &file_protos_cloud_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCephAccessResponse) String() string {
+func (x *WaitForTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCephAccessResponse) ProtoMessage() {}
+func (*WaitForTaskResponse) ProtoMessage() {}
 
-func (x *GetCephAccessResponse) ProtoReflect() protoreflect.Message {
+func (x *WaitForTaskResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -744,47 +918,54 @@ func (x *GetCephAccessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCephAccessResponse.ProtoReflect.Descriptor instead.
-func (*GetCephAccessResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WaitForTaskResponse.ProtoReflect.Descriptor instead.
+func (*WaitForTaskResponse) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetCephAccessResponse) GetCephConf() string {
+func (x *WaitForTaskResponse) GetFinished() bool {
 	if x != nil {
-		return x.CephConf
+		return x.Finished
+	}
+	return false
+}
+
+func (x *WaitForTaskResponse) GetExitStatus() string {
+	if x != nil {
+		return x.ExitStatus
 	}
 	return ""
 }
 
-func (x *GetCephAccessResponse) GetAdminKeyring() string {
+func (x *WaitForTaskResponse) GetLogTail() string {
 	if x != nil {
-		return x.AdminKeyring
+		return x.LogTail
 	}
 	return ""
 }
 
-type GetKubeconfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
-	StackName     string                 `protobuf:"bytes,2,opt,name=stack_name,json=stackName,proto3" json:"stack_name,omitempty"`
+type GetSshKeyRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	TargetPve     string                   `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	KeyType       GetSshKeyRequest_KeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=protos.GetSshKeyRequest_KeyType" json:"key_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetKubeconfigRequest) Reset() {
-	*x = GetKubeconfigRequest{}
+func (x *GetSshKeyRequest) Reset() {
+	*x = GetSshKeyRequest{}
 	mi := &file_protos_cloud_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKubeconfigRequest) String() string {
+func (x *GetSshKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKubeconfigRequest) ProtoMessage() {}
+func (*GetSshKeyRequest) ProtoMessage() {}
 
-func (x *GetKubeconfigRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSshKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,46 +977,46 @@ func (x *GetKubeconfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKubeconfigRequest.ProtoReflect.Descriptor instead.
-func (*GetKubeconfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSshKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetSshKeyRequest) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetKubeconfigRequest) GetTargetPve() string {
+func (x *GetSshKeyRequest) GetTargetPve() string {
 	if x != nil {
 		return x.TargetPve
 	}
 	return ""
 }
 
-func (x *GetKubeconfigRequest) GetStackName() string {
+func (x *GetSshKeyRequest) GetKeyType() GetSshKeyRequest_KeyType {
 	if x != nil {
-		return x.StackName
+		return x.KeyType
 	}
-	return ""
+	return GetSshKeyRequest_AUTOMATION
 }
 
-type GetKubeconfigResponse struct {
+type GetSshKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetKubeconfigResponse) Reset() {
-	*x = GetKubeconfigResponse{}
+func (x *GetSshKeyResponse) Reset() {
+	*x = GetSshKeyResponse{}
 	mi := &file_protos_cloud_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKubeconfigResponse) String() string {
+func (x *GetSshKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKubeconfigResponse) ProtoMessage() {}
+func (*GetSshKeyResponse) ProtoMessage() {}
 
-func (x *GetKubeconfigResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSshKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,39 +1028,39 @@ func (x *GetKubeconfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKubeconfigResponse.ProtoReflect.Descriptor instead.
-func (*GetKubeconfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSshKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetSshKeyResponse) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetKubeconfigResponse) GetConfig() string {
+func (x *GetSshKeyResponse) GetKey() string {
 	if x != nil {
-		return x.Config
+		return x.Key
 	}
 	return ""
 }
 
-type GetClusterVarsRequest struct {
+type GetCephAccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClusterVarsRequest) Reset() {
-	*x = GetClusterVarsRequest{}
+func (x *GetCephAccessRequest) Reset() {
+	*x = GetCephAccessRequest{}
 	mi := &file_protos_cloud_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterVarsRequest) String() string {
+func (x *GetCephAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterVarsRequest) ProtoMessage() {}
+func (*GetCephAccessRequest) ProtoMessage() {}
 
-func (x *GetClusterVarsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCephAccessRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -891,39 +1072,40 @@ func (x *GetClusterVarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterVarsRequest.ProtoReflect.Descriptor instead.
-func (*GetClusterVarsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCephAccessRequest.ProtoReflect.Descriptor instead.
+func (*GetCephAccessRequest) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetClusterVarsRequest) GetTargetPve() string {
+func (x *GetCephAccessRequest) GetTargetPve() string {
 	if x != nil {
 		return x.TargetPve
 	}
 	return ""
 }
 
-type GetClusterVarsResponse struct {
+type GetCephAccessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vars          string                 `protobuf:"bytes,1,opt,name=vars,proto3" json:"vars,omitempty"`
+	CephConf      string                 `protobuf:"bytes,1,opt,name=ceph_conf,json=cephConf,proto3" json:"ceph_conf,omitempty"`
+	AdminKeyring  string                 `protobuf:"bytes,2,opt,name=admin_keyring,json=adminKeyring,proto3" json:"admin_keyring,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClusterVarsResponse) Reset() {
-	*x = GetClusterVarsResponse{}
+func (x *GetCephAccessResponse) Reset() {
+	*x = GetCephAccessResponse{}
 	mi := &file_protos_cloud_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterVarsResponse) String() string {
+func (x *GetCephAccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterVarsResponse) ProtoMessage() {}
+func (*GetCephAccessResponse) ProtoMessage() {}
 
-func (x *GetClusterVarsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCephAccessResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_cloud_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -935,14 +1117,205 @@ func (x *GetClusterVarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterVarsResponse.ProtoReflect.Descriptor instead.
-func (*GetClusterVarsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCephAccessResponse.ProtoReflect.Descriptor instead.
+func (*GetCephAccessResponse) Descriptor() ([]byte, []int) {
 	return file_protos_cloud_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetClusterVarsResponse) GetVars() string {
+func (x *GetCephAccessResponse) GetCephConf() string {
 	if x != nil {
-		return x.Vars
+		return x.CephConf
+	}
+	return ""
+}
+
+func (x *GetCephAccessResponse) GetAdminKeyring() string {
+	if x != nil {
+		return x.AdminKeyring
+	}
+	return ""
+}
+
+type GetKubeconfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	StackName     string                 `protobuf:"bytes,2,opt,name=stack_name,json=stackName,proto3" json:"stack_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKubeconfigRequest) Reset() {
+	*x = GetKubeconfigRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKubeconfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKubeconfigRequest) ProtoMessage() {}
+
+func (x *GetKubeconfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKubeconfigRequest.ProtoReflect.Descriptor instead.
+func (*GetKubeconfigRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetKubeconfigRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *GetKubeconfigRequest) GetStackName() string {
+	if x != nil {
+		return x.StackName
+	}
+	return ""
+}
+
+type GetKubeconfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKubeconfigResponse) Reset() {
+	*x = GetKubeconfigResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKubeconfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKubeconfigResponse) ProtoMessage() {}
+
+func (x *GetKubeconfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKubeconfigResponse.ProtoReflect.Descriptor instead.
+func (*GetKubeconfigResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetKubeconfigResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type GetClusterVarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterVarsRequest) Reset() {
+	*x = GetClusterVarsRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterVarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterVarsRequest) ProtoMessage() {}
+
+func (x *GetClusterVarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterVarsRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterVarsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetClusterVarsRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+type GetClusterVarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vars          string                 `protobuf:"bytes,1,opt,name=vars,proto3" json:"vars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterVarsResponse) Reset() {
+	*x = GetClusterVarsResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterVarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterVarsResponse) ProtoMessage() {}
+
+func (x *GetClusterVarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterVarsResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterVarsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetClusterVarsResponse) GetVars() string {
+	if x != nil {
+		return x.Vars
 	}
 	return ""
 }
@@ -958,7 +1331,7 @@ type GetCloudFileSecretRequest struct {
 
 func (x *GetCloudFileSecretRequest) Reset() {
 	*x = GetCloudFileSecretRequest{}
-	mi := &file_protos_cloud_proto_msgTypes[18]
+	mi := &file_protos_cloud_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -970,7 +1343,7 @@ func (x *GetCloudFileSecretRequest) String() string {
 func (*GetCloudFileSecretRequest) ProtoMessage() {}
 
 func (x *GetCloudFileSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[18]
+	mi := &file_protos_cloud_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1356,7 @@ func (x *GetCloudFileSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCloudFileSecretRequest.ProtoReflect.Descriptor instead.
 func (*GetCloudFileSecretRequest) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{18}
+	return file_protos_cloud_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetCloudFileSecretRequest) GetTargetPve() string {
@@ -1010,13 +1383,14 @@ func (x *GetCloudFileSecretRequest) GetRstrip() bool {
 type GetCloudFileSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Raw           []byte                 `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCloudFileSecretResponse) Reset() {
 	*x = GetCloudFileSecretResponse{}
-	mi := &file_protos_cloud_proto_msgTypes[19]
+	mi := &file_protos_cloud_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1028,7 +1402,7 @@ func (x *GetCloudFileSecretResponse) String() string {
 func (*GetCloudFileSecretResponse) ProtoMessage() {}
 
 func (x *GetCloudFileSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[19]
+	mi := &file_protos_cloud_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,7 +1415,7 @@ func (x *GetCloudFileSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCloudFileSecretResponse.ProtoReflect.Descriptor instead.
 func (*GetCloudFileSecretResponse) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{19}
+	return file_protos_cloud_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetCloudFileSecretResponse) GetSecret() string {
@@ -1051,6 +1425,13 @@ func (x *GetCloudFileSecretResponse) GetSecret() string {
 	return ""
 }
 
+func (x *GetCloudFileSecretResponse) GetRaw() []byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
 type CreateCloudSecretRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
@@ -1058,13 +1439,15 @@ type CreateCloudSecretRequest struct {
 	SecretName    string                 `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
 	SecretData    string                 `protobuf:"bytes,4,opt,name=secret_data,json=secretData,proto3" json:"secret_data,omitempty"`
 	SecretType    string                 `protobuf:"bytes,5,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	Namespace     string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateCloudSecretRequest) Reset() {
 	*x = CreateCloudSecretRequest{}
-	mi := &file_protos_cloud_proto_msgTypes[20]
+	mi := &file_protos_cloud_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1076,7 +1459,7 @@ func (x *CreateCloudSecretRequest) String() string {
 func (*CreateCloudSecretRequest) ProtoMessage() {}
 
 func (x *CreateCloudSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[20]
+	mi := &file_protos_cloud_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1472,7 @@ func (x *CreateCloudSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCloudSecretRequest.ProtoReflect.Descriptor instead.
 func (*CreateCloudSecretRequest) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{20}
+	return file_protos_cloud_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateCloudSecretRequest) GetCloudDomain() string {
@@ -1127,6 +1510,20 @@ func (x *CreateCloudSecretRequest) GetSecretType() string {
 	return ""
 }
 
+func (x *CreateCloudSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateCloudSecretRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
 type CreateCloudSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1137,7 +1534,7 @@ type CreateCloudSecretResponse struct {
 
 func (x *CreateCloudSecretResponse) Reset() {
 	*x = CreateCloudSecretResponse{}
-	mi := &file_protos_cloud_proto_msgTypes[21]
+	mi := &file_protos_cloud_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1149,7 +1546,7 @@ func (x *CreateCloudSecretResponse) String() string {
 func (*CreateCloudSecretResponse) ProtoMessage() {}
 
 func (x *CreateCloudSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[21]
+	mi := &file_protos_cloud_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1559,7 @@ func (x *CreateCloudSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCloudSecretResponse.ProtoReflect.Descriptor instead.
 func (*CreateCloudSecretResponse) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{21}
+	return file_protos_cloud_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateCloudSecretResponse) GetSuccess() bool {
@@ -1184,25 +1581,1417 @@ type DeleteCloudSecretRequest struct {
 	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
 	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
 	SecretName    string                 `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteCloudSecretRequest) Reset() {
 	*x = DeleteCloudSecretRequest{}
-	mi := &file_protos_cloud_proto_msgTypes[22]
+	mi := &file_protos_cloud_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCloudSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCloudSecretRequest) ProtoMessage() {}
+
+func (x *DeleteCloudSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCloudSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCloudSecretRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteCloudSecretRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *DeleteCloudSecretRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *DeleteCloudSecretRequest) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *DeleteCloudSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type DeleteCloudSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCloudSecretResponse) Reset() {
+	*x = DeleteCloudSecretResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCloudSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCloudSecretResponse) ProtoMessage() {}
+
+func (x *DeleteCloudSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCloudSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCloudSecretResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteCloudSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCloudSecretResponse) GetErrMessage() string {
+	if x != nil {
+		return x.ErrMessage
+	}
+	return ""
+}
+
+type UpdateCloudSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	SecretName    string                 `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	SecretData    string                 `protobuf:"bytes,4,opt,name=secret_data,json=secretData,proto3" json:"secret_data,omitempty"`
+	SecretType    string                 `protobuf:"bytes,5,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	Namespace     string                 `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCloudSecretRequest) Reset() {
+	*x = UpdateCloudSecretRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCloudSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCloudSecretRequest) ProtoMessage() {}
+
+func (x *UpdateCloudSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCloudSecretRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCloudSecretRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateCloudSecretRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *UpdateCloudSecretRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *UpdateCloudSecretRequest) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *UpdateCloudSecretRequest) GetSecretData() string {
+	if x != nil {
+		return x.SecretData
+	}
+	return ""
+}
+
+func (x *UpdateCloudSecretRequest) GetSecretType() string {
+	if x != nil {
+		return x.SecretType
+	}
+	return ""
+}
+
+func (x *UpdateCloudSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateCloudSecretRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type UpdateCloudSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCloudSecretResponse) Reset() {
+	*x = UpdateCloudSecretResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCloudSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCloudSecretResponse) ProtoMessage() {}
+
+func (x *UpdateCloudSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCloudSecretResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCloudSecretResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateCloudSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateCloudSecretResponse) GetErrMessage() string {
+	if x != nil {
+		return x.ErrMessage
+	}
+	return ""
+}
+
+type GetCloudSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	SecretName    string                 `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretRequest) Reset() {
+	*x = GetCloudSecretRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretRequest) ProtoMessage() {}
+
+func (x *GetCloudSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCloudSecretRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *GetCloudSecretRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *GetCloudSecretRequest) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *GetCloudSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetCloudSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretResponse) Reset() {
+	*x = GetCloudSecretResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretResponse) ProtoMessage() {}
+
+func (x *GetCloudSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretResponse.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetCloudSecretResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *GetCloudSecretResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetCloudSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	SecretType    string                 `protobuf:"bytes,3,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretsRequest) Reset() {
+	*x = GetCloudSecretsRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretsRequest) ProtoMessage() {}
+
+func (x *GetCloudSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretsRequest.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetCloudSecretsRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *GetCloudSecretsRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *GetCloudSecretsRequest) GetSecretType() string {
+	if x != nil {
+		return x.SecretType
+	}
+	return ""
+}
+
+func (x *GetCloudSecretsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetCloudSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       string                 `protobuf:"bytes,1,opt,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretsResponse) Reset() {
+	*x = GetCloudSecretsResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretsResponse) ProtoMessage() {}
+
+func (x *GetCloudSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretsResponse.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetCloudSecretsResponse) GetSecrets() string {
+	if x != nil {
+		return x.Secrets
+	}
+	return ""
+}
+
+type GetCloudSecretByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	SecretName    string                 `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretByNameRequest) Reset() {
+	*x = GetCloudSecretByNameRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretByNameRequest) ProtoMessage() {}
+
+func (x *GetCloudSecretByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretByNameRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCloudSecretByNameRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *GetCloudSecretByNameRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *GetCloudSecretByNameRequest) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *GetCloudSecretByNameRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetCloudSecretByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         bool                   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	SecretData    string                 `protobuf:"bytes,2,opt,name=secret_data,json=secretData,proto3" json:"secret_data,omitempty"`
+	SecretType    string                 `protobuf:"bytes,3,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretByNameResponse) Reset() {
+	*x = GetCloudSecretByNameResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretByNameResponse) ProtoMessage() {}
+
+func (x *GetCloudSecretByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretByNameResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCloudSecretByNameResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *GetCloudSecretByNameResponse) GetSecretData() string {
+	if x != nil {
+		return x.SecretData
+	}
+	return ""
+}
+
+func (x *GetCloudSecretByNameResponse) GetSecretType() string {
+	if x != nil {
+		return x.SecretType
+	}
+	return ""
+}
+
+func (x *GetCloudSecretByNameResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetCloudSecretNamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	SecretType    string                 `protobuf:"bytes,3,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretNamesRequest) Reset() {
+	*x = GetCloudSecretNamesRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretNamesRequest) ProtoMessage() {}
+
+func (x *GetCloudSecretNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretNamesRequest.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretNamesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCloudSecretNamesRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *GetCloudSecretNamesRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *GetCloudSecretNamesRequest) GetSecretType() string {
+	if x != nil {
+		return x.SecretType
+	}
+	return ""
+}
+
+func (x *GetCloudSecretNamesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type CloudSecretMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretName    string                 `protobuf:"bytes,1,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	SecretType    string                 `protobuf:"bytes,2,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloudSecretMeta) Reset() {
+	*x = CloudSecretMeta{}
+	mi := &file_protos_cloud_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloudSecretMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudSecretMeta) ProtoMessage() {}
+
+func (x *CloudSecretMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudSecretMeta.ProtoReflect.Descriptor instead.
+func (*CloudSecretMeta) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CloudSecretMeta) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *CloudSecretMeta) GetSecretType() string {
+	if x != nil {
+		return x.SecretType
+	}
+	return ""
+}
+
+func (x *CloudSecretMeta) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CloudSecretMeta) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *CloudSecretMeta) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetCloudSecretNamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*CloudSecretMeta     `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudSecretNamesResponse) Reset() {
+	*x = GetCloudSecretNamesResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudSecretNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudSecretNamesResponse) ProtoMessage() {}
+
+func (x *GetCloudSecretNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudSecretNamesResponse.ProtoReflect.Descriptor instead.
+func (*GetCloudSecretNamesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCloudSecretNamesResponse) GetSecrets() []*CloudSecretMeta {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type GetVmVarsBlakeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	CloudDomain   string                 `protobuf:"bytes,2,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	BlakeIds      []string               `protobuf:"bytes,3,rep,name=blake_ids,json=blakeIds,proto3" json:"blake_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVmVarsBlakeRequest) Reset() {
+	*x = GetVmVarsBlakeRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVmVarsBlakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVmVarsBlakeRequest) ProtoMessage() {}
+
+func (x *GetVmVarsBlakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVmVarsBlakeRequest.ProtoReflect.Descriptor instead.
+func (*GetVmVarsBlakeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetVmVarsBlakeRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *GetVmVarsBlakeRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *GetVmVarsBlakeRequest) GetBlakeIds() []string {
+	if x != nil {
+		return x.BlakeIds
+	}
+	return nil
+}
+
+type GetVmVarsBlakeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlakeIdVars   map[string]string      `protobuf:"bytes,1,rep,name=blake_id_vars,json=blakeIdVars,proto3" json:"blake_id_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVmVarsBlakeResponse) Reset() {
+	*x = GetVmVarsBlakeResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVmVarsBlakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVmVarsBlakeResponse) ProtoMessage() {}
+
+func (x *GetVmVarsBlakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVmVarsBlakeResponse.ProtoReflect.Descriptor instead.
+func (*GetVmVarsBlakeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetVmVarsBlakeResponse) GetBlakeIdVars() map[string]string {
+	if x != nil {
+		return x.BlakeIdVars
+	}
+	return nil
+}
+
+type VmVarsBlakeEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlakeId       string                 `protobuf:"bytes,1,opt,name=blake_id,json=blakeId,proto3" json:"blake_id,omitempty"`
+	Vars          string                 `protobuf:"bytes,2,opt,name=vars,proto3" json:"vars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VmVarsBlakeEntry) Reset() {
+	*x = VmVarsBlakeEntry{}
+	mi := &file_protos_cloud_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VmVarsBlakeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VmVarsBlakeEntry) ProtoMessage() {}
+
+func (x *VmVarsBlakeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VmVarsBlakeEntry.ProtoReflect.Descriptor instead.
+func (*VmVarsBlakeEntry) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VmVarsBlakeEntry) GetBlakeId() string {
+	if x != nil {
+		return x.BlakeId
+	}
+	return ""
+}
+
+func (x *VmVarsBlakeEntry) GetVars() string {
+	if x != nil {
+		return x.Vars
+	}
+	return ""
+}
+
+type SetVmVarsBlakeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	CloudDomain   string                 `protobuf:"bytes,2,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	BlakeId       string                 `protobuf:"bytes,3,opt,name=blake_id,json=blakeId,proto3" json:"blake_id,omitempty"`
+	Vars          string                 `protobuf:"bytes,4,opt,name=vars,proto3" json:"vars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVmVarsBlakeRequest) Reset() {
+	*x = SetVmVarsBlakeRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVmVarsBlakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVmVarsBlakeRequest) ProtoMessage() {}
+
+func (x *SetVmVarsBlakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVmVarsBlakeRequest.ProtoReflect.Descriptor instead.
+func (*SetVmVarsBlakeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetVmVarsBlakeRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *SetVmVarsBlakeRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *SetVmVarsBlakeRequest) GetBlakeId() string {
+	if x != nil {
+		return x.BlakeId
+	}
+	return ""
+}
+
+func (x *SetVmVarsBlakeRequest) GetVars() string {
+	if x != nil {
+		return x.Vars
+	}
+	return ""
+}
+
+type SetVmVarsBlakeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVmVarsBlakeResponse) Reset() {
+	*x = SetVmVarsBlakeResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVmVarsBlakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVmVarsBlakeResponse) ProtoMessage() {}
+
+func (x *SetVmVarsBlakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVmVarsBlakeResponse.ProtoReflect.Descriptor instead.
+func (*SetVmVarsBlakeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetVmVarsBlakeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetVmVarsBlakeResponse) GetErrMessage() string {
+	if x != nil {
+		return x.ErrMessage
+	}
+	return ""
+}
+
+type DeleteVmVarsBlakeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	CloudDomain   string                 `protobuf:"bytes,2,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
+	BlakeId       string                 `protobuf:"bytes,3,opt,name=blake_id,json=blakeId,proto3" json:"blake_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVmVarsBlakeRequest) Reset() {
+	*x = DeleteVmVarsBlakeRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVmVarsBlakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVmVarsBlakeRequest) ProtoMessage() {}
+
+func (x *DeleteVmVarsBlakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVmVarsBlakeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVmVarsBlakeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteVmVarsBlakeRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *DeleteVmVarsBlakeRequest) GetCloudDomain() string {
+	if x != nil {
+		return x.CloudDomain
+	}
+	return ""
+}
+
+func (x *DeleteVmVarsBlakeRequest) GetBlakeId() string {
+	if x != nil {
+		return x.BlakeId
+	}
+	return ""
+}
+
+type DeleteVmVarsBlakeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVmVarsBlakeResponse) Reset() {
+	*x = DeleteVmVarsBlakeResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVmVarsBlakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVmVarsBlakeResponse) ProtoMessage() {}
+
+func (x *DeleteVmVarsBlakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVmVarsBlakeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVmVarsBlakeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteVmVarsBlakeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteVmVarsBlakeResponse) GetErrMessage() string {
+	if x != nil {
+		return x.ErrMessage
+	}
+	return ""
+}
+
+type GetCloudDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudDomainRequest) Reset() {
+	*x = GetCloudDomainRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudDomainRequest) ProtoMessage() {}
+
+func (x *GetCloudDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetCloudDomainRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCloudDomainRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+type GetCloudDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloudDomainResponse) Reset() {
+	*x = GetCloudDomainResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloudDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloudDomainResponse) ProtoMessage() {}
+
+func (x *GetCloudDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloudDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetCloudDomainResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCloudDomainResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type GetNodeProxyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeProxyConfigRequest) Reset() {
+	*x = GetNodeProxyConfigRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeProxyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeProxyConfigRequest) ProtoMessage() {}
+
+func (x *GetNodeProxyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeProxyConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeProxyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetNodeProxyConfigRequest) GetTargetPve() string {
+	if x != nil {
+		return x.TargetPve
+	}
+	return ""
+}
+
+func (x *GetNodeProxyConfigRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+type GetNodeProxyConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        map[string]string      `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeProxyConfigResponse) Reset() {
+	*x = GetNodeProxyConfigResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeProxyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeProxyConfigResponse) ProtoMessage() {}
+
+func (x *GetNodeProxyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeProxyConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeProxyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetNodeProxyConfigResponse) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetNodeProxyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Config        map[string]string      `protobuf:"bytes,3,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeProxyConfigRequest) Reset() {
+	*x = SetNodeProxyConfigRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCloudSecretRequest) String() string {
+func (x *SetNodeProxyConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCloudSecretRequest) ProtoMessage() {}
+func (*SetNodeProxyConfigRequest) ProtoMessage() {}
 
-func (x *DeleteCloudSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[22]
+func (x *SetNodeProxyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,33 +3002,33 @@ func (x *DeleteCloudSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCloudSecretRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCloudSecretRequest) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SetNodeProxyConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeProxyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteCloudSecretRequest) GetCloudDomain() string {
+func (x *SetNodeProxyConfigRequest) GetTargetPve() string {
 	if x != nil {
-		return x.CloudDomain
+		return x.TargetPve
 	}
 	return ""
 }
 
-func (x *DeleteCloudSecretRequest) GetTargetPve() string {
+func (x *SetNodeProxyConfigRequest) GetNode() string {
 	if x != nil {
-		return x.TargetPve
+		return x.Node
 	}
 	return ""
 }
 
-func (x *DeleteCloudSecretRequest) GetSecretName() string {
+func (x *SetNodeProxyConfigRequest) GetConfig() map[string]string {
 	if x != nil {
-		return x.SecretName
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-type DeleteCloudSecretResponse struct {
+type SetNodeProxyConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	ErrMessage    string                 `protobuf:"bytes,2,opt,name=err_message,json=errMessage,proto3" json:"err_message,omitempty"`
@@ -1247,21 +3036,21 @@ type DeleteCloudSecretResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCloudSecretResponse) Reset() {
-	*x = DeleteCloudSecretResponse{}
-	mi := &file_protos_cloud_proto_msgTypes[23]
+func (x *SetNodeProxyConfigResponse) Reset() {
+	*x = SetNodeProxyConfigResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCloudSecretResponse) String() string {
+func (x *SetNodeProxyConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCloudSecretResponse) ProtoMessage() {}
+func (*SetNodeProxyConfigResponse) ProtoMessage() {}
 
-func (x *DeleteCloudSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[23]
+func (x *SetNodeProxyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,49 +3061,49 @@ func (x *DeleteCloudSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCloudSecretResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCloudSecretResponse) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SetNodeProxyConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetNodeProxyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DeleteCloudSecretResponse) GetSuccess() bool {
+func (x *SetNodeProxyConfigResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteCloudSecretResponse) GetErrMessage() string {
+func (x *SetNodeProxyConfigResponse) GetErrMessage() string {
 	if x != nil {
 		return x.ErrMessage
 	}
 	return ""
 }
 
-type GetCloudSecretRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
-	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
-	SecretName    string                 `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+type CephCommandRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TargetPve     string                  `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	Args          []string                `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Tool          CephCommandRequest_Tool `protobuf:"varint,3,opt,name=tool,proto3,enum=protos.CephCommandRequest_Tool" json:"tool,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCloudSecretRequest) Reset() {
-	*x = GetCloudSecretRequest{}
-	mi := &file_protos_cloud_proto_msgTypes[24]
+func (x *CephCommandRequest) Reset() {
+	*x = CephCommandRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCloudSecretRequest) String() string {
+func (x *CephCommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCloudSecretRequest) ProtoMessage() {}
+func (*CephCommandRequest) ProtoMessage() {}
 
-func (x *GetCloudSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[24]
+func (x *CephCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,54 +3114,54 @@ func (x *GetCloudSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCloudSecretRequest.ProtoReflect.Descriptor instead.
-func (*GetCloudSecretRequest) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CephCommandRequest.ProtoReflect.Descriptor instead.
+func (*CephCommandRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetCloudSecretRequest) GetCloudDomain() string {
+func (x *CephCommandRequest) GetTargetPve() string {
 	if x != nil {
-		return x.CloudDomain
+		return x.TargetPve
 	}
 	return ""
 }
 
-func (x *GetCloudSecretRequest) GetTargetPve() string {
+func (x *CephCommandRequest) GetArgs() []string {
 	if x != nil {
-		return x.TargetPve
+		return x.Args
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCloudSecretRequest) GetSecretName() string {
+func (x *CephCommandRequest) GetTool() CephCommandRequest_Tool {
 	if x != nil {
-		return x.SecretName
+		return x.Tool
 	}
-	return ""
+	return CephCommandRequest_CEPH
 }
 
-type GetCloudSecretResponse struct {
+type CephCommandResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	JsonResp      string                 `protobuf:"bytes,1,opt,name=json_resp,json=jsonResp,proto3" json:"json_resp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCloudSecretResponse) Reset() {
-	*x = GetCloudSecretResponse{}
-	mi := &file_protos_cloud_proto_msgTypes[25]
+func (x *CephCommandResponse) Reset() {
+	*x = CephCommandResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCloudSecretResponse) String() string {
+func (x *CephCommandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCloudSecretResponse) ProtoMessage() {}
+func (*CephCommandResponse) ProtoMessage() {}
 
-func (x *GetCloudSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[25]
+func (x *CephCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1383,42 +3172,44 @@ func (x *GetCloudSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCloudSecretResponse.ProtoReflect.Descriptor instead.
-func (*GetCloudSecretResponse) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CephCommandResponse.ProtoReflect.Descriptor instead.
+func (*CephCommandResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetCloudSecretResponse) GetSecret() string {
+func (x *CephCommandResponse) GetJsonResp() string {
 	if x != nil {
-		return x.Secret
+		return x.JsonResp
 	}
 	return ""
 }
 
-type GetCloudSecretsRequest struct {
+type WriteStorageFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CloudDomain   string                 `protobuf:"bytes,1,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
-	TargetPve     string                 `protobuf:"bytes,2,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
-	SecretType    string                 `protobuf:"bytes,3,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	VolumeId      string                 `protobuf:"bytes,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Executable    bool                   `protobuf:"varint,5,opt,name=executable,proto3" json:"executable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCloudSecretsRequest) Reset() {
-	*x = GetCloudSecretsRequest{}
-	mi := &file_protos_cloud_proto_msgTypes[26]
+func (x *WriteStorageFileRequest) Reset() {
+	*x = WriteStorageFileRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCloudSecretsRequest) String() string {
+func (x *WriteStorageFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCloudSecretsRequest) ProtoMessage() {}
+func (*WriteStorageFileRequest) ProtoMessage() {}
 
-func (x *GetCloudSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[26]
+func (x *WriteStorageFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,54 +3220,67 @@ func (x *GetCloudSecretsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCloudSecretsRequest.ProtoReflect.Descriptor instead.
-func (*GetCloudSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use WriteStorageFileRequest.ProtoReflect.Descriptor instead.
+func (*WriteStorageFileRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetCloudSecretsRequest) GetCloudDomain() string {
+func (x *WriteStorageFileRequest) GetTargetPve() string {
 	if x != nil {
-		return x.CloudDomain
+		return x.TargetPve
 	}
 	return ""
 }
 
-func (x *GetCloudSecretsRequest) GetTargetPve() string {
+func (x *WriteStorageFileRequest) GetNode() string {
 	if x != nil {
-		return x.TargetPve
+		return x.Node
 	}
 	return ""
 }
 
-func (x *GetCloudSecretsRequest) GetSecretType() string {
+func (x *WriteStorageFileRequest) GetVolumeId() string {
 	if x != nil {
-		return x.SecretType
+		return x.VolumeId
 	}
 	return ""
 }
 
-type GetCloudSecretsResponse struct {
+func (x *WriteStorageFileRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *WriteStorageFileRequest) GetExecutable() bool {
+	if x != nil {
+		return x.Executable
+	}
+	return false
+}
+
+type WriteStorageFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secrets       string                 `protobuf:"bytes,1,opt,name=secrets,proto3" json:"secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCloudSecretsResponse) Reset() {
-	*x = GetCloudSecretsResponse{}
-	mi := &file_protos_cloud_proto_msgTypes[27]
+func (x *WriteStorageFileResponse) Reset() {
+	*x = WriteStorageFileResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCloudSecretsResponse) String() string {
+func (x *WriteStorageFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCloudSecretsResponse) ProtoMessage() {}
+func (*WriteStorageFileResponse) ProtoMessage() {}
 
-func (x *GetCloudSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[27]
+func (x *WriteStorageFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,42 +3291,35 @@ func (x *GetCloudSecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCloudSecretsResponse.ProtoReflect.Descriptor instead.
-func (*GetCloudSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetCloudSecretsResponse) GetSecrets() string {
-	if x != nil {
-		return x.Secrets
-	}
-	return ""
+// Deprecated: Use WriteStorageFileResponse.ProtoReflect.Descriptor instead.
+func (*WriteStorageFileResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{55}
 }
 
-type GetVmVarsBlakeRequest struct {
+type HashStorageFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
-	CloudDomain   string                 `protobuf:"bytes,2,opt,name=cloud_domain,json=cloudDomain,proto3" json:"cloud_domain,omitempty"`
-	BlakeIds      []string               `protobuf:"bytes,3,rep,name=blake_ids,json=blakeIds,proto3" json:"blake_ids,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	VolumeId      string                 `protobuf:"bytes,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVmVarsBlakeRequest) Reset() {
-	*x = GetVmVarsBlakeRequest{}
-	mi := &file_protos_cloud_proto_msgTypes[28]
+func (x *HashStorageFileRequest) Reset() {
+	*x = HashStorageFileRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVmVarsBlakeRequest) String() string {
+func (x *HashStorageFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVmVarsBlakeRequest) ProtoMessage() {}
+func (*HashStorageFileRequest) ProtoMessage() {}
 
-func (x *GetVmVarsBlakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[28]
+func (x *HashStorageFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,54 +3330,54 @@ func (x *GetVmVarsBlakeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVmVarsBlakeRequest.ProtoReflect.Descriptor instead.
-func (*GetVmVarsBlakeRequest) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use HashStorageFileRequest.ProtoReflect.Descriptor instead.
+func (*HashStorageFileRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetVmVarsBlakeRequest) GetTargetPve() string {
+func (x *HashStorageFileRequest) GetTargetPve() string {
 	if x != nil {
 		return x.TargetPve
 	}
 	return ""
 }
 
-func (x *GetVmVarsBlakeRequest) GetCloudDomain() string {
+func (x *HashStorageFileRequest) GetNode() string {
 	if x != nil {
-		return x.CloudDomain
+		return x.Node
 	}
 	return ""
 }
 
-func (x *GetVmVarsBlakeRequest) GetBlakeIds() []string {
+func (x *HashStorageFileRequest) GetVolumeId() string {
 	if x != nil {
-		return x.BlakeIds
+		return x.VolumeId
 	}
-	return nil
+	return ""
 }
 
-type GetVmVarsBlakeResponse struct {
+type HashStorageFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlakeIdVars   map[string]string      `protobuf:"bytes,1,rep,name=blake_id_vars,json=blakeIdVars,proto3" json:"blake_id_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sha256        string                 `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVmVarsBlakeResponse) Reset() {
-	*x = GetVmVarsBlakeResponse{}
-	mi := &file_protos_cloud_proto_msgTypes[29]
+func (x *HashStorageFileResponse) Reset() {
+	*x = HashStorageFileResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVmVarsBlakeResponse) String() string {
+func (x *HashStorageFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVmVarsBlakeResponse) ProtoMessage() {}
+func (*HashStorageFileResponse) ProtoMessage() {}
 
-func (x *GetVmVarsBlakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[29]
+func (x *HashStorageFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1591,40 +3388,42 @@ func (x *GetVmVarsBlakeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVmVarsBlakeResponse.ProtoReflect.Descriptor instead.
-func (*GetVmVarsBlakeResponse) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use HashStorageFileResponse.ProtoReflect.Descriptor instead.
+func (*HashStorageFileResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetVmVarsBlakeResponse) GetBlakeIdVars() map[string]string {
+func (x *HashStorageFileResponse) GetSha256() string {
 	if x != nil {
-		return x.BlakeIdVars
+		return x.Sha256
 	}
-	return nil
+	return ""
 }
 
-type GetCloudDomainRequest struct {
+type DeleteStorageFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TargetPve     string                 `protobuf:"bytes,1,opt,name=target_pve,json=targetPve,proto3" json:"target_pve,omitempty"`
+	Node          string                 `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	VolumeId      string                 `protobuf:"bytes,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCloudDomainRequest) Reset() {
-	*x = GetCloudDomainRequest{}
-	mi := &file_protos_cloud_proto_msgTypes[30]
+func (x *DeleteStorageFileRequest) Reset() {
+	*x = DeleteStorageFileRequest{}
+	mi := &file_protos_cloud_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCloudDomainRequest) String() string {
+func (x *DeleteStorageFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCloudDomainRequest) ProtoMessage() {}
+func (*DeleteStorageFileRequest) ProtoMessage() {}
 
-func (x *GetCloudDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[30]
+func (x *DeleteStorageFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,40 +3434,53 @@ func (x *GetCloudDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCloudDomainRequest.ProtoReflect.Descriptor instead.
-func (*GetCloudDomainRequest) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use DeleteStorageFileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStorageFileRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetCloudDomainRequest) GetTargetPve() string {
+func (x *DeleteStorageFileRequest) GetTargetPve() string {
 	if x != nil {
 		return x.TargetPve
 	}
 	return ""
 }
 
-type GetCloudDomainResponse struct {
+func (x *DeleteStorageFileRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *DeleteStorageFileRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type DeleteStorageFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCloudDomainResponse) Reset() {
-	*x = GetCloudDomainResponse{}
-	mi := &file_protos_cloud_proto_msgTypes[31]
+func (x *DeleteStorageFileResponse) Reset() {
+	*x = DeleteStorageFileResponse{}
+	mi := &file_protos_cloud_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCloudDomainResponse) String() string {
+func (x *DeleteStorageFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCloudDomainResponse) ProtoMessage() {}
+func (*DeleteStorageFileResponse) ProtoMessage() {}
 
-func (x *GetCloudDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_cloud_proto_msgTypes[31]
+func (x *DeleteStorageFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cloud_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,16 +3491,9 @@ func (x *GetCloudDomainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCloudDomainResponse.ProtoReflect.Descriptor instead.
-func (*GetCloudDomainResponse) Descriptor() ([]byte, []int) {
-	return file_protos_cloud_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *GetCloudDomainResponse) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// Deprecated: Use DeleteStorageFileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStorageFileResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cloud_proto_rawDescGZIP(), []int{59}
 }
 
 var File_protos_cloud_proto protoreflect.FileDescriptor
@@ -1725,19 +3530,50 @@ const file_protos_cloud_proto_rawDesc = "" +
 	"createArgs\x1a=\n" +
 	"\x0fCreateArgsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
 	"\x18CreateProxmoxApiResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
 	"\verr_message\x18\x02 \x01(\tR\n" +
-	"errMessage\"S\n" +
+	"errMessage\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\tR\x06output\"\xe4\x01\n" +
 	"\x17DeleteProxmoxApiRequest\x12\x1d\n" +
 	"\n" +
 	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x19\n" +
-	"\bapi_path\x18\x02 \x01(\tR\aapiPath\"U\n" +
+	"\bapi_path\x18\x02 \x01(\tR\aapiPath\x12P\n" +
+	"\vdelete_args\x18\x03 \x03(\v2/.protos.DeleteProxmoxApiRequest.DeleteArgsEntryR\n" +
+	"deleteArgs\x1a=\n" +
+	"\x0fDeleteArgsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
 	"\x18DeleteProxmoxApiResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
 	"\verr_message\x18\x02 \x01(\tR\n" +
-	"errMessage\"\x9b\x01\n" +
+	"errMessage\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\tR\x06output\"\xd2\x01\n" +
+	"\x14SetProxmoxApiRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x19\n" +
+	"\bapi_path\x18\x02 \x01(\tR\aapiPath\x12D\n" +
+	"\bset_args\x18\x03 \x03(\v2).protos.SetProxmoxApiRequest.SetArgsEntryR\asetArgs\x1a:\n" +
+	"\fSetArgsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"j\n" +
+	"\x15SetProxmoxApiResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\verr_message\x18\x02 \x01(\tR\n" +
+	"errMessage\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\tR\x06output\"\x8d\x01\n" +
+	"\x12WaitForTaskRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x12\n" +
+	"\x04upid\x18\x02 \x01(\tR\x04upid\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x03R\x0etimeoutSeconds\x12\x1b\n" +
+	"\tlog_lines\x18\x04 \x01(\x03R\blogLines\"m\n" +
+	"\x13WaitForTaskResponse\x12\x1a\n" +
+	"\bfinished\x18\x01 \x01(\bR\bfinished\x12\x1f\n" +
+	"\vexit_status\x18\x02 \x01(\tR\n" +
+	"exitStatus\x12\x19\n" +
+	"\blog_tail\x18\x03 \x01(\tR\alogTail\"\x9b\x01\n" +
 	"\x10GetSshKeyRequest\x12\x1d\n" +
 	"\n" +
 	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12;\n" +
@@ -1771,9 +3607,10 @@ const file_protos_cloud_proto_rawDesc = "" +
 	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x1f\n" +
 	"\vsecret_name\x18\x02 \x01(\tR\n" +
 	"secretName\x12\x16\n" +
-	"\x06rstrip\x18\x03 \x01(\bR\x06rstrip\"4\n" +
+	"\x06rstrip\x18\x03 \x01(\bR\x06rstrip\"F\n" +
 	"\x1aGetCloudFileSecretResponse\x12\x16\n" +
-	"\x06secret\x18\x01 \x01(\tR\x06secret\"\xbf\x01\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03raw\x18\x02 \x01(\fR\x03raw\"\xfc\x01\n" +
 	"\x18CreateCloudSecretRequest\x12!\n" +
 	"\fcloud_domain\x18\x01 \x01(\tR\vcloudDomain\x12\x1d\n" +
 	"\n" +
@@ -1783,37 +3620,97 @@ const file_protos_cloud_proto_rawDesc = "" +
 	"\vsecret_data\x18\x04 \x01(\tR\n" +
 	"secretData\x12\x1f\n" +
 	"\vsecret_type\x18\x05 \x01(\tR\n" +
-	"secretType\"V\n" +
+	"secretType\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\"V\n" +
 	"\x19CreateCloudSecretResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
 	"\verr_message\x18\x02 \x01(\tR\n" +
-	"errMessage\"}\n" +
+	"errMessage\"\x9b\x01\n" +
 	"\x18DeleteCloudSecretRequest\x12!\n" +
 	"\fcloud_domain\x18\x01 \x01(\tR\vcloudDomain\x12\x1d\n" +
 	"\n" +
 	"target_pve\x18\x02 \x01(\tR\ttargetPve\x12\x1f\n" +
 	"\vsecret_name\x18\x03 \x01(\tR\n" +
-	"secretName\"V\n" +
+	"secretName\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"V\n" +
 	"\x19DeleteCloudSecretResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
 	"\verr_message\x18\x02 \x01(\tR\n" +
-	"errMessage\"z\n" +
+	"errMessage\"\xfc\x01\n" +
+	"\x18UpdateCloudSecretRequest\x12!\n" +
+	"\fcloud_domain\x18\x01 \x01(\tR\vcloudDomain\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x02 \x01(\tR\ttargetPve\x12\x1f\n" +
+	"\vsecret_name\x18\x03 \x01(\tR\n" +
+	"secretName\x12\x1f\n" +
+	"\vsecret_data\x18\x04 \x01(\tR\n" +
+	"secretData\x12\x1f\n" +
+	"\vsecret_type\x18\x05 \x01(\tR\n" +
+	"secretType\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\"V\n" +
+	"\x19UpdateCloudSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\verr_message\x18\x02 \x01(\tR\n" +
+	"errMessage\"\x98\x01\n" +
 	"\x15GetCloudSecretRequest\x12!\n" +
 	"\fcloud_domain\x18\x01 \x01(\tR\vcloudDomain\x12\x1d\n" +
 	"\n" +
 	"target_pve\x18\x02 \x01(\tR\ttargetPve\x12\x1f\n" +
 	"\vsecret_name\x18\x03 \x01(\tR\n" +
-	"secretName\"0\n" +
+	"secretName\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"O\n" +
 	"\x16GetCloudSecretResponse\x12\x16\n" +
-	"\x06secret\x18\x01 \x01(\tR\x06secret\"{\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"\x99\x01\n" +
 	"\x16GetCloudSecretsRequest\x12!\n" +
 	"\fcloud_domain\x18\x01 \x01(\tR\vcloudDomain\x12\x1d\n" +
 	"\n" +
 	"target_pve\x18\x02 \x01(\tR\ttargetPve\x12\x1f\n" +
 	"\vsecret_type\x18\x03 \x01(\tR\n" +
-	"secretType\"3\n" +
+	"secretType\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"3\n" +
 	"\x17GetCloudSecretsResponse\x12\x18\n" +
-	"\asecrets\x18\x01 \x01(\tR\asecrets\"v\n" +
+	"\asecrets\x18\x01 \x01(\tR\asecrets\"\x9e\x01\n" +
+	"\x1bGetCloudSecretByNameRequest\x12!\n" +
+	"\fcloud_domain\x18\x01 \x01(\tR\vcloudDomain\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x02 \x01(\tR\ttargetPve\x12\x1f\n" +
+	"\vsecret_name\x18\x03 \x01(\tR\n" +
+	"secretName\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"\x95\x01\n" +
+	"\x1cGetCloudSecretByNameResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x1f\n" +
+	"\vsecret_data\x18\x02 \x01(\tR\n" +
+	"secretData\x12\x1f\n" +
+	"\vsecret_type\x18\x03 \x01(\tR\n" +
+	"secretType\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"\x9d\x01\n" +
+	"\x1aGetCloudSecretNamesRequest\x12!\n" +
+	"\fcloud_domain\x18\x01 \x01(\tR\vcloudDomain\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x02 \x01(\tR\ttargetPve\x12\x1f\n" +
+	"\vsecret_type\x18\x03 \x01(\tR\n" +
+	"secretType\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"\xb0\x01\n" +
+	"\x0fCloudSecretMeta\x12\x1f\n" +
+	"\vsecret_name\x18\x01 \x01(\tR\n" +
+	"secretName\x12\x1f\n" +
+	"\vsecret_type\x18\x02 \x01(\tR\n" +
+	"secretType\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"P\n" +
+	"\x1bGetCloudSecretNamesResponse\x121\n" +
+	"\asecrets\x18\x01 \x03(\v2\x17.protos.CloudSecretMetaR\asecrets\"v\n" +
 	"\x15GetVmVarsBlakeRequest\x12\x1d\n" +
 	"\n" +
 	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12!\n" +
@@ -1823,30 +3720,125 @@ const file_protos_cloud_proto_rawDesc = "" +
 	"\rblake_id_vars\x18\x01 \x03(\v2/.protos.GetVmVarsBlakeResponse.BlakeIdVarsEntryR\vblakeIdVars\x1a>\n" +
 	"\x10BlakeIdVarsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"6\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
+	"\x10VmVarsBlakeEntry\x12\x19\n" +
+	"\bblake_id\x18\x01 \x01(\tR\ablakeId\x12\x12\n" +
+	"\x04vars\x18\x02 \x01(\tR\x04vars\"\x88\x01\n" +
+	"\x15SetVmVarsBlakeRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12!\n" +
+	"\fcloud_domain\x18\x02 \x01(\tR\vcloudDomain\x12\x19\n" +
+	"\bblake_id\x18\x03 \x01(\tR\ablakeId\x12\x12\n" +
+	"\x04vars\x18\x04 \x01(\tR\x04vars\"S\n" +
+	"\x16SetVmVarsBlakeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\verr_message\x18\x02 \x01(\tR\n" +
+	"errMessage\"w\n" +
+	"\x18DeleteVmVarsBlakeRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12!\n" +
+	"\fcloud_domain\x18\x02 \x01(\tR\vcloudDomain\x12\x19\n" +
+	"\bblake_id\x18\x03 \x01(\tR\ablakeId\"V\n" +
+	"\x19DeleteVmVarsBlakeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\verr_message\x18\x02 \x01(\tR\n" +
+	"errMessage\"6\n" +
 	"\x15GetCloudDomainRequest\x12\x1d\n" +
 	"\n" +
 	"target_pve\x18\x01 \x01(\tR\ttargetPve\"0\n" +
 	"\x16GetCloudDomainResponse\x12\x16\n" +
-	"\x06domain\x18\x01 \x01(\tR\x06domain2\xbc\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"N\n" +
+	"\x19GetNodeProxyConfigRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\"\x9f\x01\n" +
+	"\x1aGetNodeProxyConfigResponse\x12F\n" +
+	"\x06config\x18\x01 \x03(\v2..protos.GetNodeProxyConfigResponse.ConfigEntryR\x06config\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd0\x01\n" +
+	"\x19SetNodeProxyConfigRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12E\n" +
+	"\x06config\x18\x03 \x03(\v2-.protos.SetNodeProxyConfigRequest.ConfigEntryR\x06config\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\x1aSetNodeProxyConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\verr_message\x18\x02 \x01(\tR\n" +
+	"errMessage\"\x97\x01\n" +
+	"\x12CephCommandRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x12\n" +
+	"\x04args\x18\x02 \x03(\tR\x04args\x123\n" +
+	"\x04tool\x18\x03 \x01(\x0e2\x1f.protos.CephCommandRequest.ToolR\x04tool\"\x19\n" +
+	"\x04Tool\x12\b\n" +
+	"\x04CEPH\x10\x00\x12\a\n" +
+	"\x03RBD\x10\x01\"2\n" +
+	"\x13CephCommandResponse\x12\x1b\n" +
+	"\tjson_resp\x18\x01 \x01(\tR\bjsonResp\"\xa3\x01\n" +
+	"\x17WriteStorageFileRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x1b\n" +
+	"\tvolume_id\x18\x03 \x01(\tR\bvolumeId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\x12\x1e\n" +
+	"\n" +
+	"executable\x18\x05 \x01(\bR\n" +
+	"executable\"\x1a\n" +
+	"\x18WriteStorageFileResponse\"h\n" +
+	"\x16HashStorageFileRequest\x12\x1d\n" +
+	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x1b\n" +
+	"\tvolume_id\x18\x03 \x01(\tR\bvolumeId\"1\n" +
+	"\x17HashStorageFileResponse\x12\x16\n" +
+	"\x06sha256\x18\x01 \x01(\tR\x06sha256\"j\n" +
+	"\x18DeleteStorageFileRequest\x12\x1d\n" +
 	"\n" +
+	"target_pve\x18\x01 \x01(\tR\ttargetPve\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x1b\n" +
+	"\tvolume_id\x18\x03 \x01(\tR\bvolumeId\"\x1b\n" +
+	"\x19DeleteStorageFileResponse*_\n" +
+	"\tErrorCode\x12\x1a\n" +
+	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tNOT_FOUND\x10\x01\x12\f\n" +
+	"\bCONFLICT\x10\x02\x12\x0f\n" +
+	"\vUNREACHABLE\x10\x03\x12\b\n" +
+	"\x04AUTH\x10\x042\xf1\x13\n" +
 	"\fCloudService\x12R\n" +
 	"\x13GetMasterKubeconfig\x12\x1c.protos.GetKubeconfigRequest\x1a\x1d.protos.GetKubeconfigResponse\x12O\n" +
 	"\x0eGetClusterVars\x12\x1d.protos.GetClusterVarsRequest\x1a\x1e.protos.GetClusterVarsResponse\x12[\n" +
 	"\x12GetCloudFileSecret\x12!.protos.GetCloudFileSecretRequest\x1a\".protos.GetCloudFileSecretResponse\x12X\n" +
 	"\x11CreateCloudSecret\x12 .protos.CreateCloudSecretRequest\x1a!.protos.CreateCloudSecretResponse\x12X\n" +
-	"\x11DeleteCloudSecret\x12 .protos.DeleteCloudSecretRequest\x1a!.protos.DeleteCloudSecretResponse\x12O\n" +
+	"\x11DeleteCloudSecret\x12 .protos.DeleteCloudSecretRequest\x1a!.protos.DeleteCloudSecretResponse\x12X\n" +
+	"\x11UpdateCloudSecret\x12 .protos.UpdateCloudSecretRequest\x1a!.protos.UpdateCloudSecretResponse\x12O\n" +
 	"\x0eGetCloudSecret\x12\x1d.protos.GetCloudSecretRequest\x1a\x1e.protos.GetCloudSecretResponse\x12R\n" +
-	"\x0fGetCloudSecrets\x12\x1e.protos.GetCloudSecretsRequest\x1a\x1f.protos.GetCloudSecretsResponse\x12L\n" +
+	"\x0fGetCloudSecrets\x12\x1e.protos.GetCloudSecretsRequest\x1a\x1f.protos.GetCloudSecretsResponse\x12a\n" +
+	"\x14GetCloudSecretByName\x12#.protos.GetCloudSecretByNameRequest\x1a$.protos.GetCloudSecretByNameResponse\x12^\n" +
+	"\x13GetCloudSecretNames\x12\".protos.GetCloudSecretNamesRequest\x1a#.protos.GetCloudSecretNamesResponse\x12L\n" +
 	"\rGetCephAccess\x12\x1c.protos.GetCephAccessRequest\x1a\x1d.protos.GetCephAccessResponse\x12@\n" +
 	"\tGetSshKey\x12\x18.protos.GetSshKeyRequest\x1a\x19.protos.GetSshKeyResponse\x12L\n" +
 	"\rGetProxmoxApi\x12\x1c.protos.GetProxmoxApiRequest\x1a\x1d.protos.GetProxmoxApiResponse\x12U\n" +
 	"\x10CreateProxmoxApi\x12\x1f.protos.CreateProxmoxApiRequest\x1a .protos.CreateProxmoxApiResponse\x12U\n" +
-	"\x10DeleteProxmoxApi\x12\x1f.protos.DeleteProxmoxApiRequest\x1a .protos.DeleteProxmoxApiResponse\x12O\n" +
+	"\x10DeleteProxmoxApi\x12\x1f.protos.DeleteProxmoxApiRequest\x1a .protos.DeleteProxmoxApiResponse\x12L\n" +
+	"\rSetProxmoxApi\x12\x1c.protos.SetProxmoxApiRequest\x1a\x1d.protos.SetProxmoxApiResponse\x12F\n" +
+	"\vWaitForTask\x12\x1a.protos.WaitForTaskRequest\x1a\x1b.protos.WaitForTaskResponse\x12O\n" +
 	"\x0eGetProxmoxHost\x12\x1d.protos.GetProxmoxHostRequest\x1a\x1e.protos.GetProxmoxHostResponse\x12R\n" +
 	"\x0fGetPveInventory\x12\x1e.protos.GetPveInventoryRequest\x1a\x1f.protos.GetPveInventoryResponse\x12O\n" +
 	"\x0eGetCloudDomain\x12\x1d.protos.GetCloudDomainRequest\x1a\x1e.protos.GetCloudDomainResponse\x12O\n" +
-	"\x0eGetVmVarsBlake\x12\x1d.protos.GetVmVarsBlakeRequest\x1a\x1e.protos.GetVmVarsBlakeResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3"
+	"\x0eGetVmVarsBlake\x12\x1d.protos.GetVmVarsBlakeRequest\x1a\x1e.protos.GetVmVarsBlakeResponse\x12N\n" +
+	"\x11StreamVmVarsBlake\x12\x1d.protos.GetVmVarsBlakeRequest\x1a\x18.protos.VmVarsBlakeEntry0\x01\x12O\n" +
+	"\x0eSetVmVarsBlake\x12\x1d.protos.SetVmVarsBlakeRequest\x1a\x1e.protos.SetVmVarsBlakeResponse\x12X\n" +
+	"\x11DeleteVmVarsBlake\x12 .protos.DeleteVmVarsBlakeRequest\x1a!.protos.DeleteVmVarsBlakeResponse\x12[\n" +
+	"\x12GetNodeProxyConfig\x12!.protos.GetNodeProxyConfigRequest\x1a\".protos.GetNodeProxyConfigResponse\x12[\n" +
+	"\x12SetNodeProxyConfig\x12!.protos.SetNodeProxyConfigRequest\x1a\".protos.SetNodeProxyConfigResponse\x12F\n" +
+	"\vCephCommand\x12\x1a.protos.CephCommandRequest\x1a\x1b.protos.CephCommandResponse\x12U\n" +
+	"\x10WriteStorageFile\x12\x1f.protos.WriteStorageFileRequest\x1a .protos.WriteStorageFileResponse\x12R\n" +
+	"\x0fHashStorageFile\x12\x1e.protos.HashStorageFileRequest\x1a\x1f.protos.HashStorageFileResponse\x12X\n" +
+	"\x11DeleteStorageFile\x12 .protos.DeleteStorageFileRequest\x1a!.protos.DeleteStorageFileResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3"
 
 var (
 	file_protos_cloud_proto_rawDescOnce sync.Once
@@ -1860,88 +3852,156 @@ func file_protos_cloud_proto_rawDescGZIP() []byte {
 	return file_protos_cloud_proto_rawDescData
 }
 
-var file_protos_cloud_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protos_cloud_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_protos_cloud_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_protos_cloud_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_protos_cloud_proto_goTypes = []any{
-	(GetSshKeyRequest_KeyType)(0),      // 0: protos.GetSshKeyRequest.KeyType
-	(*GetPveInventoryRequest)(nil),     // 1: protos.GetPveInventoryRequest
-	(*GetPveInventoryResponse)(nil),    // 2: protos.GetPveInventoryResponse
-	(*GetProxmoxHostRequest)(nil),      // 3: protos.GetProxmoxHostRequest
-	(*GetProxmoxHostResponse)(nil),     // 4: protos.GetProxmoxHostResponse
-	(*GetProxmoxApiRequest)(nil),       // 5: protos.GetProxmoxApiRequest
-	(*GetProxmoxApiResponse)(nil),      // 6: protos.GetProxmoxApiResponse
-	(*CreateProxmoxApiRequest)(nil),    // 7: protos.CreateProxmoxApiRequest
-	(*CreateProxmoxApiResponse)(nil),   // 8: protos.CreateProxmoxApiResponse
-	(*DeleteProxmoxApiRequest)(nil),    // 9: protos.DeleteProxmoxApiRequest
-	(*DeleteProxmoxApiResponse)(nil),   // 10: protos.DeleteProxmoxApiResponse
-	(*GetSshKeyRequest)(nil),           // 11: protos.GetSshKeyRequest
-	(*GetSshKeyResponse)(nil),          // 12: protos.GetSshKeyResponse
-	(*GetCephAccessRequest)(nil),       // 13: protos.GetCephAccessRequest
-	(*GetCephAccessResponse)(nil),      // 14: protos.GetCephAccessResponse
-	(*GetKubeconfigRequest)(nil),       // 15: protos.GetKubeconfigRequest
-	(*GetKubeconfigResponse)(nil),      // 16: protos.GetKubeconfigResponse
-	(*GetClusterVarsRequest)(nil),      // 17: protos.GetClusterVarsRequest
-	(*GetClusterVarsResponse)(nil),     // 18: protos.GetClusterVarsResponse
-	(*GetCloudFileSecretRequest)(nil),  // 19: protos.GetCloudFileSecretRequest
-	(*GetCloudFileSecretResponse)(nil), // 20: protos.GetCloudFileSecretResponse
-	(*CreateCloudSecretRequest)(nil),   // 21: protos.CreateCloudSecretRequest
-	(*CreateCloudSecretResponse)(nil),  // 22: protos.CreateCloudSecretResponse
-	(*DeleteCloudSecretRequest)(nil),   // 23: protos.DeleteCloudSecretRequest
-	(*DeleteCloudSecretResponse)(nil),  // 24: protos.DeleteCloudSecretResponse
-	(*GetCloudSecretRequest)(nil),      // 25: protos.GetCloudSecretRequest
-	(*GetCloudSecretResponse)(nil),     // 26: protos.GetCloudSecretResponse
-	(*GetCloudSecretsRequest)(nil),     // 27: protos.GetCloudSecretsRequest
-	(*GetCloudSecretsResponse)(nil),    // 28: protos.GetCloudSecretsResponse
-	(*GetVmVarsBlakeRequest)(nil),      // 29: protos.GetVmVarsBlakeRequest
-	(*GetVmVarsBlakeResponse)(nil),     // 30: protos.GetVmVarsBlakeResponse
-	(*GetCloudDomainRequest)(nil),      // 31: protos.GetCloudDomainRequest
-	(*GetCloudDomainResponse)(nil),     // 32: protos.GetCloudDomainResponse
-	nil,                                // 33: protos.GetProxmoxApiRequest.GetArgsEntry
-	nil,                                // 34: protos.CreateProxmoxApiRequest.CreateArgsEntry
-	nil,                                // 35: protos.GetVmVarsBlakeResponse.BlakeIdVarsEntry
+	(ErrorCode)(0),                       // 0: protos.ErrorCode
+	(GetSshKeyRequest_KeyType)(0),        // 1: protos.GetSshKeyRequest.KeyType
+	(CephCommandRequest_Tool)(0),         // 2: protos.CephCommandRequest.Tool
+	(*GetPveInventoryRequest)(nil),       // 3: protos.GetPveInventoryRequest
+	(*GetPveInventoryResponse)(nil),      // 4: protos.GetPveInventoryResponse
+	(*GetProxmoxHostRequest)(nil),        // 5: protos.GetProxmoxHostRequest
+	(*GetProxmoxHostResponse)(nil),       // 6: protos.GetProxmoxHostResponse
+	(*GetProxmoxApiRequest)(nil),         // 7: protos.GetProxmoxApiRequest
+	(*GetProxmoxApiResponse)(nil),        // 8: protos.GetProxmoxApiResponse
+	(*CreateProxmoxApiRequest)(nil),      // 9: protos.CreateProxmoxApiRequest
+	(*CreateProxmoxApiResponse)(nil),     // 10: protos.CreateProxmoxApiResponse
+	(*DeleteProxmoxApiRequest)(nil),      // 11: protos.DeleteProxmoxApiRequest
+	(*DeleteProxmoxApiResponse)(nil),     // 12: protos.DeleteProxmoxApiResponse
+	(*SetProxmoxApiRequest)(nil),         // 13: protos.SetProxmoxApiRequest
+	(*SetProxmoxApiResponse)(nil),        // 14: protos.SetProxmoxApiResponse
+	(*WaitForTaskRequest)(nil),           // 15: protos.WaitForTaskRequest
+	(*WaitForTaskResponse)(nil),          // 16: protos.WaitForTaskResponse
+	(*GetSshKeyRequest)(nil),             // 17: protos.GetSshKeyRequest
+	(*GetSshKeyResponse)(nil),            // 18: protos.GetSshKeyResponse
+	(*GetCephAccessRequest)(nil),         // 19: protos.GetCephAccessRequest
+	(*GetCephAccessResponse)(nil),        // 20: protos.GetCephAccessResponse
+	(*GetKubeconfigRequest)(nil),         // 21: protos.GetKubeconfigRequest
+	(*GetKubeconfigResponse)(nil),        // 22: protos.GetKubeconfigResponse
+	(*GetClusterVarsRequest)(nil),        // 23: protos.GetClusterVarsRequest
+	(*GetClusterVarsResponse)(nil),       // 24: protos.GetClusterVarsResponse
+	(*GetCloudFileSecretRequest)(nil),    // 25: protos.GetCloudFileSecretRequest
+	(*GetCloudFileSecretResponse)(nil),   // 26: protos.GetCloudFileSecretResponse
+	(*CreateCloudSecretRequest)(nil),     // 27: protos.CreateCloudSecretRequest
+	(*CreateCloudSecretResponse)(nil),    // 28: protos.CreateCloudSecretResponse
+	(*DeleteCloudSecretRequest)(nil),     // 29: protos.DeleteCloudSecretRequest
+	(*DeleteCloudSecretResponse)(nil),    // 30: protos.DeleteCloudSecretResponse
+	(*UpdateCloudSecretRequest)(nil),     // 31: protos.UpdateCloudSecretRequest
+	(*UpdateCloudSecretResponse)(nil),    // 32: protos.UpdateCloudSecretResponse
+	(*GetCloudSecretRequest)(nil),        // 33: protos.GetCloudSecretRequest
+	(*GetCloudSecretResponse)(nil),       // 34: protos.GetCloudSecretResponse
+	(*GetCloudSecretsRequest)(nil),       // 35: protos.GetCloudSecretsRequest
+	(*GetCloudSecretsResponse)(nil),      // 36: protos.GetCloudSecretsResponse
+	(*GetCloudSecretByNameRequest)(nil),  // 37: protos.GetCloudSecretByNameRequest
+	(*GetCloudSecretByNameResponse)(nil), // 38: protos.GetCloudSecretByNameResponse
+	(*GetCloudSecretNamesRequest)(nil),   // 39: protos.GetCloudSecretNamesRequest
+	(*CloudSecretMeta)(nil),              // 40: protos.CloudSecretMeta
+	(*GetCloudSecretNamesResponse)(nil),  // 41: protos.GetCloudSecretNamesResponse
+	(*GetVmVarsBlakeRequest)(nil),        // 42: protos.GetVmVarsBlakeRequest
+	(*GetVmVarsBlakeResponse)(nil),       // 43: protos.GetVmVarsBlakeResponse
+	(*VmVarsBlakeEntry)(nil),             // 44: protos.VmVarsBlakeEntry
+	(*SetVmVarsBlakeRequest)(nil),        // 45: protos.SetVmVarsBlakeRequest
+	(*SetVmVarsBlakeResponse)(nil),       // 46: protos.SetVmVarsBlakeResponse
+	(*DeleteVmVarsBlakeRequest)(nil),     // 47: protos.DeleteVmVarsBlakeRequest
+	(*DeleteVmVarsBlakeResponse)(nil),    // 48: protos.DeleteVmVarsBlakeResponse
+	(*GetCloudDomainRequest)(nil),        // 49: protos.GetCloudDomainRequest
+	(*GetCloudDomainResponse)(nil),       // 50: protos.GetCloudDomainResponse
+	(*GetNodeProxyConfigRequest)(nil),    // 51: protos.GetNodeProxyConfigRequest
+	(*GetNodeProxyConfigResponse)(nil),   // 52: protos.GetNodeProxyConfigResponse
+	(*SetNodeProxyConfigRequest)(nil),    // 53: protos.SetNodeProxyConfigRequest
+	(*SetNodeProxyConfigResponse)(nil),   // 54: protos.SetNodeProxyConfigResponse
+	(*CephCommandRequest)(nil),           // 55: protos.CephCommandRequest
+	(*CephCommandResponse)(nil),          // 56: protos.CephCommandResponse
+	(*WriteStorageFileRequest)(nil),      // 57: protos.WriteStorageFileRequest
+	(*WriteStorageFileResponse)(nil),     // 58: protos.WriteStorageFileResponse
+	(*HashStorageFileRequest)(nil),       // 59: protos.HashStorageFileRequest
+	(*HashStorageFileResponse)(nil),      // 60: protos.HashStorageFileResponse
+	(*DeleteStorageFileRequest)(nil),     // 61: protos.DeleteStorageFileRequest
+	(*DeleteStorageFileResponse)(nil),    // 62: protos.DeleteStorageFileResponse
+	nil,                                  // 63: protos.GetProxmoxApiRequest.GetArgsEntry
+	nil,                                  // 64: protos.CreateProxmoxApiRequest.CreateArgsEntry
+	nil,                                  // 65: protos.DeleteProxmoxApiRequest.DeleteArgsEntry
+	nil,                                  // 66: protos.SetProxmoxApiRequest.SetArgsEntry
+	nil,                                  // 67: protos.GetVmVarsBlakeResponse.BlakeIdVarsEntry
+	nil,                                  // 68: protos.GetNodeProxyConfigResponse.ConfigEntry
+	nil,                                  // 69: protos.SetNodeProxyConfigRequest.ConfigEntry
 }
 var file_protos_cloud_proto_depIdxs = []int32{
-	33, // 0: protos.GetProxmoxApiRequest.get_args:type_name -> protos.GetProxmoxApiRequest.GetArgsEntry
-	34, // 1: protos.CreateProxmoxApiRequest.create_args:type_name -> protos.CreateProxmoxApiRequest.CreateArgsEntry
-	0,  // 2: protos.GetSshKeyRequest.key_type:type_name -> protos.GetSshKeyRequest.KeyType
-	35, // 3: protos.GetVmVarsBlakeResponse.blake_id_vars:type_name -> protos.GetVmVarsBlakeResponse.BlakeIdVarsEntry
-	15, // 4: protos.CloudService.GetMasterKubeconfig:input_type -> protos.GetKubeconfigRequest
-	17, // 5: protos.CloudService.GetClusterVars:input_type -> protos.GetClusterVarsRequest
-	19, // 6: protos.CloudService.GetCloudFileSecret:input_type -> protos.GetCloudFileSecretRequest
-	21, // 7: protos.CloudService.CreateCloudSecret:input_type -> protos.CreateCloudSecretRequest
-	23, // 8: protos.CloudService.DeleteCloudSecret:input_type -> protos.DeleteCloudSecretRequest
-	25, // 9: protos.CloudService.GetCloudSecret:input_type -> protos.GetCloudSecretRequest
-	27, // 10: protos.CloudService.GetCloudSecrets:input_type -> protos.GetCloudSecretsRequest
-	13, // 11: protos.CloudService.GetCephAccess:input_type -> protos.GetCephAccessRequest
-	11, // 12: protos.CloudService.GetSshKey:input_type -> protos.GetSshKeyRequest
-	5,  // 13: protos.CloudService.GetProxmoxApi:input_type -> protos.GetProxmoxApiRequest
-	7,  // 14: protos.CloudService.CreateProxmoxApi:input_type -> protos.CreateProxmoxApiRequest
-	9,  // 15: protos.CloudService.DeleteProxmoxApi:input_type -> protos.DeleteProxmoxApiRequest
-	3,  // 16: protos.CloudService.GetProxmoxHost:input_type -> protos.GetProxmoxHostRequest
-	1,  // 17: protos.CloudService.GetPveInventory:input_type -> protos.GetPveInventoryRequest
-	31, // 18: protos.CloudService.GetCloudDomain:input_type -> protos.GetCloudDomainRequest
-	29, // 19: protos.CloudService.GetVmVarsBlake:input_type -> protos.GetVmVarsBlakeRequest
-	16, // 20: protos.CloudService.GetMasterKubeconfig:output_type -> protos.GetKubeconfigResponse
-	18, // 21: protos.CloudService.GetClusterVars:output_type -> protos.GetClusterVarsResponse
-	20, // 22: protos.CloudService.GetCloudFileSecret:output_type -> protos.GetCloudFileSecretResponse
-	22, // 23: protos.CloudService.CreateCloudSecret:output_type -> protos.CreateCloudSecretResponse
-	24, // 24: protos.CloudService.DeleteCloudSecret:output_type -> protos.DeleteCloudSecretResponse
-	26, // 25: protos.CloudService.GetCloudSecret:output_type -> protos.GetCloudSecretResponse
-	28, // 26: protos.CloudService.GetCloudSecrets:output_type -> protos.GetCloudSecretsResponse
-	14, // 27: protos.CloudService.GetCephAccess:output_type -> protos.GetCephAccessResponse
-	12, // 28: protos.CloudService.GetSshKey:output_type -> protos.GetSshKeyResponse
-	6,  // 29: protos.CloudService.GetProxmoxApi:output_type -> protos.GetProxmoxApiResponse
-	8,  // 30: protos.CloudService.CreateProxmoxApi:output_type -> protos.CreateProxmoxApiResponse
-	10, // 31: protos.CloudService.DeleteProxmoxApi:output_type -> protos.DeleteProxmoxApiResponse
-	4,  // 32: protos.CloudService.GetProxmoxHost:output_type -> protos.GetProxmoxHostResponse
-	2,  // 33: protos.CloudService.GetPveInventory:output_type -> protos.GetPveInventoryResponse
-	32, // 34: protos.CloudService.GetCloudDomain:output_type -> protos.GetCloudDomainResponse
-	30, // 35: protos.CloudService.GetVmVarsBlake:output_type -> protos.GetVmVarsBlakeResponse
-	20, // [20:36] is the sub-list for method output_type
-	4,  // [4:20] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	63, // 0: protos.GetProxmoxApiRequest.get_args:type_name -> protos.GetProxmoxApiRequest.GetArgsEntry
+	64, // 1: protos.CreateProxmoxApiRequest.create_args:type_name -> protos.CreateProxmoxApiRequest.CreateArgsEntry
+	65, // 2: protos.DeleteProxmoxApiRequest.delete_args:type_name -> protos.DeleteProxmoxApiRequest.DeleteArgsEntry
+	66, // 3: protos.SetProxmoxApiRequest.set_args:type_name -> protos.SetProxmoxApiRequest.SetArgsEntry
+	1,  // 4: protos.GetSshKeyRequest.key_type:type_name -> protos.GetSshKeyRequest.KeyType
+	40, // 5: protos.GetCloudSecretNamesResponse.secrets:type_name -> protos.CloudSecretMeta
+	67, // 6: protos.GetVmVarsBlakeResponse.blake_id_vars:type_name -> protos.GetVmVarsBlakeResponse.BlakeIdVarsEntry
+	68, // 7: protos.GetNodeProxyConfigResponse.config:type_name -> protos.GetNodeProxyConfigResponse.ConfigEntry
+	69, // 8: protos.SetNodeProxyConfigRequest.config:type_name -> protos.SetNodeProxyConfigRequest.ConfigEntry
+	2,  // 9: protos.CephCommandRequest.tool:type_name -> protos.CephCommandRequest.Tool
+	21, // 10: protos.CloudService.GetMasterKubeconfig:input_type -> protos.GetKubeconfigRequest
+	23, // 11: protos.CloudService.GetClusterVars:input_type -> protos.GetClusterVarsRequest
+	25, // 12: protos.CloudService.GetCloudFileSecret:input_type -> protos.GetCloudFileSecretRequest
+	27, // 13: protos.CloudService.CreateCloudSecret:input_type -> protos.CreateCloudSecretRequest
+	29, // 14: protos.CloudService.DeleteCloudSecret:input_type -> protos.DeleteCloudSecretRequest
+	31, // 15: protos.CloudService.UpdateCloudSecret:input_type -> protos.UpdateCloudSecretRequest
+	33, // 16: protos.CloudService.GetCloudSecret:input_type -> protos.GetCloudSecretRequest
+	35, // 17: protos.CloudService.GetCloudSecrets:input_type -> protos.GetCloudSecretsRequest
+	37, // 18: protos.CloudService.GetCloudSecretByName:input_type -> protos.GetCloudSecretByNameRequest
+	39, // 19: protos.CloudService.GetCloudSecretNames:input_type -> protos.GetCloudSecretNamesRequest
+	19, // 20: protos.CloudService.GetCephAccess:input_type -> protos.GetCephAccessRequest
+	17, // 21: protos.CloudService.GetSshKey:input_type -> protos.GetSshKeyRequest
+	7,  // 22: protos.CloudService.GetProxmoxApi:input_type -> protos.GetProxmoxApiRequest
+	9,  // 23: protos.CloudService.CreateProxmoxApi:input_type -> protos.CreateProxmoxApiRequest
+	11, // 24: protos.CloudService.DeleteProxmoxApi:input_type -> protos.DeleteProxmoxApiRequest
+	13, // 25: protos.CloudService.SetProxmoxApi:input_type -> protos.SetProxmoxApiRequest
+	15, // 26: protos.CloudService.WaitForTask:input_type -> protos.WaitForTaskRequest
+	5,  // 27: protos.CloudService.GetProxmoxHost:input_type -> protos.GetProxmoxHostRequest
+	3,  // 28: protos.CloudService.GetPveInventory:input_type -> protos.GetPveInventoryRequest
+	49, // 29: protos.CloudService.GetCloudDomain:input_type -> protos.GetCloudDomainRequest
+	42, // 30: protos.CloudService.GetVmVarsBlake:input_type -> protos.GetVmVarsBlakeRequest
+	42, // 31: protos.CloudService.StreamVmVarsBlake:input_type -> protos.GetVmVarsBlakeRequest
+	45, // 32: protos.CloudService.SetVmVarsBlake:input_type -> protos.SetVmVarsBlakeRequest
+	47, // 33: protos.CloudService.DeleteVmVarsBlake:input_type -> protos.DeleteVmVarsBlakeRequest
+	51, // 34: protos.CloudService.GetNodeProxyConfig:input_type -> protos.GetNodeProxyConfigRequest
+	53, // 35: protos.CloudService.SetNodeProxyConfig:input_type -> protos.SetNodeProxyConfigRequest
+	55, // 36: protos.CloudService.CephCommand:input_type -> protos.CephCommandRequest
+	57, // 37: protos.CloudService.WriteStorageFile:input_type -> protos.WriteStorageFileRequest
+	59, // 38: protos.CloudService.HashStorageFile:input_type -> protos.HashStorageFileRequest
+	61, // 39: protos.CloudService.DeleteStorageFile:input_type -> protos.DeleteStorageFileRequest
+	22, // 40: protos.CloudService.GetMasterKubeconfig:output_type -> protos.GetKubeconfigResponse
+	24, // 41: protos.CloudService.GetClusterVars:output_type -> protos.GetClusterVarsResponse
+	26, // 42: protos.CloudService.GetCloudFileSecret:output_type -> protos.GetCloudFileSecretResponse
+	28, // 43: protos.CloudService.CreateCloudSecret:output_type -> protos.CreateCloudSecretResponse
+	30, // 44: protos.CloudService.DeleteCloudSecret:output_type -> protos.DeleteCloudSecretResponse
+	32, // 45: protos.CloudService.UpdateCloudSecret:output_type -> protos.UpdateCloudSecretResponse
+	34, // 46: protos.CloudService.GetCloudSecret:output_type -> protos.GetCloudSecretResponse
+	36, // 47: protos.CloudService.GetCloudSecrets:output_type -> protos.GetCloudSecretsResponse
+	38, // 48: protos.CloudService.GetCloudSecretByName:output_type -> protos.GetCloudSecretByNameResponse
+	41, // 49: protos.CloudService.GetCloudSecretNames:output_type -> protos.GetCloudSecretNamesResponse
+	20, // 50: protos.CloudService.GetCephAccess:output_type -> protos.GetCephAccessResponse
+	18, // 51: protos.CloudService.GetSshKey:output_type -> protos.GetSshKeyResponse
+	8,  // 52: protos.CloudService.GetProxmoxApi:output_type -> protos.GetProxmoxApiResponse
+	10, // 53: protos.CloudService.CreateProxmoxApi:output_type -> protos.CreateProxmoxApiResponse
+	12, // 54: protos.CloudService.DeleteProxmoxApi:output_type -> protos.DeleteProxmoxApiResponse
+	14, // 55: protos.CloudService.SetProxmoxApi:output_type -> protos.SetProxmoxApiResponse
+	16, // 56: protos.CloudService.WaitForTask:output_type -> protos.WaitForTaskResponse
+	6,  // 57: protos.CloudService.GetProxmoxHost:output_type -> protos.GetProxmoxHostResponse
+	4,  // 58: protos.CloudService.GetPveInventory:output_type -> protos.GetPveInventoryResponse
+	50, // 59: protos.CloudService.GetCloudDomain:output_type -> protos.GetCloudDomainResponse
+	43, // 60: protos.CloudService.GetVmVarsBlake:output_type -> protos.GetVmVarsBlakeResponse
+	44, // 61: protos.CloudService.StreamVmVarsBlake:output_type -> protos.VmVarsBlakeEntry
+	46, // 62: protos.CloudService.SetVmVarsBlake:output_type -> protos.SetVmVarsBlakeResponse
+	48, // 63: protos.CloudService.DeleteVmVarsBlake:output_type -> protos.DeleteVmVarsBlakeResponse
+	52, // 64: protos.CloudService.GetNodeProxyConfig:output_type -> protos.GetNodeProxyConfigResponse
+	54, // 65: protos.CloudService.SetNodeProxyConfig:output_type -> protos.SetNodeProxyConfigResponse
+	56, // 66: protos.CloudService.CephCommand:output_type -> protos.CephCommandResponse
+	58, // 67: protos.CloudService.WriteStorageFile:output_type -> protos.WriteStorageFileResponse
+	60, // 68: protos.CloudService.HashStorageFile:output_type -> protos.HashStorageFileResponse
+	62, // 69: protos.CloudService.DeleteStorageFile:output_type -> protos.DeleteStorageFileResponse
+	40, // [40:70] is the sub-list for method output_type
+	10, // [10:40] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_protos_cloud_proto_init() }
@@ -1954,8 +4014,8 @@ func file_protos_cloud_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_cloud_proto_rawDesc), len(file_protos_cloud_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   35,
+			NumEnums:      3,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
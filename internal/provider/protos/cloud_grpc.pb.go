@@ -19,22 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CloudService_GetMasterKubeconfig_FullMethodName = "/protos.CloudService/GetMasterKubeconfig"
-	CloudService_GetClusterVars_FullMethodName      = "/protos.CloudService/GetClusterVars"
-	CloudService_GetCloudFileSecret_FullMethodName  = "/protos.CloudService/GetCloudFileSecret"
-	CloudService_CreateCloudSecret_FullMethodName   = "/protos.CloudService/CreateCloudSecret"
-	CloudService_DeleteCloudSecret_FullMethodName   = "/protos.CloudService/DeleteCloudSecret"
-	CloudService_GetCloudSecret_FullMethodName      = "/protos.CloudService/GetCloudSecret"
-	CloudService_GetCloudSecrets_FullMethodName     = "/protos.CloudService/GetCloudSecrets"
-	CloudService_GetCephAccess_FullMethodName       = "/protos.CloudService/GetCephAccess"
-	CloudService_GetSshKey_FullMethodName           = "/protos.CloudService/GetSshKey"
-	CloudService_GetProxmoxApi_FullMethodName       = "/protos.CloudService/GetProxmoxApi"
-	CloudService_CreateProxmoxApi_FullMethodName    = "/protos.CloudService/CreateProxmoxApi"
-	CloudService_DeleteProxmoxApi_FullMethodName    = "/protos.CloudService/DeleteProxmoxApi"
-	CloudService_GetProxmoxHost_FullMethodName      = "/protos.CloudService/GetProxmoxHost"
-	CloudService_GetPveInventory_FullMethodName     = "/protos.CloudService/GetPveInventory"
-	CloudService_GetCloudDomain_FullMethodName      = "/protos.CloudService/GetCloudDomain"
-	CloudService_GetVmVarsBlake_FullMethodName      = "/protos.CloudService/GetVmVarsBlake"
+	CloudService_GetMasterKubeconfig_FullMethodName  = "/protos.CloudService/GetMasterKubeconfig"
+	CloudService_GetClusterVars_FullMethodName       = "/protos.CloudService/GetClusterVars"
+	CloudService_GetCloudFileSecret_FullMethodName   = "/protos.CloudService/GetCloudFileSecret"
+	CloudService_CreateCloudSecret_FullMethodName    = "/protos.CloudService/CreateCloudSecret"
+	CloudService_DeleteCloudSecret_FullMethodName    = "/protos.CloudService/DeleteCloudSecret"
+	CloudService_UpdateCloudSecret_FullMethodName    = "/protos.CloudService/UpdateCloudSecret"
+	CloudService_GetCloudSecret_FullMethodName       = "/protos.CloudService/GetCloudSecret"
+	CloudService_GetCloudSecrets_FullMethodName      = "/protos.CloudService/GetCloudSecrets"
+	CloudService_GetCloudSecretByName_FullMethodName = "/protos.CloudService/GetCloudSecretByName"
+	CloudService_GetCloudSecretNames_FullMethodName  = "/protos.CloudService/GetCloudSecretNames"
+	CloudService_GetCephAccess_FullMethodName        = "/protos.CloudService/GetCephAccess"
+	CloudService_GetSshKey_FullMethodName            = "/protos.CloudService/GetSshKey"
+	CloudService_GetProxmoxApi_FullMethodName        = "/protos.CloudService/GetProxmoxApi"
+	CloudService_CreateProxmoxApi_FullMethodName     = "/protos.CloudService/CreateProxmoxApi"
+	CloudService_DeleteProxmoxApi_FullMethodName     = "/protos.CloudService/DeleteProxmoxApi"
+	CloudService_SetProxmoxApi_FullMethodName        = "/protos.CloudService/SetProxmoxApi"
+	CloudService_WaitForTask_FullMethodName          = "/protos.CloudService/WaitForTask"
+	CloudService_GetProxmoxHost_FullMethodName       = "/protos.CloudService/GetProxmoxHost"
+	CloudService_GetPveInventory_FullMethodName      = "/protos.CloudService/GetPveInventory"
+	CloudService_GetCloudDomain_FullMethodName       = "/protos.CloudService/GetCloudDomain"
+	CloudService_GetVmVarsBlake_FullMethodName       = "/protos.CloudService/GetVmVarsBlake"
+	CloudService_StreamVmVarsBlake_FullMethodName    = "/protos.CloudService/StreamVmVarsBlake"
+	CloudService_SetVmVarsBlake_FullMethodName       = "/protos.CloudService/SetVmVarsBlake"
+	CloudService_DeleteVmVarsBlake_FullMethodName    = "/protos.CloudService/DeleteVmVarsBlake"
+	CloudService_GetNodeProxyConfig_FullMethodName   = "/protos.CloudService/GetNodeProxyConfig"
+	CloudService_SetNodeProxyConfig_FullMethodName   = "/protos.CloudService/SetNodeProxyConfig"
+	CloudService_CephCommand_FullMethodName          = "/protos.CloudService/CephCommand"
+	CloudService_WriteStorageFile_FullMethodName     = "/protos.CloudService/WriteStorageFile"
+	CloudService_HashStorageFile_FullMethodName      = "/protos.CloudService/HashStorageFile"
+	CloudService_DeleteStorageFile_FullMethodName    = "/protos.CloudService/DeleteStorageFile"
 )
 
 // CloudServiceClient is the client API for CloudService service.
@@ -46,17 +60,31 @@ type CloudServiceClient interface {
 	GetCloudFileSecret(ctx context.Context, in *GetCloudFileSecretRequest, opts ...grpc.CallOption) (*GetCloudFileSecretResponse, error)
 	CreateCloudSecret(ctx context.Context, in *CreateCloudSecretRequest, opts ...grpc.CallOption) (*CreateCloudSecretResponse, error)
 	DeleteCloudSecret(ctx context.Context, in *DeleteCloudSecretRequest, opts ...grpc.CallOption) (*DeleteCloudSecretResponse, error)
+	UpdateCloudSecret(ctx context.Context, in *UpdateCloudSecretRequest, opts ...grpc.CallOption) (*UpdateCloudSecretResponse, error)
 	GetCloudSecret(ctx context.Context, in *GetCloudSecretRequest, opts ...grpc.CallOption) (*GetCloudSecretResponse, error)
 	GetCloudSecrets(ctx context.Context, in *GetCloudSecretsRequest, opts ...grpc.CallOption) (*GetCloudSecretsResponse, error)
+	GetCloudSecretByName(ctx context.Context, in *GetCloudSecretByNameRequest, opts ...grpc.CallOption) (*GetCloudSecretByNameResponse, error)
+	GetCloudSecretNames(ctx context.Context, in *GetCloudSecretNamesRequest, opts ...grpc.CallOption) (*GetCloudSecretNamesResponse, error)
 	GetCephAccess(ctx context.Context, in *GetCephAccessRequest, opts ...grpc.CallOption) (*GetCephAccessResponse, error)
 	GetSshKey(ctx context.Context, in *GetSshKeyRequest, opts ...grpc.CallOption) (*GetSshKeyResponse, error)
 	GetProxmoxApi(ctx context.Context, in *GetProxmoxApiRequest, opts ...grpc.CallOption) (*GetProxmoxApiResponse, error)
 	CreateProxmoxApi(ctx context.Context, in *CreateProxmoxApiRequest, opts ...grpc.CallOption) (*CreateProxmoxApiResponse, error)
 	DeleteProxmoxApi(ctx context.Context, in *DeleteProxmoxApiRequest, opts ...grpc.CallOption) (*DeleteProxmoxApiResponse, error)
+	SetProxmoxApi(ctx context.Context, in *SetProxmoxApiRequest, opts ...grpc.CallOption) (*SetProxmoxApiResponse, error)
+	WaitForTask(ctx context.Context, in *WaitForTaskRequest, opts ...grpc.CallOption) (*WaitForTaskResponse, error)
 	GetProxmoxHost(ctx context.Context, in *GetProxmoxHostRequest, opts ...grpc.CallOption) (*GetProxmoxHostResponse, error)
 	GetPveInventory(ctx context.Context, in *GetPveInventoryRequest, opts ...grpc.CallOption) (*GetPveInventoryResponse, error)
 	GetCloudDomain(ctx context.Context, in *GetCloudDomainRequest, opts ...grpc.CallOption) (*GetCloudDomainResponse, error)
 	GetVmVarsBlake(ctx context.Context, in *GetVmVarsBlakeRequest, opts ...grpc.CallOption) (*GetVmVarsBlakeResponse, error)
+	StreamVmVarsBlake(ctx context.Context, in *GetVmVarsBlakeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmVarsBlakeEntry], error)
+	SetVmVarsBlake(ctx context.Context, in *SetVmVarsBlakeRequest, opts ...grpc.CallOption) (*SetVmVarsBlakeResponse, error)
+	DeleteVmVarsBlake(ctx context.Context, in *DeleteVmVarsBlakeRequest, opts ...grpc.CallOption) (*DeleteVmVarsBlakeResponse, error)
+	GetNodeProxyConfig(ctx context.Context, in *GetNodeProxyConfigRequest, opts ...grpc.CallOption) (*GetNodeProxyConfigResponse, error)
+	SetNodeProxyConfig(ctx context.Context, in *SetNodeProxyConfigRequest, opts ...grpc.CallOption) (*SetNodeProxyConfigResponse, error)
+	CephCommand(ctx context.Context, in *CephCommandRequest, opts ...grpc.CallOption) (*CephCommandResponse, error)
+	WriteStorageFile(ctx context.Context, in *WriteStorageFileRequest, opts ...grpc.CallOption) (*WriteStorageFileResponse, error)
+	HashStorageFile(ctx context.Context, in *HashStorageFileRequest, opts ...grpc.CallOption) (*HashStorageFileResponse, error)
+	DeleteStorageFile(ctx context.Context, in *DeleteStorageFileRequest, opts ...grpc.CallOption) (*DeleteStorageFileResponse, error)
 }
 
 type cloudServiceClient struct {
@@ -117,6 +145,16 @@ func (c *cloudServiceClient) DeleteCloudSecret(ctx context.Context, in *DeleteCl
 	return out, nil
 }
 
+func (c *cloudServiceClient) UpdateCloudSecret(ctx context.Context, in *UpdateCloudSecretRequest, opts ...grpc.CallOption) (*UpdateCloudSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCloudSecretResponse)
+	err := c.cc.Invoke(ctx, CloudService_UpdateCloudSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cloudServiceClient) GetCloudSecret(ctx context.Context, in *GetCloudSecretRequest, opts ...grpc.CallOption) (*GetCloudSecretResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCloudSecretResponse)
@@ -137,6 +175,26 @@ func (c *cloudServiceClient) GetCloudSecrets(ctx context.Context, in *GetCloudSe
 	return out, nil
 }
 
+func (c *cloudServiceClient) GetCloudSecretByName(ctx context.Context, in *GetCloudSecretByNameRequest, opts ...grpc.CallOption) (*GetCloudSecretByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCloudSecretByNameResponse)
+	err := c.cc.Invoke(ctx, CloudService_GetCloudSecretByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) GetCloudSecretNames(ctx context.Context, in *GetCloudSecretNamesRequest, opts ...grpc.CallOption) (*GetCloudSecretNamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCloudSecretNamesResponse)
+	err := c.cc.Invoke(ctx, CloudService_GetCloudSecretNames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cloudServiceClient) GetCephAccess(ctx context.Context, in *GetCephAccessRequest, opts ...grpc.CallOption) (*GetCephAccessResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCephAccessResponse)
@@ -187,6 +245,26 @@ func (c *cloudServiceClient) DeleteProxmoxApi(ctx context.Context, in *DeletePro
 	return out, nil
 }
 
+func (c *cloudServiceClient) SetProxmoxApi(ctx context.Context, in *SetProxmoxApiRequest, opts ...grpc.CallOption) (*SetProxmoxApiResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProxmoxApiResponse)
+	err := c.cc.Invoke(ctx, CloudService_SetProxmoxApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) WaitForTask(ctx context.Context, in *WaitForTaskRequest, opts ...grpc.CallOption) (*WaitForTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitForTaskResponse)
+	err := c.cc.Invoke(ctx, CloudService_WaitForTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cloudServiceClient) GetProxmoxHost(ctx context.Context, in *GetProxmoxHostRequest, opts ...grpc.CallOption) (*GetProxmoxHostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProxmoxHostResponse)
@@ -227,6 +305,105 @@ func (c *cloudServiceClient) GetVmVarsBlake(ctx context.Context, in *GetVmVarsBl
 	return out, nil
 }
 
+func (c *cloudServiceClient) StreamVmVarsBlake(ctx context.Context, in *GetVmVarsBlakeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmVarsBlakeEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CloudService_ServiceDesc.Streams[0], CloudService_StreamVmVarsBlake_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetVmVarsBlakeRequest, VmVarsBlakeEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CloudService_StreamVmVarsBlakeClient = grpc.ServerStreamingClient[VmVarsBlakeEntry]
+
+func (c *cloudServiceClient) SetVmVarsBlake(ctx context.Context, in *SetVmVarsBlakeRequest, opts ...grpc.CallOption) (*SetVmVarsBlakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVmVarsBlakeResponse)
+	err := c.cc.Invoke(ctx, CloudService_SetVmVarsBlake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) DeleteVmVarsBlake(ctx context.Context, in *DeleteVmVarsBlakeRequest, opts ...grpc.CallOption) (*DeleteVmVarsBlakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVmVarsBlakeResponse)
+	err := c.cc.Invoke(ctx, CloudService_DeleteVmVarsBlake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) GetNodeProxyConfig(ctx context.Context, in *GetNodeProxyConfigRequest, opts ...grpc.CallOption) (*GetNodeProxyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeProxyConfigResponse)
+	err := c.cc.Invoke(ctx, CloudService_GetNodeProxyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) SetNodeProxyConfig(ctx context.Context, in *SetNodeProxyConfigRequest, opts ...grpc.CallOption) (*SetNodeProxyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNodeProxyConfigResponse)
+	err := c.cc.Invoke(ctx, CloudService_SetNodeProxyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) CephCommand(ctx context.Context, in *CephCommandRequest, opts ...grpc.CallOption) (*CephCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CephCommandResponse)
+	err := c.cc.Invoke(ctx, CloudService_CephCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) WriteStorageFile(ctx context.Context, in *WriteStorageFileRequest, opts ...grpc.CallOption) (*WriteStorageFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteStorageFileResponse)
+	err := c.cc.Invoke(ctx, CloudService_WriteStorageFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) HashStorageFile(ctx context.Context, in *HashStorageFileRequest, opts ...grpc.CallOption) (*HashStorageFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HashStorageFileResponse)
+	err := c.cc.Invoke(ctx, CloudService_HashStorageFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudServiceClient) DeleteStorageFile(ctx context.Context, in *DeleteStorageFileRequest, opts ...grpc.CallOption) (*DeleteStorageFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStorageFileResponse)
+	err := c.cc.Invoke(ctx, CloudService_DeleteStorageFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CloudServiceServer is the server API for CloudService service.
 // All implementations must embed UnimplementedCloudServiceServer
 // for forward compatibility.
@@ -236,17 +413,31 @@ type CloudServiceServer interface {
 	GetCloudFileSecret(context.Context, *GetCloudFileSecretRequest) (*GetCloudFileSecretResponse, error)
 	CreateCloudSecret(context.Context, *CreateCloudSecretRequest) (*CreateCloudSecretResponse, error)
 	DeleteCloudSecret(context.Context, *DeleteCloudSecretRequest) (*DeleteCloudSecretResponse, error)
+	UpdateCloudSecret(context.Context, *UpdateCloudSecretRequest) (*UpdateCloudSecretResponse, error)
 	GetCloudSecret(context.Context, *GetCloudSecretRequest) (*GetCloudSecretResponse, error)
 	GetCloudSecrets(context.Context, *GetCloudSecretsRequest) (*GetCloudSecretsResponse, error)
+	GetCloudSecretByName(context.Context, *GetCloudSecretByNameRequest) (*GetCloudSecretByNameResponse, error)
+	GetCloudSecretNames(context.Context, *GetCloudSecretNamesRequest) (*GetCloudSecretNamesResponse, error)
 	GetCephAccess(context.Context, *GetCephAccessRequest) (*GetCephAccessResponse, error)
 	GetSshKey(context.Context, *GetSshKeyRequest) (*GetSshKeyResponse, error)
 	GetProxmoxApi(context.Context, *GetProxmoxApiRequest) (*GetProxmoxApiResponse, error)
 	CreateProxmoxApi(context.Context, *CreateProxmoxApiRequest) (*CreateProxmoxApiResponse, error)
 	DeleteProxmoxApi(context.Context, *DeleteProxmoxApiRequest) (*DeleteProxmoxApiResponse, error)
+	SetProxmoxApi(context.Context, *SetProxmoxApiRequest) (*SetProxmoxApiResponse, error)
+	WaitForTask(context.Context, *WaitForTaskRequest) (*WaitForTaskResponse, error)
 	GetProxmoxHost(context.Context, *GetProxmoxHostRequest) (*GetProxmoxHostResponse, error)
 	GetPveInventory(context.Context, *GetPveInventoryRequest) (*GetPveInventoryResponse, error)
 	GetCloudDomain(context.Context, *GetCloudDomainRequest) (*GetCloudDomainResponse, error)
 	GetVmVarsBlake(context.Context, *GetVmVarsBlakeRequest) (*GetVmVarsBlakeResponse, error)
+	StreamVmVarsBlake(*GetVmVarsBlakeRequest, grpc.ServerStreamingServer[VmVarsBlakeEntry]) error
+	SetVmVarsBlake(context.Context, *SetVmVarsBlakeRequest) (*SetVmVarsBlakeResponse, error)
+	DeleteVmVarsBlake(context.Context, *DeleteVmVarsBlakeRequest) (*DeleteVmVarsBlakeResponse, error)
+	GetNodeProxyConfig(context.Context, *GetNodeProxyConfigRequest) (*GetNodeProxyConfigResponse, error)
+	SetNodeProxyConfig(context.Context, *SetNodeProxyConfigRequest) (*SetNodeProxyConfigResponse, error)
+	CephCommand(context.Context, *CephCommandRequest) (*CephCommandResponse, error)
+	WriteStorageFile(context.Context, *WriteStorageFileRequest) (*WriteStorageFileResponse, error)
+	HashStorageFile(context.Context, *HashStorageFileRequest) (*HashStorageFileResponse, error)
+	DeleteStorageFile(context.Context, *DeleteStorageFileRequest) (*DeleteStorageFileResponse, error)
 	mustEmbedUnimplementedCloudServiceServer()
 }
 
@@ -272,12 +463,21 @@ func (UnimplementedCloudServiceServer) CreateCloudSecret(context.Context, *Creat
 func (UnimplementedCloudServiceServer) DeleteCloudSecret(context.Context, *DeleteCloudSecretRequest) (*DeleteCloudSecretResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCloudSecret not implemented")
 }
+func (UnimplementedCloudServiceServer) UpdateCloudSecret(context.Context, *UpdateCloudSecretRequest) (*UpdateCloudSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCloudSecret not implemented")
+}
 func (UnimplementedCloudServiceServer) GetCloudSecret(context.Context, *GetCloudSecretRequest) (*GetCloudSecretResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCloudSecret not implemented")
 }
 func (UnimplementedCloudServiceServer) GetCloudSecrets(context.Context, *GetCloudSecretsRequest) (*GetCloudSecretsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCloudSecrets not implemented")
 }
+func (UnimplementedCloudServiceServer) GetCloudSecretByName(context.Context, *GetCloudSecretByNameRequest) (*GetCloudSecretByNameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCloudSecretByName not implemented")
+}
+func (UnimplementedCloudServiceServer) GetCloudSecretNames(context.Context, *GetCloudSecretNamesRequest) (*GetCloudSecretNamesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCloudSecretNames not implemented")
+}
 func (UnimplementedCloudServiceServer) GetCephAccess(context.Context, *GetCephAccessRequest) (*GetCephAccessResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCephAccess not implemented")
 }
@@ -293,6 +493,12 @@ func (UnimplementedCloudServiceServer) CreateProxmoxApi(context.Context, *Create
 func (UnimplementedCloudServiceServer) DeleteProxmoxApi(context.Context, *DeleteProxmoxApiRequest) (*DeleteProxmoxApiResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteProxmoxApi not implemented")
 }
+func (UnimplementedCloudServiceServer) SetProxmoxApi(context.Context, *SetProxmoxApiRequest) (*SetProxmoxApiResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProxmoxApi not implemented")
+}
+func (UnimplementedCloudServiceServer) WaitForTask(context.Context, *WaitForTaskRequest) (*WaitForTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WaitForTask not implemented")
+}
 func (UnimplementedCloudServiceServer) GetProxmoxHost(context.Context, *GetProxmoxHostRequest) (*GetProxmoxHostResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProxmoxHost not implemented")
 }
@@ -305,6 +511,33 @@ func (UnimplementedCloudServiceServer) GetCloudDomain(context.Context, *GetCloud
 func (UnimplementedCloudServiceServer) GetVmVarsBlake(context.Context, *GetVmVarsBlakeRequest) (*GetVmVarsBlakeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetVmVarsBlake not implemented")
 }
+func (UnimplementedCloudServiceServer) StreamVmVarsBlake(*GetVmVarsBlakeRequest, grpc.ServerStreamingServer[VmVarsBlakeEntry]) error {
+	return status.Error(codes.Unimplemented, "method StreamVmVarsBlake not implemented")
+}
+func (UnimplementedCloudServiceServer) SetVmVarsBlake(context.Context, *SetVmVarsBlakeRequest) (*SetVmVarsBlakeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVmVarsBlake not implemented")
+}
+func (UnimplementedCloudServiceServer) DeleteVmVarsBlake(context.Context, *DeleteVmVarsBlakeRequest) (*DeleteVmVarsBlakeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVmVarsBlake not implemented")
+}
+func (UnimplementedCloudServiceServer) GetNodeProxyConfig(context.Context, *GetNodeProxyConfigRequest) (*GetNodeProxyConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNodeProxyConfig not implemented")
+}
+func (UnimplementedCloudServiceServer) SetNodeProxyConfig(context.Context, *SetNodeProxyConfigRequest) (*SetNodeProxyConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNodeProxyConfig not implemented")
+}
+func (UnimplementedCloudServiceServer) CephCommand(context.Context, *CephCommandRequest) (*CephCommandResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CephCommand not implemented")
+}
+func (UnimplementedCloudServiceServer) WriteStorageFile(context.Context, *WriteStorageFileRequest) (*WriteStorageFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WriteStorageFile not implemented")
+}
+func (UnimplementedCloudServiceServer) HashStorageFile(context.Context, *HashStorageFileRequest) (*HashStorageFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HashStorageFile not implemented")
+}
+func (UnimplementedCloudServiceServer) DeleteStorageFile(context.Context, *DeleteStorageFileRequest) (*DeleteStorageFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteStorageFile not implemented")
+}
 func (UnimplementedCloudServiceServer) mustEmbedUnimplementedCloudServiceServer() {}
 func (UnimplementedCloudServiceServer) testEmbeddedByValue()                      {}
 
@@ -416,6 +649,24 @@ func _CloudService_DeleteCloudSecret_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudService_UpdateCloudSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCloudSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).UpdateCloudSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_UpdateCloudSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).UpdateCloudSecret(ctx, req.(*UpdateCloudSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CloudService_GetCloudSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCloudSecretRequest)
 	if err := dec(in); err != nil {
@@ -452,6 +703,42 @@ func _CloudService_GetCloudSecrets_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudService_GetCloudSecretByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloudSecretByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).GetCloudSecretByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_GetCloudSecretByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).GetCloudSecretByName(ctx, req.(*GetCloudSecretByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_GetCloudSecretNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCloudSecretNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).GetCloudSecretNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_GetCloudSecretNames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).GetCloudSecretNames(ctx, req.(*GetCloudSecretNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CloudService_GetCephAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCephAccessRequest)
 	if err := dec(in); err != nil {
@@ -542,6 +829,42 @@ func _CloudService_DeleteProxmoxApi_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudService_SetProxmoxApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProxmoxApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).SetProxmoxApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_SetProxmoxApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).SetProxmoxApi(ctx, req.(*SetProxmoxApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_WaitForTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).WaitForTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_WaitForTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).WaitForTask(ctx, req.(*WaitForTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CloudService_GetProxmoxHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProxmoxHostRequest)
 	if err := dec(in); err != nil {
@@ -614,6 +937,161 @@ func _CloudService_GetVmVarsBlake_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudService_StreamVmVarsBlake_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetVmVarsBlakeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CloudServiceServer).StreamVmVarsBlake(m, &grpc.GenericServerStream[GetVmVarsBlakeRequest, VmVarsBlakeEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CloudService_StreamVmVarsBlakeServer = grpc.ServerStreamingServer[VmVarsBlakeEntry]
+
+func _CloudService_SetVmVarsBlake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVmVarsBlakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).SetVmVarsBlake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_SetVmVarsBlake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).SetVmVarsBlake(ctx, req.(*SetVmVarsBlakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_DeleteVmVarsBlake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVmVarsBlakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).DeleteVmVarsBlake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_DeleteVmVarsBlake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).DeleteVmVarsBlake(ctx, req.(*DeleteVmVarsBlakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_GetNodeProxyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeProxyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).GetNodeProxyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_GetNodeProxyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).GetNodeProxyConfig(ctx, req.(*GetNodeProxyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_SetNodeProxyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeProxyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).SetNodeProxyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_SetNodeProxyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).SetNodeProxyConfig(ctx, req.(*SetNodeProxyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_CephCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CephCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).CephCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_CephCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).CephCommand(ctx, req.(*CephCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_WriteStorageFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteStorageFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).WriteStorageFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_WriteStorageFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).WriteStorageFile(ctx, req.(*WriteStorageFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_HashStorageFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashStorageFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).HashStorageFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_HashStorageFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).HashStorageFile(ctx, req.(*HashStorageFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudService_DeleteStorageFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStorageFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudServiceServer).DeleteStorageFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudService_DeleteStorageFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudServiceServer).DeleteStorageFile(ctx, req.(*DeleteStorageFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CloudService_ServiceDesc is the grpc.ServiceDesc for CloudService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -641,6 +1119,10 @@ var CloudService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCloudSecret",
 			Handler:    _CloudService_DeleteCloudSecret_Handler,
 		},
+		{
+			MethodName: "UpdateCloudSecret",
+			Handler:    _CloudService_UpdateCloudSecret_Handler,
+		},
 		{
 			MethodName: "GetCloudSecret",
 			Handler:    _CloudService_GetCloudSecret_Handler,
@@ -649,6 +1131,14 @@ var CloudService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCloudSecrets",
 			Handler:    _CloudService_GetCloudSecrets_Handler,
 		},
+		{
+			MethodName: "GetCloudSecretByName",
+			Handler:    _CloudService_GetCloudSecretByName_Handler,
+		},
+		{
+			MethodName: "GetCloudSecretNames",
+			Handler:    _CloudService_GetCloudSecretNames_Handler,
+		},
 		{
 			MethodName: "GetCephAccess",
 			Handler:    _CloudService_GetCephAccess_Handler,
@@ -669,6 +1159,14 @@ var CloudService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProxmoxApi",
 			Handler:    _CloudService_DeleteProxmoxApi_Handler,
 		},
+		{
+			MethodName: "SetProxmoxApi",
+			Handler:    _CloudService_SetProxmoxApi_Handler,
+		},
+		{
+			MethodName: "WaitForTask",
+			Handler:    _CloudService_WaitForTask_Handler,
+		},
 		{
 			MethodName: "GetProxmoxHost",
 			Handler:    _CloudService_GetProxmoxHost_Handler,
@@ -685,7 +1183,45 @@ var CloudService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVmVarsBlake",
 			Handler:    _CloudService_GetVmVarsBlake_Handler,
 		},
+		{
+			MethodName: "SetVmVarsBlake",
+			Handler:    _CloudService_SetVmVarsBlake_Handler,
+		},
+		{
+			MethodName: "DeleteVmVarsBlake",
+			Handler:    _CloudService_DeleteVmVarsBlake_Handler,
+		},
+		{
+			MethodName: "GetNodeProxyConfig",
+			Handler:    _CloudService_GetNodeProxyConfig_Handler,
+		},
+		{
+			MethodName: "SetNodeProxyConfig",
+			Handler:    _CloudService_SetNodeProxyConfig_Handler,
+		},
+		{
+			MethodName: "CephCommand",
+			Handler:    _CloudService_CephCommand_Handler,
+		},
+		{
+			MethodName: "WriteStorageFile",
+			Handler:    _CloudService_WriteStorageFile_Handler,
+		},
+		{
+			MethodName: "HashStorageFile",
+			Handler:    _CloudService_HashStorageFile_Handler,
+		},
+		{
+			MethodName: "DeleteStorageFile",
+			Handler:    _CloudService_DeleteStorageFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamVmVarsBlake",
+			Handler:       _CloudService_StreamVmVarsBlake_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/cloud.proto",
 }
@@ -1,5 +1,3 @@
-// google health mod
-
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.11
@@ -165,6 +163,86 @@ func (x *HealthCheckResponse) GetErrorMessage() string {
 	return ""
 }
 
+type VersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	mi := &file_protos_health_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_health_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_health_proto_rawDescGZIP(), []int{2}
+}
+
+type VersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	mi := &file_protos_health_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_health_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_health_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
 var File_protos_health_proto protoreflect.FileDescriptor
 
 const file_protos_health_proto_rawDesc = "" +
@@ -178,9 +256,13 @@ const file_protos_health_proto_rawDesc = "" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"+\n" +
 	"\rServingStatus\x12\v\n" +
 	"\aSERVING\x10\x00\x12\r\n" +
-	"\tMISSMATCH\x10\x012J\n" +
+	"\tMISSMATCH\x10\x01\"\x10\n" +
+	"\x0eVersionRequest\"+\n" +
+	"\x0fVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion2\x86\x01\n" +
 	"\x06Health\x12@\n" +
-	"\x05Check\x12\x1a.protos.HealthCheckRequest\x1a\x1b.protos.HealthCheckResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3"
+	"\x05Check\x12\x1a.protos.HealthCheckRequest\x1a\x1b.protos.HealthCheckResponse\x12:\n" +
+	"\aVersion\x12\x16.protos.VersionRequest\x1a\x17.protos.VersionResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3"
 
 var (
 	file_protos_health_proto_rawDescOnce sync.Once
@@ -195,18 +277,22 @@ func file_protos_health_proto_rawDescGZIP() []byte {
 }
 
 var file_protos_health_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protos_health_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protos_health_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_protos_health_proto_goTypes = []any{
 	(HealthCheckResponse_ServingStatus)(0), // 0: protos.HealthCheckResponse.ServingStatus
 	(*HealthCheckRequest)(nil),             // 1: protos.HealthCheckRequest
 	(*HealthCheckResponse)(nil),            // 2: protos.HealthCheckResponse
+	(*VersionRequest)(nil),                 // 3: protos.VersionRequest
+	(*VersionResponse)(nil),                // 4: protos.VersionResponse
 }
 var file_protos_health_proto_depIdxs = []int32{
 	0, // 0: protos.HealthCheckResponse.status:type_name -> protos.HealthCheckResponse.ServingStatus
 	1, // 1: protos.Health.Check:input_type -> protos.HealthCheckRequest
-	2, // 2: protos.Health.Check:output_type -> protos.HealthCheckResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
+	3, // 2: protos.Health.Version:input_type -> protos.VersionRequest
+	2, // 3: protos.Health.Check:output_type -> protos.HealthCheckResponse
+	4, // 4: protos.Health.Version:output_type -> protos.VersionResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
 	1, // [1:1] is the sub-list for extension extendee
 	0, // [0:1] is the sub-list for field type_name
@@ -223,7 +309,7 @@ func file_protos_health_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_health_proto_rawDesc), len(file_protos_health_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,26 +2,31 @@ package provider
 
 import (
 	"context"
-
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
-	"strconv"
 	"time"
 
-	"gopkg.in/yaml.v3"
+	"filippo.io/age"
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
 )
 
 // Ensure PxcProvider satisfies various provider interfaces.
@@ -29,6 +34,7 @@ var _ provider.Provider = &PxcProvider{}
 var _ provider.ProviderWithFunctions = &PxcProvider{}
 var _ provider.ProviderWithEphemeralResources = &PxcProvider{}
 var _ provider.ProviderWithActions = &PxcProvider{}
+var _ provider.ProviderWithListResources = &PxcProvider{}
 
 // PxcProvider defines the provider implementation.
 type PxcProvider struct {
@@ -41,9 +47,24 @@ type PxcProvider struct {
 
 // PxcProviderModel describes the provider data model.
 type PxcProviderModel struct {
-	InventoryPath types.String `tfsdk:"inventory"`
-	TargetCluster types.String `tfsdk:"target_cluster"`
-	exitCh       chan bool
+	InventoryPath      types.String         `tfsdk:"inventory"`
+	TargetCluster      types.String         `tfsdk:"target_cluster"`
+	AgeIdentities      *AgeIdentitiesModel  `tfsdk:"age_identities"`
+	Gotify             *GotifyProviderModel `tfsdk:"gotify"`
+	RpcAddress         types.String         `tfsdk:"rpc_address"`
+	RpcSocketDir       types.String         `tfsdk:"rpc_socket_dir"`
+	RpcToken           types.String         `tfsdk:"rpc_token"`
+	RpcRetryAttempts   types.Int64          `tfsdk:"rpc_retry_attempts"`
+	DefaultTimeout     types.String         `tfsdk:"default_timeout"`
+	DisableCache       types.Bool           `tfsdk:"disable_cache"`
+	RpcRetryBackoff    types.String         `tfsdk:"rpc_retry_backoff"`
+	PythonVenvPath     types.String         `tfsdk:"python_venv_path"`
+	PcrpcPath          types.String         `tfsdk:"pcrpc_path"`
+	BackendInstallMode types.String         `tfsdk:"backend_install_mode"`
+	BackendVersion     types.String         `tfsdk:"backend_version"`
+	Backend            types.String         `tfsdk:"backend"`
+	PveSshHosts        []string             `tfsdk:"pve_ssh_hosts"`
+	exitCh             chan bool
 }
 
 func (p *PxcProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -62,16 +83,146 @@ func (p *PxcProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				MarkdownDescription: "Cluster you want to target, only needed/allowed when passing an inventory of type pxc.cloud.pve_cloud_inv",
 				Optional:            true,
 			},
+			"age_identities": schema.SingleNestedAttribute{
+				MarkdownDescription: "Identities used by all age based resources for decryption, validated when the provider is configured. Keys from ~/.ssh and the legacy CLOUD_AGE_SSH_KEY_FILE env var are used in addition. Passphrase protected identities are unlocked with the CLOUD_AGE_IDENTITY_PASSPHRASE env var.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"files": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Identity files, native age identity files (AGE-SECRET-KEY-...), age identity files encrypted with `age -p` or ssh private keys.",
+						Optional:            true,
+					},
+					"keys": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Inline identities in any of the formats supported by files.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"rpc_address": schema.StringAttribute{
+				MarkdownDescription: "Grpc address of an already running python backend (e.g. `unix:///run/pcrpc.sock` or `pcrpc.example.com:50052`), started via `pcrpc 0 <listen address>`. If set the provider doesn't install and launch its own backend.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("rpc_socket_dir")),
+				},
+			},
+			"rpc_token": schema.StringAttribute{
+				MarkdownDescription: "Token of the backend at rpc_address, the daemon reads it from the PXC_RPC_TOKEN env var. Defaults to the PXC_RPC_TOKEN env var, a launched backend always gets a fresh token.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"default_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of each backend call as go duration (e.g. `5m`), defaults to 120s. The timeouts blocks of resources bound whole operations instead, task waits are bounded by the timeout of the resource.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRe, "must be a go duration, e.g. 90s or 1h30m"),
+				},
+			},
+			"disable_cache": schema.BoolAttribute{
+				MarkdownDescription: "Disables caching of the cluster vars and pve inventory. By default they are fetched once per target_pve and run, set this if they are changed during a run (e.g. by a local-exec).",
+				Optional:            true,
+			},
+			"rpc_retry_attempts": schema.Int64Attribute{
+				MarkdownDescription: "Attempts of backend calls failing with a transient error (backend or cluster unavailable, timed out reads), defaults to 3. Set 1 to disable retries.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rpc_retry_backoff": schema.StringAttribute{
+				MarkdownDescription: "Wait before the first retry as go duration (e.g. `500ms`), doubled for each further attempt up to 30s. Defaults to 1s.",
+				Optional:            true,
+			},
+			"rpc_socket_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory the unix socket of the launched python backend is created in, defaults to /tmp. Set it to e.g. $XDG_RUNTIME_DIR if /tmp is shared or read-only.",
+				Optional:            true,
+			},
+			"python_venv_path": schema.StringAttribute{
+				MarkdownDescription: "Virtualenv the python backend is installed into and launched from. Defaults to the PXC_PYTHON_VENV env var, then to the activated virtualenv (VIRTUAL_ENV). Without a virtualenv the backend isn't installed and pcrpc has to be on the PATH, e.g. via pipx.",
+				Optional:            true,
+			},
+			"pcrpc_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the pcrpc executable to launch, defaults to the PXC_PCRPC_PATH env var, then to bin/pcrpc of the virtualenv and finally to pcrpc on the PATH.",
+				Optional:            true,
+			},
+			"backend_install_mode": schema.StringAttribute{
+				MarkdownDescription: "How the python backend is installed into the virtualenv. `auto` (default) pip installs the matching version from PyPI, `skip` uses the pre-installed backend and any other value is the path of a local rpyc-pve-cloud wheel, installed without reaching out to PyPI (dependency wheels are taken from the same directory). Defaults to the PXC_BACKEND_INSTALL_MODE env var.",
+				Optional:            true,
+			},
+			"backend": schema.StringAttribute{
+				MarkdownDescription: "Backend serving the provider calls. `python` (default) launches the rpyc-pve-cloud daemon, `go` talks to the cluster directly over ssh without python. The go backend only supports the pve native resources and data sources (pvesh calls, tasks, file secrets, ssh keys, ceph access), others fail with an unimplemented error.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("python", "go"),
+				},
+			},
+			"pve_ssh_hosts": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Hosts of the target cluster the go backend connects to as root, tried in order. Defaults to the target_pve name. Authenticates with the ssh agent and the unencrypted keys in ~/.ssh.",
+				Optional:            true,
+			},
+			"backend_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the python backend (rpyc-pve-cloud) the provider installs and requires, defaults to the provider version. The provider refuses to run against a backend reporting another version.",
+				Optional:            true,
+			},
+			"gotify": schema.SingleNestedAttribute{
+				MarkdownDescription: "Gotify connection shared by all gotify resources, attributes set on a resource take precedence.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "Gotify host to connect to (e.g. gotify.example.com).",
+						Optional:            true,
+					},
+					"admin_pw": schema.StringAttribute{
+						MarkdownDescription: "Password for the root gotify admin user needed to make api calls.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"allow_insecure": schema.BoolAttribute{
+						MarkdownDescription: "Allows connection to an insecure gotify serving a self signed certificate via https, disables all tls verification. Prefer ca_cert_pem.",
+						Optional:            true,
+					},
+					"ca_cert_pem": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded ca certificate to verify the gotify server against.",
+						Optional:            true,
+					},
+					"client_cert_pem": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded client certificate for mutual tls.",
+						Optional:            true,
+					},
+					"client_key_pem": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded private key of client_cert_pem.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
 		},
 	}
 }
 
+// AgeIdentitiesModel describes the age_identities provider attribute.
+type AgeIdentitiesModel struct {
+	Files []string `tfsdk:"files"`
+	Keys  []string `tfsdk:"keys"`
+}
+
+// GotifyProviderModel describes the gotify provider attribute, shared by all gotify resources.
+type GotifyProviderModel struct {
+	Host          types.String `tfsdk:"host"`
+	AdminPw       types.String `tfsdk:"admin_pw"`
+	AllowInsecure types.Bool   `tfsdk:"allow_insecure"`
+	CaCertPem     types.String `tfsdk:"ca_cert_pem"`
+	ClientCertPem types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPem  types.String `tfsdk:"client_key_pem"`
+}
 
 type KubesprayInventory struct {
-	TargetPve          string               `yaml:"target_pve"`
-	StackName          string               `yaml:"stack_name"`
+	TargetPve string `yaml:"target_pve"`
+	StackName string `yaml:"stack_name"`
 	// we need these two in the controller module and will return them in cloud_self data source
-	ClusterCertEntries []interface{}   `yaml:"cluster_cert_entries"`
+	ClusterCertEntries []interface{} `yaml:"cluster_cert_entries"`
 	ExternalDomains    []interface{} `yaml:"external_domains"`
 }
 
@@ -81,17 +232,25 @@ type PveCloudInventory struct {
 
 // this gets passed down to resources and they can dynamically pick / err what they need
 type CloudInventory struct {
-	Plugin	string 	`yaml:"plugin"`
-	TargetPve string
-	StackName string
+	Plugin      string `yaml:"plugin"`
+	TargetPve   string
+	StackName   string
 	CloudDomain string
 
+	// identities for age based resources, loaded from the provider config
+	AgeIdentities []age.Identity `yaml:"-"`
+
+	// provider level gotify connection, nil if not configured
+	Gotify *GotifyProviderModel `yaml:"-"`
+
+	// connection to the python rpc server, shared by all resources
+	RpcConn grpc.ClientConnInterface `yaml:"-"`
+
 	// nullables
 	KubesprayInventory *KubesprayInventory
-	PveCloudInventory *PveCloudInventory
+	PveCloudInventory  *PveCloudInventory
 }
 
-
 func (p *PxcProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data PxcProviderModel
 
@@ -123,96 +282,147 @@ func (p *PxcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	}
 
 	switch cloudInv.Plugin {
-		case "pxc.cloud.pve_cloud_inv":
-			// core cloud inventory
-			if data.TargetCluster.IsNull() {
-				resp.Diagnostics.AddError(
-					"Bad configuration",
-					"When passing a pxc.cloud.pve_cloud_inv inventory you need to set target_cluster in the provider configuration!",
-				)
-				return
-			}
-			// parse the pve_cloud_inv file
-			var pveCloudInventory PveCloudInventory
-			err = yaml.Unmarshal(yamlFile, &pveCloudInventory)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Parsing Inventory YAML",
-					"Could not unmarshal YAML: "+err.Error(),
-				)
-				return
-			}
+	case "pxc.cloud.pve_cloud_inv":
+		// core cloud inventory
+		if data.TargetCluster.IsNull() {
+			resp.Diagnostics.AddError(
+				"Bad configuration",
+				"When passing a pxc.cloud.pve_cloud_inv inventory you need to set target_cluster in the provider configuration!",
+			)
+			return
+		}
+		// parse the pve_cloud_inv file
+		var pveCloudInventory PveCloudInventory
+		err = yaml.Unmarshal(yamlFile, &pveCloudInventory)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Parsing Inventory YAML",
+				"Could not unmarshal YAML: "+err.Error(),
+			)
+			return
+		}
 
-			cloudInv.StackName = "master" // only one cloud inv per cloud
-			cloudInv.TargetPve = fmt.Sprintf("%s.%s", data.TargetCluster.ValueString(), pveCloudInventory.PveCloudDomain)
+		cloudInv.StackName = "master" // only one cloud inv per cloud
+		cloudInv.TargetPve = fmt.Sprintf("%s.%s", data.TargetCluster.ValueString(), pveCloudInventory.PveCloudDomain)
 
-			cloudInv.PveCloudInventory = &pveCloudInventory
+		cloudInv.PveCloudInventory = &pveCloudInventory
 
-		case "pxc.cloud.kubespray_inv":
-			// kubernetes
-			if !data.TargetCluster.IsNull() {
-				resp.Diagnostics.AddError(
-					"Bad configuration",
-					"When passing a pxc.cloud.kubespray inventory you are not allowed to set target_cluster! It is sourced from the inventory file.",
-				)
-				return
-			}
+	case "pxc.cloud.kubespray_inv":
+		// kubernetes
+		if !data.TargetCluster.IsNull() {
+			resp.Diagnostics.AddError(
+				"Bad configuration",
+				"When passing a pxc.cloud.kubespray inventory you are not allowed to set target_cluster! It is sourced from the inventory file.",
+			)
+			return
+		}
 
-			var kubeInv KubesprayInventory
-			err = yaml.Unmarshal(yamlFile, &kubeInv)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Parsing Inventory YAML",
-					"Could not unmarshal YAML: "+err.Error(),
-				)
-				return
-			}
+		var kubeInv KubesprayInventory
+		err = yaml.Unmarshal(yamlFile, &kubeInv)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Parsing Inventory YAML",
+				"Could not unmarshal YAML: "+err.Error(),
+			)
+			return
+		}
 
-			cloudInv.TargetPve = kubeInv.TargetPve
-			cloudInv.StackName = kubeInv.StackName
+		cloudInv.TargetPve = kubeInv.TargetPve
+		cloudInv.StackName = kubeInv.StackName
 
-			cloudInv.KubesprayInventory = &kubeInv
+		cloudInv.KubesprayInventory = &kubeInv
 
+	default:
+		resp.Diagnostics.AddError(
+			"Unknown type",
+			"Unknown plugin type: "+cloudInv.Plugin,
+		)
+		return
+	}
 
-		default:
-			resp.Diagnostics.AddError(
-				"Unknown type",
-				"Unknown plugin type: "+ cloudInv.Plugin,
-			)
-			return
+	// load the age identities upfront so config errors surface before any resource runs
+	var ageFiles, ageKeys []string
+	if data.AgeIdentities != nil {
+		ageFiles = data.AgeIdentities.Files
+		ageKeys = data.AgeIdentities.Keys
+	}
+	cloudInv.AgeIdentities, err = loadProviderAgeIdentities(ageFiles, ageKeys)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid age_identities", err.Error())
+		return
 	}
 
-	// next launch our python grpc server
+	cloudInv.Gotify = data.Gotify
 
-	// todo: implement option to specify pythonpath in provider and pass that up here somehow
-	// or find a better solution
-	virtualEnv := os.Getenv("VIRTUAL_ENV")
-	if virtualEnv == "" {
-		resp.Diagnostics.AddError("Client Error", "VIRTUAL_ENV not defined, cant launch gprc")
+	callConfig, err := rpcCallConfigFromProvider(data.DefaultTimeout, data.RpcRetryAttempts, data.RpcRetryBackoff)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid rpc call config", err.Error())
 		return
 	}
+	if !data.DisableCache.ValueBool() {
+		callConfig.Cache = newRpcCache()
+	}
 
-	// with this env var we can determine if we are running in a pytest context
-	pytestCurrent := os.Getenv("PYTEST_CURRENT_TEST")
+	// the go backend talks to the cluster directly, no python daemon needed
+	if data.Backend.ValueString() == "go" {
+		goConn, err := newGoBackend(ctx, cloudInv.TargetPve, data.PveSshHosts, callConfig)
+		if err != nil {
+			resp.Diagnostics.AddError("Could not init go backend", err.Error())
+			return
+		}
 
-	// only install the pypi package if not in e2e scenario (in this case its installed via pip -e .)
-	if pytestCurrent == "" && p.version != "dev" {
-		// package will be published to pypi with same version tag as provider
-		// todo: check against installed version and prevent from removing / missmatching
-		pipCmd := exec.Command(fmt.Sprintf("%s/bin/pip", virtualEnv), "install", fmt.Sprintf("rpyc-pve-cloud==%s", p.version))
+		go func() {
+			<-p.exitCh // wait for exit signal
+			goConn.Close()
+			p.exitCh <- true // call finished
+		}()
 
-		output, err := pipCmd.CombinedOutput()
+		cresp, err := pb.NewCloudServiceClient(goConn).GetCloudDomain(ctx, &pb.GetCloudDomainRequest{TargetPve: cloudInv.TargetPve})
 		if err != nil {
-			resp.Diagnostics.AddError("Could not launch rpc server", fmt.Sprintf("Command failed with error: %v - %s", err, string(output)))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud domain, got error: %s", err))
 			return
 		}
+
+		cloudInv.CloudDomain = cresp.Domain
+		cloudInv.RpcConn = goConn
+		setProviderData(resp, cloudInv)
+		return
+	}
+
+	// next launch our python grpc server, unless we connect to an already running one
+	address := rpcAddress(data.RpcAddress, data.RpcSocketDir)
+	backendVersion := p.version
+	if !data.BackendVersion.IsNull() {
+		backendVersion = data.BackendVersion.ValueString()
 	}
+	token := os.Getenv("PXC_RPC_TOKEN")
+	if !data.RpcToken.IsNull() {
+		token = data.RpcToken.ValueString()
+	}
+	var cmd *exec.Cmd
+	if data.RpcAddress.IsNull() && os.Getenv("PXC_RPC_MANUAL_PID") == "" {
+		backend, err := resolveRpcBackend(data.PythonVenvPath, data.PcrpcPath, data.BackendInstallMode)
+		if err != nil {
+			resp.Diagnostics.AddError("Could not launch rpc server", err.Error())
+			return
+		}
 
-	// start pyhon grpc server as daemon
-	tflog.Info(ctx, fmt.Sprintf("Launching python rpc server on unix:///tmp/pc-rpc-%d.sock", os.Getpid()))
-	cmd := exec.Command(fmt.Sprintf("%s/bin/pcrpc", virtualEnv), strconv.Itoa(os.Getpid()))
-	if err := cmd.Start(); err != nil {
-		resp.Diagnostics.AddError("Failed to start Python backend", err.Error())
+		// fresh token per session, so no other local user can call the backend
+		token, err = newRpcToken()
+		if err != nil {
+			resp.Diagnostics.AddError("Could not launch rpc server", fmt.Sprintf("Unable to generate rpc token: %s", err))
+			return
+		}
+		cmd, err = launchRpcServer(ctx, backend, backendVersion, address, token)
+		if err != nil {
+			resp.Diagnostics.AddError("Could not launch rpc server", err.Error())
+			return
+		}
+	}
+
+	conn, err := dialRpc(address, token, callConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init grpc client, got error: %s", err))
 		return
 	}
 
@@ -220,7 +430,10 @@ func (p *PxcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	go func() {
 		<-p.exitCh // wait for exit signal
 
-		cmd.Process.Kill() // kill
+		conn.Close()
+		if cmd != nil {
+			cmd.Process.Kill() // kill
+		}
 
 		p.exitCh <- true // call finished
 	}()
@@ -233,75 +446,161 @@ func (p *PxcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 			resp.Diagnostics.AddError("Failed to start python grpc server", "Deadline exceeded")
 			return
 		}
-
-		// try connect via grpc and health check
-		conn, err := grpc.NewClient(
-			fmt.Sprintf("unix:///tmp/pc-rpc-%d.sock", os.Getpid()),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		)
-		if err != nil {
-			time.Sleep(200 * time.Millisecond)
-			continue
+		if ctx.Err() != nil {
+			resp.Diagnostics.AddError("Failed to start python grpc server", ctx.Err().Error())
+			return
 		}
-		defer conn.Close()
 
-		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
-		defer cancel()
+		// health check via the shared connection, each attempt releases its context before the next one
+		done := func() bool {
+			ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			defer cancel()
 
-		healthClient := pb.NewHealthClient(conn)
-		hresp, err := healthClient.Check(ctx, &pb.HealthCheckRequest{TargetPve: cloudInv.TargetPve})
+			// verify the backend release first, older backends fail with confusing protocol errors
+			err = checkRpcBackendVersion(ctx, conn, backendVersion)
+			if errors.Is(err, errRpcBackendVersionMismatch) {
+				resp.Diagnostics.AddError("Failed to start python grpc server", err.Error())
+				return true
+			}
+			switch status.Code(err) {
+			case codes.OK:
+			case codes.Unimplemented:
+				resp.Diagnostics.AddError("Failed to start python grpc server", fmt.Sprintf("Backend doesn't support version negotiation, it is older than the provider: %s", err))
+				return true
+			case codes.Unauthenticated:
+				resp.Diagnostics.AddError("Failed to start python grpc server", fmt.Sprintf("Backend rejected the rpc token: %s", err))
+				return true
+			case codes.Unknown:
+				resp.Diagnostics.AddError("Failed to start python grpc server", fmt.Sprintf("Backend failed the version request: %s", err))
+				return true
+			default:
+				// not up yet
+				return false
+			}
 
-		if err != nil {
-			time.Sleep(200 * time.Millisecond)
-			continue
-		}
+			healthClient := pb.NewHealthClient(conn)
+			hresp, err := healthClient.Check(ctx, &pb.HealthCheckRequest{TargetPve: cloudInv.TargetPve})
 
-		if hresp.Status == pb.HealthCheckResponse_MISSMATCH {
-			resp.Diagnostics.AddError("Failed to start python grpc server", hresp.ErrorMessage)
-			return
-		}
+			if err != nil {
+				return false
+			}
 
-		// this case should never hit.
-		// todo: refactor
-		if hresp.Status != pb.HealthCheckResponse_SERVING {
-			time.Sleep(200 * time.Millisecond)
-			continue
-		}
+			if hresp.Status == pb.HealthCheckResponse_MISSMATCH {
+				resp.Diagnostics.AddError("Failed to start python grpc server", hresp.ErrorMessage)
+				return true
+			}
 
-		// its up and running, we now fetch the cloud domain and return
-		cclient := pb.NewCloudServiceClient(conn)
-		cresp, err := cclient.GetCloudDomain(ctx, &pb.GetCloudDomainRequest{TargetPve: cloudInv.TargetPve})
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable get ceph access files, got error: %s", err))
+			// this case should never hit.
+			// todo: refactor
+			if hresp.Status != pb.HealthCheckResponse_SERVING {
+				return false
+			}
+
+			// its up and running, we now fetch the cloud domain and return
+			cclient := pb.NewCloudServiceClient(conn)
+			cresp, err := cclient.GetCloudDomain(ctx, &pb.GetCloudDomainRequest{TargetPve: cloudInv.TargetPve})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable get ceph access files, got error: %s", err))
+				return true
+			}
+
+			// set the domain for all resources to use
+			cloudInv.CloudDomain = cresp.Domain
+			cloudInv.RpcConn = conn
+			return true
+		}()
+		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		// set the domain for all resources to use
-		cloudInv.CloudDomain = cresp.Domain
-		break 
+		if done {
+			break
+		}
+		time.Sleep(200 * time.Millisecond)
 	}
 
-	// simply pass the inventory as data
+	setProviderData(resp, cloudInv)
+}
+
+// setProviderData simply passes the inventory as data
+func setProviderData(resp *provider.ConfigureResponse, cloudInv CloudInventory) {
 	resp.DataSourceData = cloudInv
 	resp.ResourceData = cloudInv
 	resp.EphemeralResourceData = cloudInv
-
-
+	resp.ActionData = cloudInv
+	resp.ListResourceData = cloudInv
 }
 
 func (p *PxcProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGotifyAppResource,
 		NewCloudSecretResource,
+		NewVmVarsResource,
+		NewVmTagsResource,
+		NewVmSnapshotResource,
 		NewCloudSecretAgeResource,
 		NewPveGotifyTargetResource,
+		NewPveSmtpTargetResource,
+		NewPveNotificationMatcherResource,
+		NewPveApiResource,
 		NewPveGraphiteExporterResource,
+		NewNodeTlsOptionsResource,
+		NewPvePoolResource,
+		NewPvePoolMemberResource,
+		NewFirewallSecurityGroupResource,
+		NewFirewallRulesResource,
+		NewFirewallAliasResource,
+		NewFirewallIpsetResource,
+		NewVmFirewallOptionsResource,
+		NewSdnZoneResource,
+		NewSdnVnetResource,
+		NewSdnSubnetResource,
+		NewNodeNetworkBridgeResource,
+		NewNodeNetworkBondResource,
+		NewNodeNetworkVlanResource,
+		NewCephPoolResource,
+		NewCephFsResource,
+		NewCephFsSubvolumeGroupResource,
+		NewCephFsSubvolumeResource,
+		NewCephClientResource,
+		NewRbdImageResource,
+		NewSnapshotJobResource,
+		NewBackupJobResource,
+		NewStoragePbsResource,
+		NewStorageResource,
+		NewHaGroupResource,
+		NewHaResourceResource,
+		NewClusterOptionsResource,
+		NewPveUserResource,
+		NewPveApiTokenResource,
+		NewPveRoleResource,
+		NewPveAclResource,
+		NewPveGroupResource,
+		NewPveRealmResource,
+		NewVmResource,
+		NewVmTemplateResource,
+		NewStorageDownloadResource,
+		NewStorageFileResource,
+		NewVmIdRangeResource,
+		NewHardwareMappingPciResource,
+		NewHardwareMappingUsbResource,
+		NewReplicationJobResource,
+		NewAcmeAccountResource,
+		NewNodeAcmeCertificateResource,
+		NewNodeCertificateResource,
+		NewNodeAptRepositoryResource,
+		NewNodeSubscriptionResource,
+		NewNodeDnsResource,
+		NewNodeHostsEntryResource,
+		NewLxcResource,
 	}
 }
 
 func (p *PxcProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		NewKubeconfigEphemeralResource,
+		NewCephClientEphemeralResource,
+		NewCephAccessEphemeralResource,
+		NewSshKeyEphemeralResource,
 	}
 }
 
@@ -312,20 +611,59 @@ func (p *PxcProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewCephAccessDataSource,
 		NewSshKeyDataSource,
 		NewPveApiGetDataSource,
+		NewPveTaskDataSource,
 		NewProxmoxHostDataSource,
 		NewPveInventoryDataSource,
 		NewCloudSecretDataSource,
 		NewCloudSecretsDataSource,
+		NewCloudSecretNamesDataSource,
 		NewCloudVmsDataSource,
+		NewCloudVmDataSource,
+		NewVmNetworkDataSource,
+		NewCephHealthDataSource,
+		NewCephStatusDataSource,
+		NewCephDfDataSource,
+		NewStorageContentDataSource,
+		NewNextVmIdDataSource,
+		NewPveNodesDataSource,
+		NewClusterStatusDataSource,
+		NewNodeStatusDataSource,
+		NewNodeMetricsDataSource,
+		NewNotificationEndpointsDataSource,
 	}
 }
 
 func (p *PxcProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewSecretsToMapFunction,
+	}
 }
 
 func (p *PxcProvider) Actions(ctx context.Context) []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		NewGotifySendMessageAction,
+		NewPveApiCallAction,
+		NewVmStartAction,
+		NewVmStopAction,
+		NewVmShutdownAction,
+		NewVmRebootAction,
+		NewVmMigrateAction,
+		NewVmSnapshotRollbackAction,
+		NewBackupNowAction,
+		NewBackupRestoreAction,
+		NewCephSetFlagAction,
+		NewCephUnsetFlagAction,
+		NewCephPoolScrubAction,
+		NewCephOsdOutAction,
+		NewCephOsdInAction,
+	}
+}
+
+func (p *PxcProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewVmListResource,
+		NewCloudSecretListResource,
+	}
 }
 
 func New(version string, exitCh chan bool) func() provider.Provider {
@@ -336,29 +674,3 @@ func New(version string, exitCh chan bool) func() provider.Provider {
 		}
 	}
 }
-
-
-func GetCloudRpcService(ctx context.Context)(pb.CloudServiceClient, error){
-	// init rpc client
-	socketPath := fmt.Sprintf("unix:///tmp/pc-rpc-%d.sock", os.Getpid())
-
-	// if this env var is set we connect to a manually launched pve cloud rpc server
-	// for easier debugging
-	manualPid := os.Getenv("PXC_RPC_MANUAL_PID")
-	if manualPid != "" {
-		socketPath = fmt.Sprintf("unix:///tmp/pc-rpc-%s.sock", manualPid)
-	}
-
-	tflog.Info(ctx, socketPath)
-	conn, err := grpc.NewClient(
-		socketPath,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	client := pb.NewCloudServiceClient(conn)
-
-	return client, nil
-}
\ No newline at end of file
@@ -3,10 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
+	"strings"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -24,7 +30,36 @@ type ProxmoxHostDataSource struct {
 
 // ProxmoxHostDataSourceModel describes the data source data model.
 type ProxmoxHostDataSourceModel struct {
-	PveHost types.String `tfsdk:"pve_host"`
+	NodeName      types.String `tfsdk:"node_name"`
+	ExcludeNodes  types.Set    `tfsdk:"exclude_nodes"`
+	PreferNetwork types.String `tfsdk:"prefer_network"`
+	PveHost       types.String `tfsdk:"pve_host"`
+	PveHosts      types.List   `tfsdk:"pve_hosts"`
+}
+
+// pveNodeNetwork is an interface of /nodes/{node}/network.
+type pveNodeNetwork struct {
+	Iface   string `json:"iface"`
+	Address string `json:"address"`
+}
+
+// nodeAddressIn returns the first address of the node's interfaces within network, empty if none.
+func nodeAddressIn(ctx context.Context, client pb.CloudServiceClient, targetPve string, node string, network *net.IPNet) (string, error) {
+	var interfaces []pveNodeNetwork
+	err := getPveApiJson(ctx, client, targetPve, fmt.Sprintf("/nodes/%s/network", node), &interfaces)
+	if err != nil {
+		return "", err
+	}
+
+	slices.SortFunc(interfaces, func(a, b pveNodeNetwork) int {
+		return strings.Compare(a.Iface, b.Iface)
+	})
+	for _, iface := range interfaces {
+		if ip := net.ParseIP(iface.Address); ip != nil && network.Contains(ip) {
+			return ip.String(), nil
+		}
+	}
+	return "", nil
 }
 
 func (d *ProxmoxHostDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,12 +68,34 @@ func (d *ProxmoxHostDataSource) Metadata(ctx context.Context, req datasource.Met
 
 func (d *ProxmoxHostDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches a single online ipv4 host address of a proxmox host in target_pve. This can be used for apps that need to connect to a proxmox host directly.",
+		MarkdownDescription: "Fetches a single online ipv4 host address of a proxmox host in target_pve. This can be used for apps that need to connect to a proxmox host directly. " +
+			"The filters pick the host deterministically, pve_hosts returns the addresses of all matching online hosts.",
 
 		Attributes: map[string]schema.Attribute{
+			"node_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the host of this node.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("exclude_nodes")),
+				},
+			},
+			"exclude_nodes": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Skip the hosts of these nodes, e.g. nodes in maintenance.",
+			},
+			"prefer_network": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "CIDR of a network, e.g. a management network. Returns the node addresses within it instead of the cluster addresses where a node has one.",
+			},
 			"pve_host": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Online pve host ip",
+				MarkdownDescription: "Online pve host ip. Without filters the host the provider is connected to, otherwise the first entry of pve_hosts.",
+			},
+			"pve_hosts": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Addresses of all matching online hosts, sorted by node name.",
 			},
 		},
 	}
@@ -72,20 +129,77 @@ func (d *ProxmoxHostDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
-	// perform the request
-	cresp, err := client.GetProxmoxHost(ctx, &pb.GetProxmoxHostRequest{TargetPve: d.cloudInventory.TargetPve})
+	var network *net.IPNet
+	if !data.PreferNetwork.IsNull() {
+		_, network, err = net.ParseCIDR(data.PreferNetwork.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid prefer_network", err.Error())
+			return
+		}
+	}
+
+	var excluded []string
+	resp.Diagnostics.Append(data.ExcludeNodes.ElementsAs(ctx, &excluded, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var status []pveClusterStatus
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, "/cluster/status", &status)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get proxmox host, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cluster status, got error: %s", err))
+		return
+	}
+
+	slices.SortFunc(status, func(a, b pveClusterStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	hosts := []string{}
+	for _, entry := range status {
+		if entry.Type != "node" || !bool(entry.Online) || entry.Ip == "" ||
+			(!data.NodeName.IsNull() && entry.Name != data.NodeName.ValueString()) || slices.Contains(excluded, entry.Name) {
+			continue
+		}
+
+		host := entry.Ip
+		if network != nil {
+			address, err := nodeAddressIn(ctx, client, d.cloudInventory.TargetPve, entry.Name, network)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get network of node %s, got error: %s", entry.Name, err))
+				return
+			}
+			if address != "" {
+				host = address
+			}
+		}
+		hosts = append(hosts, host)
+	}
+
+	if data.NodeName.IsNull() && data.ExcludeNodes.IsNull() && data.PreferNetwork.IsNull() {
+		// the connected host keeps the behaviour of earlier versions
+		cresp, err := client.GetProxmoxHost(ctx, &pb.GetProxmoxHostRequest{TargetPve: d.cloudInventory.TargetPve})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get proxmox host, got error: %s", err))
+			return
+		}
+		data.PveHost = types.StringValue(cresp.PveHost)
+	} else if len(hosts) > 0 {
+		data.PveHost = types.StringValue(hosts[0])
+	} else {
+		resp.Diagnostics.AddError("No Host Found", "No online pve host matches the filters.")
 		return
 	}
 
-	data.PveHost = types.StringValue(cresp.PveHost)
+	pveHosts, diags := types.ListValueFrom(ctx, types.StringType, hosts)
+	resp.Diagnostics.Append(diags...)
+	data.PveHosts = pveHosts
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveAclResource{}
+var _ resource.ResourceWithImportState = &PveAclResource{}
+
+// acl paths, absolute api paths like /vms/100
+var pveAclPathRe = regexp.MustCompile(`^/[^\s,]*$`)
+
+func NewPveAclResource() resource.Resource {
+	return &PveAclResource{}
+}
+
+// PveAclResource defines the resource implementation.
+type PveAclResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveAclResourceModel describes the resource data model.
+type PveAclResourceModel struct {
+	Path      types.String `tfsdk:"path"`
+	RoleId    types.String `tfsdk:"roleid"`
+	User      types.String `tfsdk:"user"`
+	Group     types.String `tfsdk:"group"`
+	Token     types.String `tfsdk:"token"`
+	Propagate types.Bool   `tfsdk:"propagate"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveAclEntry is an entry of pvesh get /access/acl.
+type PveAclEntry struct {
+	Path      string  `json:"path"`
+	RoleId    string  `json:"roleid"`
+	Type      string  `json:"type"`
+	UgId      string  `json:"ugid"`
+	Propagate pveBool `json:"propagate"`
+}
+
+func (r *PveAclResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_acl"
+}
+
+func (r *PveAclResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a role to a user, group or api token on a proxmox api path. " +
+			"Import with `<path>,<roleid>,<user|group|token>,<id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Api path the role is granted on, e.g. `/`, `/vms/100`, `/storage/local-lvm` or `/pool/k8s`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pveAclPathRe, "must be an absolute api path"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"roleid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Role to grant, built-in like `PVEAuditor` or managed with pxc_pve_role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "User the role is granted to, e.g. `csi@pve`. Exactly one of user, group or token has to be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group"), path.MatchRoot("token")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Group the role is granted to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Api token the role is granted to, the full token id `<userid>!<tokenid>`. Tokens with privilege separation need their own acl entries.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"propagate": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the role is inherited by the paths below.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveAclResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// subject returns the acl entry type and id of the grantee.
+func (data PveAclResourceModel) subject() (string, string) {
+	switch {
+	case !data.Group.IsNull():
+		return "group", data.Group.ValueString()
+	case !data.Token.IsNull():
+		return "token", data.Token.ValueString()
+	default:
+		return "user", data.User.ValueString()
+	}
+}
+
+// aclArgs returns the pvesh args addressing the acl entry, the grantee is passed as --users, --groups or --tokens.
+func (data PveAclResourceModel) aclArgs() map[string]string {
+	subjectType, subjectId := data.subject()
+	return map[string]string{
+		"--path":                 data.Path.ValueString(),
+		"--roles":                data.RoleId.ValueString(),
+		"--" + subjectType + "s": subjectId,
+		"--propagate":            boolToPve(data.Propagate.ValueBool()),
+	}
+}
+
+func (r *PveAclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveAclResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// acl entries are added and removed with pvesh set on the whole acl
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, "/access/acl", data.aclArgs())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating acl entry, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveAclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveAclResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var entries []PveAclEntry
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/access/acl", &entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read acl, got error: %s", err))
+		return
+	}
+
+	subjectType, subjectId := data.subject()
+	var entry *PveAclEntry
+	for i := range entries {
+		if entries[i].Path == data.Path.ValueString() && entries[i].RoleId == data.RoleId.ValueString() && entries[i].Type == subjectType && entries[i].UgId == subjectId {
+			entry = &entries[i]
+			break
+		}
+	}
+	if removeIfMissing(ctx, entry != nil, nil, "acl entry", resp) {
+		return
+	}
+
+	data.Propagate = types.BoolValue(bool(entry.Propagate))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveAclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveAclResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// only propagate can change in place, setting the entry again overwrites it
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, "/access/acl", data.aclArgs())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating acl entry, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveAclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveAclResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	deleteArgs := data.aclArgs()
+	delete(deleteArgs, "--propagate")
+	deleteArgs["--delete"] = "1"
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, "/access/acl", deleteArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting acl entry, got error: %s", err))
+		return
+	}
+}
+
+func (r *PveAclResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format <path>,<roleid>,<user|group|token>,<id>, got: %s", req.ID))
+		return
+	}
+
+	subjectType := parts[2]
+	if subjectType != "user" && subjectType != "group" && subjectType != "token" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected user, group or token as grantee type, got: %s", parts[2]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("roleid"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(subjectType), parts[3])...)
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+)
+
+// getPveApiJson performs a pvesh get call and unmarshals the json response into v.
+func getPveApiJson(ctx context.Context, client pb.CloudServiceClient, targetPve string, apiPath string, v any) error {
+	return getPveApiJsonArgs(ctx, client, targetPve, apiPath, nil, v)
+}
+
+// getPveApiJsonArgs is getPveApiJson with query args like `--type`, they must not be part of apiPath.
+func getPveApiJsonArgs(ctx context.Context, client pb.CloudServiceClient, targetPve string, apiPath string, args map[string]string, v any) error {
+	cresp, err := client.GetProxmoxApi(ctx, &pb.GetProxmoxApiRequest{TargetPve: targetPve, ApiPath: apiPath, GetArgs: args})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal([]byte(cresp.JsonResp), v)
+}
+
+// pveApiEntryExists lists the pve api collection at apiPath and checks if
+// an entry with key == value is part of it.
+func pveApiEntryExists(ctx context.Context, client pb.CloudServiceClient, targetPve string, apiPath string, key string, value string) (bool, error) {
+	var entries []map[string]interface{}
+	err := getPveApiJson(ctx, client, targetPve, apiPath, &entries)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if entryValue, ok := entry[key]; ok && fmt.Sprint(entryValue) == value {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// pveBool decodes proxmox api booleans which are returned either as 0/1 or true/false.
+type pveBool bool
+
+func (b *pveBool) UnmarshalJSON(raw []byte) error {
+	switch string(raw) {
+	case "1", "true", `"1"`:
+		*b = true
+	default:
+		*b = false
+	}
+	return nil
+}
+
+// pveApiCall performs a pvesh create (POST), set (PUT) or delete (DELETE) call, turns
+// server side failures into errors and returns the output, the task UPID for asynchronous calls.
+func pveApiCall(ctx context.Context, client pb.CloudServiceClient, targetPve string, method string, apiPath string, args map[string]string) (string, error) {
+	var success bool
+	var errMessage, output string
+	switch method {
+	case "POST":
+		cresp, err := client.CreateProxmoxApi(ctx, &pb.CreateProxmoxApiRequest{TargetPve: targetPve, ApiPath: apiPath, CreateArgs: args})
+		if err != nil {
+			return "", err
+		}
+		success, errMessage, output = cresp.Success, cresp.ErrMessage, cresp.Output
+	case "PUT":
+		cresp, err := client.SetProxmoxApi(ctx, &pb.SetProxmoxApiRequest{TargetPve: targetPve, ApiPath: apiPath, SetArgs: args})
+		if err != nil {
+			return "", err
+		}
+		success, errMessage, output = cresp.Success, cresp.ErrMessage, cresp.Output
+	case "DELETE":
+		cresp, err := client.DeleteProxmoxApi(ctx, &pb.DeleteProxmoxApiRequest{TargetPve: targetPve, ApiPath: apiPath, DeleteArgs: args})
+		if err != nil {
+			return "", err
+		}
+		success, errMessage, output = cresp.Success, cresp.ErrMessage, cresp.Output
+	default:
+		return "", fmt.Errorf("unsupported method %s", method)
+	}
+
+	if !success {
+		return "", fmt.Errorf("error on server side making %s call to %s: %s", method, apiPath, errMessage)
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+// pveApiCreate performs a pvesh create call and turns server side failures into errors.
+func pveApiCreate(ctx context.Context, client pb.CloudServiceClient, targetPve string, apiPath string, createArgs map[string]string) error {
+	_, err := pveApiCall(ctx, client, targetPve, "POST", apiPath, createArgs)
+	return err
+}
+
+// pveApiSet performs a pvesh set call and turns server side failures into errors.
+func pveApiSet(ctx context.Context, client pb.CloudServiceClient, targetPve string, apiPath string, setArgs map[string]string) error {
+	_, err := pveApiCall(ctx, client, targetPve, "PUT", apiPath, setArgs)
+	return err
+}
+
+// pveApiDelete performs a pvesh delete call and turns server side failures into errors.
+func pveApiDelete(ctx context.Context, client pb.CloudServiceClient, targetPve string, apiPath string) error {
+	_, err := pveApiCall(ctx, client, targetPve, "DELETE", apiPath, nil)
+	return err
+}
+
+// matches proxmox task ids, UPID:<node>:<pid>:<pstart>:<starttime>:<type>:<id>:<user>:
+var pveUpidRe = regexp.MustCompile(`^UPID:[^:]+:[0-9A-F]+:[0-9A-F]+:[0-9A-F]+:[^:]+:[^:]*:[^:]+:$`)
+
+// isPveUpid checks if the output of a pvesh call is the UPID of a started task.
+func isPveUpid(output string) bool {
+	return pveUpidRe.MatchString(output)
+}
+
+// pveWaitForTask blocks until the task started by a pvesh call finishes and fails if it
+// didn't finish within timeout seconds or exited with an error. Synchronous outputs are ignored.
+func pveWaitForTask(ctx context.Context, client pb.CloudServiceClient, targetPve string, output string, timeout int64) error {
+	if !isPveUpid(output) {
+		return nil
+	}
+
+	// the wait has to outlast the default call timeout
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second+rpcCallTimeout)
+		defer cancel()
+	}
+
+	cresp, err := client.WaitForTask(ctx, &pb.WaitForTaskRequest{TargetPve: targetPve, Upid: output, TimeoutSeconds: timeout, LogLines: 20})
+	if err != nil {
+		return err
+	}
+
+	if !cresp.Finished {
+		return fmt.Errorf("task %s didn't finish within %d seconds, log:\n%s", output, timeout, cresp.LogTail)
+	}
+	if cresp.ExitStatus != "OK" {
+		return fmt.Errorf("task %s failed with %s, log:\n%s", output, cresp.ExitStatus, cresp.LogTail)
+	}
+
+	return nil
+}
+
+// pveApiCallWait makes the api call and, if wait is set, waits up to timeout seconds for the started task.
+func pveApiCallWait(ctx context.Context, client pb.CloudServiceClient, targetPve string, method string, apiPath string, args map[string]string, wait bool, timeout int64) error {
+	output, err := pveApiCall(ctx, client, targetPve, method, apiPath, args)
+	if err != nil || !wait {
+		return err
+	}
+
+	return pveWaitForTask(ctx, client, targetPve, output, timeout)
+}
+
+// parsePveProps splits a proxmox property string like `local-lvm:vm-100-disk-0,size=32G`
+// into its leading value (if it is not a key=value pair) and the key=value properties.
+func parsePveProps(propString string) (string, map[string]string) {
+	lead := ""
+	props := map[string]string{}
+	for i, part := range strings.Split(propString, ",") {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			if i == 0 {
+				lead = part
+			}
+			continue
+		}
+		props[key] = value
+	}
+	return lead, props
+}
+
+// pveConfigInt reads an integer from a proxmox config, which might be encoded as string or number.
+func pveConfigInt(config map[string]interface{}, key string) (int64, bool) {
+	value, ok := config[key]
+	if !ok {
+		return 0, false
+	}
+
+	parsed, err := strconv.ParseInt(fmt.Sprint(value), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return parsed, true
+}
+
+// pveConfigString reads a string from a proxmox config.
+func pveConfigString(config map[string]interface{}, key string) (string, bool) {
+	value, ok := config[key]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(value), true
+}
+
+// boolToPve converts a bool to the 0/1 representation proxmox expects.
+func boolToPve(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
+
+// pveStringList parses a pve list value which is either a single string or a list, e.g. the target of a matcher.
+func pveStringList(raw json.RawMessage) []string {
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil {
+		return values
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return []string{value}
+	}
+
+	return nil
+}
+
+// parsePveObject reads a property value which pve returns either as object or property string, e.g. prune-backups.
+func parsePveObject(raw json.RawMessage) map[string]string {
+	props := map[string]string{}
+
+	var obj map[string]interface{}
+	var spec string
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber() // keeps large integers out of float notation
+	if err := decoder.Decode(&obj); err == nil {
+		for key, value := range obj {
+			// lists are joined like in the property string, e.g. user-allow-list
+			if list, ok := value.([]interface{}); ok {
+				parts := make([]string, len(list))
+				for i, item := range list {
+					parts[i] = fmt.Sprint(item)
+				}
+				props[key] = strings.Join(parts, ";")
+				continue
+			}
+			props[key] = fmt.Sprint(value)
+		}
+	} else if err := json.Unmarshal(raw, &spec); err == nil {
+		_, props = parsePveProps(spec)
+	}
+	return props
+}
+
+// findPveGuest looks up the node and type of a vm or lxc container in the cluster resources.
+// Guests can be migrated, so the node shouldn't be cached. Returns nil if the guest doesn't exist.
+func findPveGuest(ctx context.Context, client pb.CloudServiceClient, targetPve string, vmid int64) (*PveClusterVm, error) {
+	var guests []PveClusterVm
+	err := getPveApiJsonArgs(ctx, client, targetPve, "/cluster/resources", map[string]string{"--type": "vm"}, &guests)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, guest := range guests {
+		if guest.VmId == vmid {
+			return &guest, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// pveNextVmId asks the cluster for the next free vmid. Proxmox returns it as json string.
+func pveNextVmId(ctx context.Context, client pb.CloudServiceClient, targetPve string) (int64, error) {
+	var nextId json.RawMessage
+	err := getPveApiJson(ctx, client, targetPve, "/cluster/nextid", &nextId)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.Trim(string(nextId), `"`), 10, 64)
+}
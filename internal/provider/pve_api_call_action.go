@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &PveApiCallAction{}
+var _ action.ActionWithConfigure = &PveApiCallAction{}
+
+func NewPveApiCallAction() action.Action {
+	return &PveApiCallAction{}
+}
+
+// PveApiCallAction defines the action implementation.
+type PveApiCallAction struct {
+	cloudInventory CloudInventory
+}
+
+// PveApiCallActionModel describes the action data model.
+type PveApiCallActionModel struct {
+	Method    types.String      `tfsdk:"method"`
+	ApiPath   types.String      `tfsdk:"api_path"`
+	Args      map[string]string `tfsdk:"args"`
+	TargetPve types.String      `tfsdk:"target_pve"`
+	Wait      types.Bool        `tfsdk:"wait_for_completion"`
+	Timeout   types.Int64       `tfsdk:"timeout"`
+}
+
+func (a *PveApiCallAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_api_call"
+}
+
+func (a *PveApiCallAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Makes a one-shot proxmox api call via the pvesh cli tool, for imperative operations like bulk starts or certificate renewals. The task UPID of asynchronous calls is reported as progress.",
+
+		Attributes: map[string]schema.Attribute{
+			"method": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Http method of the call, POST (pvesh create), PUT (pvesh set) or DELETE (pvesh delete).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("POST", "PUT", "DELETE"),
+				},
+			},
+			"api_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Api path of the call (e.g. /nodes/pve1/startall).",
+			},
+			"args": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "CLI args that are inserted after the api_path (e.g. `{\"--force\" = \"1\"}`).",
+			},
+			"target_pve": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Target proxmox cluster that is used to execute the call. Defaults to what the pxc provider was initialized with.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Waits for the task started by an asynchronous call to finish and fails if it exits with an error. Defaults to false.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Seconds to wait for the task to finish, defaults to 600.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *PveApiCallAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *PveApiCallAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data PveApiCallActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	targetPve := a.cloudInventory.TargetPve
+	if !data.TargetPve.IsNull() {
+		targetPve = data.TargetPve.ValueString()
+	}
+	apiPath := data.ApiPath.ValueString()
+
+	output, err := pveApiCall(ctx, client, targetPve, data.Method.ValueString(), apiPath, data.Args)
+	if err != nil {
+		resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Error making %s call to %s, got error: %s", data.Method.ValueString(), apiPath, err))
+		return
+	}
+
+	if !isPveUpid(output) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%s %s done", data.Method.ValueString(), apiPath)})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started task %s", output)})
+	if !data.Wait.ValueBool() {
+		return
+	}
+
+	timeout := int64(600)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+	err = pveWaitForTask(ctx, client, targetPve, output, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Task Error", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Task %s finished", output)})
+}
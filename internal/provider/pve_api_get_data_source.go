@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"strings"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,9 +28,11 @@ type PveApiGetDataSource struct {
 
 // PveApiGetDataSourceModel describes the data source data model.
 type PveApiGetDataSourceModel struct {
-	ApiPath  types.String `tfsdk:"api_path"`
-	GetArgs  types.Map    `tfsdk:"get_args"`
-	JsonResp types.String `tfsdk:"json_resp"`
+	ApiPath   types.String  `tfsdk:"api_path"`
+	GetArgs   types.Map     `tfsdk:"get_args"`
+	TargetPve types.String  `tfsdk:"target_pve"`
+	JsonResp  types.String  `tfsdk:"json_resp"`
+	Result    types.Dynamic `tfsdk:"result"`
 }
 
 func (d *PveApiGetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -55,6 +61,10 @@ func (d *PveApiGetDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:            true,
 				MarkdownDescription: "Proxmox api response in json --output format",
 			},
+			"result": schema.DynamicAttribute{
+				Computed:            true,
+				MarkdownDescription: "Parsed proxmox api response, objects and lists can be accessed directly without jsondecode().",
+			},
 		},
 	}
 }
@@ -87,7 +97,7 @@ func (d *PveApiGetDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
@@ -103,8 +113,13 @@ func (d *PveApiGetDataSource) Read(ctx context.Context, req datasource.ReadReque
 		}
 	}
 
+	targetPve := d.cloudInventory.TargetPve
+	if !data.TargetPve.IsNull() {
+		targetPve = data.TargetPve.ValueString()
+	}
+
 	// perform the request
-	cresp, err := client.GetProxmoxApi(ctx, &pb.GetProxmoxApiRequest{TargetPve: d.cloudInventory.TargetPve, ApiPath: data.ApiPath.ValueString(), GetArgs: getArgs})
+	cresp, err := client.GetProxmoxApi(ctx, &pb.GetProxmoxApiRequest{TargetPve: targetPve, ApiPath: data.ApiPath.ValueString(), GetArgs: getArgs})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make get api request, got error: %s", err))
 		return
@@ -112,6 +127,55 @@ func (d *PveApiGetDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	data.JsonResp = types.StringValue(cresp.JsonResp)
 
+	// use numbers to not lose precision on large ints
+	var parsed interface{}
+	dec := json.NewDecoder(strings.NewReader(cresp.JsonResp))
+	dec.UseNumber()
+	if err := dec.Decode(&parsed); err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse api response as json, got error: %s", err))
+		return
+	}
+	data.Result = types.DynamicValue(jsonToAttrValue(parsed))
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// jsonToAttrValue converts decoded json into a framework value. Objects become
+// objects and arrays tuples, since pve responses are not homogeneous.
+func jsonToAttrValue(v interface{}) attr.Value {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(val))
+		attrValues := make(map[string]attr.Value, len(val))
+		for k, elem := range val {
+			attrValues[k] = jsonToAttrValue(elem)
+			attrTypes[k] = attrValues[k].Type(context.Background())
+		}
+		return types.ObjectValueMust(attrTypes, attrValues)
+	case []interface{}:
+		elemTypes := make([]attr.Type, len(val))
+		elemValues := make([]attr.Value, len(val))
+		for i, elem := range val {
+			elemValues[i] = jsonToAttrValue(elem)
+			elemTypes[i] = elemValues[i].Type(context.Background())
+		}
+		return types.TupleValueMust(elemTypes, elemValues)
+	case json.Number:
+		num, ok := new(big.Float).SetString(val.String())
+		if !ok {
+			return types.StringValue(val.String())
+		}
+		return types.NumberValue(num)
+	case float64:
+		// json decoded without UseNumber
+		return types.NumberValue(big.NewFloat(val))
+	case string:
+		return types.StringValue(val)
+	case bool:
+		return types.BoolValue(val)
+	default:
+		// json null has no type, a null string is the closest
+		return types.StringNull()
+	}
+}
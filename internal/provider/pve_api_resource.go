@@ -0,0 +1,345 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveApiResource{}
+
+func NewPveApiResource() resource.Resource {
+	return &PveApiResource{}
+}
+
+// PveApiResource defines the resource implementation.
+type PveApiResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveApiResourceModel describes the resource data model.
+type PveApiResourceModel struct {
+	ApiPath    types.String      `tfsdk:"api_path"`
+	CreatePath types.String      `tfsdk:"create_path"`
+	CreateArgs map[string]string `tfsdk:"create_args"`
+	UpdateArgs map[string]string `tfsdk:"update_args"`
+	ReadPath   types.String      `tfsdk:"read_path"`
+	ReadKey    types.String      `tfsdk:"read_key"`
+	ReadValue  types.String      `tfsdk:"read_value"`
+	ReadKeyMap map[string]string `tfsdk:"read_key_map"`
+	Wait       types.Bool        `tfsdk:"wait_for_completion"`
+	Timeout    types.Int64       `tfsdk:"timeout"`
+	JsonResp   types.String      `tfsdk:"json_resp"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *PveApiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_api"
+}
+
+func (r *PveApiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an arbitrary proxmox api object via the pvesh cli tool, pvesh create on create, set on update, get on read and delete on destroy. Meant as escape hatch for objects without typed resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"api_path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Api path of the object, used for set and delete calls (e.g. /pools/mypool).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Api path the create call is made against, defaults to api_path. Many pve objects are created on the collection (e.g. /pools with `--poolid mypool`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_args": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "CLI args of the create call (e.g. `{\"--poolid\" = \"mypool\"}`), changes recreate the object.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"update_args": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "CLI args of the set call made on updates, they are also passed on create. Changes are applied in place.",
+			},
+			"read_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Api path that is read for drift detection, defaults to api_path. Point it to the collection together with read_key for objects that can only be listed.",
+			},
+			"read_key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set the read_path response is treated as list and the object is the entry whose read_key equals read_value. The resource is removed from state if there is no such entry.",
+			},
+			"read_value": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Value of read_key identifying the object, defaults to the last segment of api_path.",
+			},
+			"read_key_map": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Maps update_args keys to keys of the read response (e.g. `{\"--comment\" = \"comment\"}`). Mapped values that differ remotely are refreshed into update_args so the next apply sets them again.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Waits for tasks started by asynchronous calls to finish and fails if they exit with an error.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				MarkdownDescription: "Seconds to wait for started tasks to finish.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"json_resp": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Read response of the object in json format.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveApiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *PveApiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveApiResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{}
+	for k, v := range data.UpdateArgs {
+		createArgs[k] = v
+	}
+	for k, v := range data.CreateArgs {
+		createArgs[k] = v
+	}
+
+	createPath := data.ApiPath.ValueString()
+	if !data.CreatePath.IsNull() {
+		createPath = data.CreatePath.ValueString()
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", createPath, createArgs, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating %s, got error: %s", data.ApiPath.ValueString(), err))
+		return
+	}
+
+	object, found, err := r.readObject(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s after create, got error: %s", data.ApiPath.ValueString(), err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("%s was created but can't be found, check read_path, read_key and read_value", data.ApiPath.ValueString()))
+		return
+	}
+	data.JsonResp = types.StringValue(object)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveApiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveApiResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	object, found, err := r.readObject(ctx, data)
+	if removeIfMissing(ctx, found, err, data.ApiPath.ValueString(), resp) {
+		return
+	}
+	data.JsonResp = types.StringValue(object)
+
+	// refresh the mapped update args so remote changes show up in the plan
+	var fields map[string]interface{}
+	if len(data.ReadKeyMap) > 0 && json.Unmarshal([]byte(object), &fields) == nil {
+		for argKey, respKey := range data.ReadKeyMap {
+			remote, ok := pveConfigString(fields, respKey)
+			if !ok {
+				continue
+			}
+			if _, managed := data.UpdateArgs[argKey]; managed {
+				data.UpdateArgs[argKey] = remote
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveApiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveApiResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// only the read settings might have changed
+	if len(data.UpdateArgs) > 0 {
+		err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "PUT", data.ApiPath.ValueString(), data.UpdateArgs, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating %s, got error: %s", data.ApiPath.ValueString(), err))
+			return
+		}
+	}
+
+	object, found, err := r.readObject(ctx, data)
+	if err != nil || !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s after update, found: %t, error: %v", data.ApiPath.ValueString(), found, err))
+		return
+	}
+	data.JsonResp = types.StringValue(object)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveApiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveApiResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", data.ApiPath.ValueString(), nil, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting %s, got error: %s", data.ApiPath.ValueString(), err))
+		return
+	}
+}
+
+// readObject reads the object and returns it json encoded. Without read_key a
+// successful get counts as found, with read_key the matching list entry is returned.
+func (r *PveApiResource) readObject(ctx context.Context, data PveApiResourceModel) (string, bool, error) {
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return "", false, err
+	}
+
+	readPath := data.ApiPath.ValueString()
+	if !data.ReadPath.IsNull() {
+		readPath = data.ReadPath.ValueString()
+	}
+
+	if data.ReadKey.IsNull() {
+		var object json.RawMessage
+		err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, readPath, &object)
+		return string(object), err == nil, err
+	}
+
+	readValue := path.Base(strings.TrimSuffix(data.ApiPath.ValueString(), "/"))
+	if !data.ReadValue.IsNull() {
+		readValue = data.ReadValue.ValueString()
+	}
+
+	var entries []map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, readPath, &entries)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, entry := range entries {
+		if fmt.Sprint(entry[data.ReadKey.ValueString()]) == readValue {
+			object, err := json.Marshal(entry)
+			return string(object), true, err
+		}
+	}
+
+	return "", false, nil
+}
@@ -0,0 +1,388 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveApiTokenResource{}
+var _ resource.ResourceWithImportState = &PveApiTokenResource{}
+
+// proxmox token ids, the part after the ! of the full token id
+var pveTokenIdRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9.\-_]+$`)
+
+func NewPveApiTokenResource() resource.Resource {
+	return &PveApiTokenResource{}
+}
+
+// PveApiTokenResource defines the resource implementation.
+type PveApiTokenResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveApiTokenResourceModel describes the resource data model.
+type PveApiTokenResourceModel struct {
+	UserId         types.String                 `tfsdk:"userid"`
+	TokenId        types.String                 `tfsdk:"tokenid"`
+	Comment        types.String                 `tfsdk:"comment"`
+	Expire         types.Int64                  `tfsdk:"expire"`
+	Privsep        types.Bool                   `tfsdk:"privsep"`
+	RotateTriggers types.Map                    `tfsdk:"rotate_triggers"`
+	SecretStore    *PveApiTokenSecretStoreModel `tfsdk:"secret_store"`
+	FullTokenId    types.String                 `tfsdk:"full_tokenid"`
+	Value          types.String                 `tfsdk:"value"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveApiTokenSecretStoreModel describes the cloud secret the token is stored in.
+type PveApiTokenSecretStoreModel struct {
+	SecretName types.String `tfsdk:"secret_name"`
+	Namespace  types.String `tfsdk:"namespace"`
+}
+
+// PveApiToken is the subset of pvesh get /access/users/{userid}/token/{tokenid} we manage.
+type PveApiToken struct {
+	Comment string  `json:"comment"`
+	Expire  int64   `json:"expire"`
+	Privsep pveBool `json:"privsep"`
+}
+
+// PveApiTokenCreated is the output of the token creation, the only time the value is returned.
+type PveApiTokenCreated struct {
+	FullTokenId string `json:"full-tokenid"`
+	Value       string `json:"value"`
+}
+
+func (r *PveApiTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_api_token"
+}
+
+func (r *PveApiTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates an api token of a proxmox user. Proxmox returns the token secret only on creation, " +
+			"with secret_store it is saved as cloud secret and never enters the state, otherwise it is kept in the sensitive value attribute. " +
+			"Change rotate_triggers to rotate the token. Import with `<userid>!<tokenid>`, the secret can't be imported.",
+
+		Attributes: map[string]schema.Attribute{
+			"userid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "User owning the token, e.g. `csi@pve`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pveUserIdRe, "must be <name>@<realm>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tokenid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the token, unique per user.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pveTokenIdRe, "must be a valid proxmox token id"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the token.",
+			},
+			"expire": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Expiration as unix timestamp, 0 uses the expiration of the user.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"privsep": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Privilege separation, the token needs its own acl entries instead of inheriting the permissions of the user.",
+			},
+			"rotate_triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values, changing them recreates the token with a new secret, e.g. a `time_rotating` timestamp.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_store": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Cloud secret the token is stored in as json with token_id and secret, read it with the pxc_cloud_secret data source. The secret is deleted with the token.",
+				Attributes: map[string]schema.Attribute{
+					"secret_name": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Name of the cloud secret.",
+					},
+					"namespace": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Namespace of the cloud secret.",
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(), // the secret is only known on creation
+				},
+			},
+			"full_tokenid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Full id of the token, `<userid>!<tokenid>`, as used in the `PVEAPIToken` authorization header.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret of the token, null if secret_store is set or the token was imported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveApiTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (data PveApiTokenResourceModel) tokenPath() string {
+	return fmt.Sprintf("/access/users/%s/token/%s", data.UserId.ValueString(), data.TokenId.ValueString())
+}
+
+// tokenArgs returns the pvesh args of the token, unset comments are cleared by passing them empty.
+func (data PveApiTokenResourceModel) tokenArgs() map[string]string {
+	return map[string]string{
+		"--comment": data.Comment.ValueString(),
+		"--expire":  strconv.FormatInt(data.Expire.ValueInt64(), 10),
+		"--privsep": boolToPve(data.Privsep.ValueBool()),
+	}
+}
+
+func (r *PveApiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveApiTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := data.tokenArgs()
+	createArgs["--output-format"] = "json"
+
+	output, err := pveApiCall(ctx, client, r.cloudInventory.TargetPve, "POST", data.tokenPath(), createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating api token, got error: %s", err))
+		return
+	}
+
+	var created PveApiTokenCreated
+	if err := json.Unmarshal([]byte(output), &created); err != nil || created.Value == "" {
+		// the token exists but its secret is lost, remove it so the next apply can recreate it
+		_ = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.tokenPath())
+		resp.Diagnostics.AddError("Create Call Error", "Api token was created but its secret couldn't be read from the output, the token was deleted again.")
+		return
+	}
+
+	data.FullTokenId = types.StringValue(created.FullTokenId)
+	data.Value = types.StringNull()
+
+	if data.SecretStore != nil {
+		secretData, err := json.Marshal(map[string]string{"token_id": created.FullTokenId, "secret": created.Value})
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode api token secret, got error: %s", err))
+			return
+		}
+
+		cresp, err := client.CreateCloudSecret(ctx, &pb.CreateCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretStore.SecretName.ValueString(), SecretType: "pve_api_token", SecretData: string(secretData), Namespace: data.SecretStore.Namespace.ValueString()})
+		if err == nil && !cresp.Success {
+			err = fmt.Errorf("error on server side creating cloud secret: %s", cresp.ErrMessage)
+		}
+		if err != nil {
+			_ = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.tokenPath())
+			resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to store api token as cloud secret, the token was deleted again, got error: %s", err))
+			return
+		}
+	} else {
+		data.Value = types.StringValue(created.Value)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveApiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveApiTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/users/%s/token", data.UserId.ValueString()), "tokenid", data.TokenId.ValueString())
+	if removeIfMissing(ctx, exists, err, "api token", resp) {
+		return
+	}
+
+	var token PveApiToken
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.tokenPath(), &token)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read api token, got error: %s", err))
+		return
+	}
+
+	data.Comment = optionalString(token.Comment)
+	data.Expire = types.Int64Value(token.Expire)
+	data.Privsep = types.BoolValue(bool(token.Privsep))
+	data.FullTokenId = types.StringValue(fmt.Sprintf("%s!%s", data.UserId.ValueString(), data.TokenId.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveApiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveApiTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.tokenPath(), data.tokenArgs())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating api token, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveApiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveApiTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.tokenPath())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting api token, got error: %s", err))
+		return
+	}
+
+	if data.SecretStore != nil {
+		cresp, err := client.DeleteCloudSecret(ctx, &pb.DeleteCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: data.SecretStore.SecretName.ValueString(), Namespace: data.SecretStore.Namespace.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make grpc delete cloud secret request, got error: %s", err))
+			return
+		}
+		if !cresp.Success {
+			resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error on server side deleting cloud secret, got error: %s", cresp.ErrMessage))
+			return
+		}
+	}
+}
+
+func (r *PveApiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userId, tokenId, found := strings.Cut(req.ID, "!")
+	if !found || userId == "" || tokenId == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <userid>!<tokenid>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("userid"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tokenid"), tokenId)...)
+}
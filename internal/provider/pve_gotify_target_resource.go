@@ -6,13 +6,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,32 +41,97 @@ type PveGotifyTargetResource struct {
 type PveGotifyTargetResourceModel struct {
 	GotifyHost  types.String `tfsdk:"gotify_host"`
 	GotifyToken types.String `tfsdk:"gotify_token"`
+	TargetName  types.String `tfsdk:"target_name"`
+	MatcherName types.String `tfsdk:"matcher_name"`
+	Comment     types.String `tfsdk:"comment"`
+	Severities  []string     `tfsdk:"severities"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveGotifyEndpoint is an entry of pvesh get /cluster/notifications/endpoints/gotify.
+type PveGotifyEndpoint struct {
+	Name    string `json:"name"`
+	Server  string `json:"server"`
+	Comment string `json:"comment"`
 }
 
+// PveNotificationMatcherSeverity is the severity part of pvesh get /cluster/notifications/matchers.
+type PveNotificationMatcherSeverity struct {
+	Name          string   `json:"name"`
+	MatchSeverity []string `json:"match-severity"`
+}
+
+// severities returns the matched severities, they are either listed separately or comma joined.
+func (m PveNotificationMatcherSeverity) severities() []string {
+	severities := []string{}
+	for _, severity := range m.MatchSeverity {
+		severities = append(severities, strings.Split(severity, ",")...)
+	}
+	return severities
+}
+
+// severities a notification matcher can match on
+var notificationSeverities = []string{"info", "notice", "warning", "error", "unknown"}
+
 func (r *PveGotifyTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_pve_gotify_target"
 }
 
 func (r *PveGotifyTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates a gotify notification target in your proxmox cluster.",
+		MarkdownDescription: "Creates a gotify notification target in your proxmox cluster together with a matcher routing notifications to it. Import with `<target_name>[/<matcher_name>]`, the token can't be read back and is set on the next apply.",
 
 		Attributes: map[string]schema.Attribute{
 			"gotify_host": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Gotify host to connect to (e.g. gotify.example.com), defaults to the host of the provider gotify block. Changes are applied in place.",
+			},
+			"gotify_token": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Gotify host to connect to (e.g. gotify.example.com).",
+				Sensitive:           true,
+				MarkdownDescription: "Gotify app token that proxmox uses when publishing notifications. Changes are applied in place.",
+			},
+			"target_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the notification target, defaults to `gotify-<stack_name>`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // changing host forces replace
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(), // pve can't rename targets
 				},
 			},
-			"gotify_token": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Gotify app token that proxmox uses when publishing notifications.",
+			"matcher_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the matcher routing to the target, defaults to `<target_name>-matcher`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // lazy replace
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Proxmox cloud gotify alerts."),
+				MarkdownDescription: "Comment of the notification target.",
+			},
+			"severities": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("error")})),
+				MarkdownDescription: "Severities the matcher routes to the target, one of info, notice, warning, error or unknown. Defaults to error only.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(notificationSeverities...)),
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
 	}
 }
 
@@ -90,47 +163,41 @@ func (r *PveGotifyTargetResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
-	createArgs := map[string]string{
-		"--name":    fmt.Sprintf("gotify-%s", r.cloudInventory.StackName),
-		"--server":  fmt.Sprintf("https://%s", data.GotifyHost.ValueString()),
-		"--token":   data.GotifyToken.ValueString(),
-		"--comment": "Proxmox cloud gotify alerts.",
-	}
-
-	// perform the request
-	cresp, err := client.CreateProxmoxApi(ctx, &pb.CreateProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: "/cluster/notifications/endpoints/gotify", CreateArgs: createArgs})
+	conn, err := resolveGotifyConn(r.cloudInventory.Gotify, GotifyProviderModel{Host: data.GotifyHost})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make create gotify api request, got error: %s", err))
+		resp.Diagnostics.AddError("Gotify Config Error", err.Error())
 		return
 	}
 
-	if !cresp.Success {
-		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error on server side making gotify create call, got error: %s", cresp.ErrMessage))
-		return
-	}
+	r.defaultNames(&data)
 
-	// create error matcher
-	createArgs = map[string]string{
-		"--name":           fmt.Sprintf("gotify-%s-matcher", r.cloudInventory.StackName),
-		"--target":         fmt.Sprintf("gotify-%s", r.cloudInventory.StackName),
-		"--match-severity": "error",
-	}
-	cresp, err = client.CreateProxmoxApi(ctx, &pb.CreateProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: "/cluster/notifications/matchers", CreateArgs: createArgs})
+	createArgs := data.endpointArgs(conn)
+	createArgs["--name"] = data.TargetName.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/endpoints/gotify", createArgs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make create matcher api request, got error: %s", err))
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating gotify target, got error: %s", err))
 		return
 	}
 
-	if !cresp.Success {
-		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error on server side making matcher create call, got error: %s", cresp.ErrMessage))
+	// create the matcher routing to the target
+	createArgs = data.matcherArgs()
+	createArgs["--name"] = data.MatcherName.ValueString()
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/matchers", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating gotify matcher, got error: %s", err))
 		return
 	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -145,44 +212,85 @@ func (r *PveGotifyTargetResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	// states from before the names were configurable
+	r.defaultNames(&data)
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var endpoints []PveGotifyEndpoint
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/endpoints/gotify", &endpoints)
+	idx := slices.IndexFunc(endpoints, func(e PveGotifyEndpoint) bool { return e.Name == data.TargetName.ValueString() })
+	if removeIfMissing(ctx, idx >= 0, err, "gotify target", resp) {
+		return
+	}
+
+	var matchers []PveNotificationMatcherSeverity
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/matchers", &matchers)
+	matcherIdx := slices.IndexFunc(matchers, func(m PveNotificationMatcherSeverity) bool { return m.Name == data.MatcherName.ValueString() })
+	if removeIfMissing(ctx, matcherIdx >= 0, err, "gotify matcher", resp) {
+		return
+	}
+
+	// the token can't be read back, pve doesn't return it
+	endpoint := endpoints[idx]
+	data.Comment = types.StringValue(endpoint.Comment)
+	if !data.GotifyHost.IsNull() {
+		data.GotifyHost = types.StringValue(strings.TrimPrefix(endpoint.Server, "https://"))
+	}
+
+	data.Severities = matchers[matcherIdx].severities()
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PveGotifyTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"This resource does not support in-place updates. Any change to these attributes "+
-			"should have triggered a replacement. This is a provider bug.",
-	)
+	var data PveGotifyTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// var data PveGotifyTargetResourceModel
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
 
-	// // Read Terraform plan data into the model
-	// resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
 
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
+	conn, err := resolveGotifyConn(r.cloudInventory.Gotify, GotifyProviderModel{Host: data.GotifyHost})
+	if err != nil {
+		resp.Diagnostics.AddError("Gotify Config Error", err.Error())
+		return
+	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	// names force a replacement, everything else is set in place
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/endpoints/gotify/%s", data.TargetName.ValueString()), data.endpointArgs(conn))
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating gotify target, got error: %s", err))
+		return
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/matchers/%s", data.MatcherName.ValueString()), data.matcherArgs())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating gotify matcher, got error: %s", err))
+		return
+	}
 
 	// Save updated data into Terraform state
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PveGotifyTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -195,14 +303,20 @@ func (r *PveGotifyTargetResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	// states from before the names were configurable
+	r.defaultNames(&data)
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
 	// delete the matcher first
-	cresp, err := client.DeleteProxmoxApi(ctx, &pb.DeleteProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/notifications/matchers/%s", fmt.Sprintf("gotify-%s-matcher", r.cloudInventory.StackName))})
+	cresp, err := client.DeleteProxmoxApi(ctx, &pb.DeleteProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/notifications/matchers/%s", data.MatcherName.ValueString())})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make delete matcher api request, got error: %s", err))
 		return
@@ -214,7 +328,7 @@ func (r *PveGotifyTargetResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// perform the request to delete gotify notification target
-	cresp, err = client.DeleteProxmoxApi(ctx, &pb.DeleteProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/notifications/endpoints/gotify/%s", fmt.Sprintf("gotify-%s", r.cloudInventory.StackName))})
+	cresp, err = client.DeleteProxmoxApi(ctx, &pb.DeleteProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/notifications/endpoints/gotify/%s", data.TargetName.ValueString())})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make delete gotify api request, got error: %s", err))
 		return
@@ -227,5 +341,42 @@ func (r *PveGotifyTargetResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *PveGotifyTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// matcher_name defaults like on create if only the target is given
+	targetName, matcherName, _ := strings.Cut(req.ID, "/")
+	if targetName == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format <target_name>[/<matcher_name>], got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_name"), targetName)...)
+	if matcherName != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("matcher_name"), matcherName)...)
+	}
+}
+
+// defaultNames fills in the target and matcher names if they weren't configured.
+func (r *PveGotifyTargetResource) defaultNames(data *PveGotifyTargetResourceModel) {
+	if data.TargetName.IsUnknown() || data.TargetName.IsNull() {
+		data.TargetName = types.StringValue(fmt.Sprintf("gotify-%s", r.cloudInventory.StackName))
+	}
+	if data.MatcherName.IsUnknown() || data.MatcherName.IsNull() {
+		data.MatcherName = types.StringValue(fmt.Sprintf("%s-matcher", data.TargetName.ValueString()))
+	}
+}
+
+// endpointArgs returns the pvesh args of the gotify endpoint without the name.
+func (data PveGotifyTargetResourceModel) endpointArgs(conn gotifyConn) map[string]string {
+	return map[string]string{
+		"--server":  conn.url(""),
+		"--token":   data.GotifyToken.ValueString(),
+		"--comment": data.Comment.ValueString(),
+	}
+}
+
+// matcherArgs returns the pvesh args of the matcher without the name.
+func (data PveGotifyTargetResourceModel) matcherArgs() map[string]string {
+	return map[string]string{
+		"--target":         data.TargetName.ValueString(),
+		"--match-severity": strings.Join(data.Severities, ","),
+	}
 }
@@ -7,20 +7,28 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PveGraphiteExporterResource{}
 var _ resource.ResourceWithImportState = &PveGraphiteExporterResource{}
+var _ resource.ResourceWithIdentity = &PveGraphiteExporterResource{}
 
 func NewPveGraphiteExporterResource() resource.Resource {
 	return &PveGraphiteExporterResource{}
@@ -36,6 +44,18 @@ type PveGraphiteExporterResourceModel struct {
 	ExporterName types.String `tfsdk:"exporter_name"`
 	Server       types.String `tfsdk:"server"`
 	Port         types.Int64  `tfsdk:"port"`
+	Proto        types.String `tfsdk:"proto"`
+	Mtu          types.Int64  `tfsdk:"mtu"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+	Disable      types.Bool   `tfsdk:"disable"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveGraphiteExporterIdentityModel describes the resource identity.
+type PveGraphiteExporterIdentityModel struct {
+	TargetPve    types.String `tfsdk:"target_pve"`
+	ExporterName types.String `tfsdk:"exporter_name"`
 }
 
 func (r *PveGraphiteExporterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,7 +64,7 @@ func (r *PveGraphiteExporterResource) Metadata(ctx context.Context, req resource
 
 func (r *PveGraphiteExporterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates a TCP graphite exporter in your proxmox cluster.",
+		MarkdownDescription: "Creates a graphite exporter in your proxmox cluster. All attributes except the name are updated in place. Import with the `<exporter_name>`.",
 
 		Attributes: map[string]schema.Attribute{
 			"exporter_name": schema.StringAttribute{
@@ -57,21 +77,67 @@ func (r *PveGraphiteExporterResource) Schema(ctx context.Context, req resource.S
 			"server": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "Server address where metrics will be send to.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(), // lazy replace
-				},
 			},
 			"port": schema.Int64Attribute{
 				Required:            true,
-				MarkdownDescription: "UDP port of the server.",
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(), // lazy replace
+				MarkdownDescription: "Port of the server.",
+			},
+			"proto": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("udp"),
+				MarkdownDescription: "Protocol used to send the metrics, udp or tcp.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("udp", "tcp"),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				// something weird going on with k8s nodeport udp, leaving this on the default 1500 causes pvestatd to crash
+				Default:             int64default.StaticInt64(1400),
+				MarkdownDescription: "MTU for udp metric transmission, defaults to 1400.",
+				Validators: []validator.Int64{
+					int64validator.Between(512, 65536),
 				},
 			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Timeout in seconds for tcp connections, pve uses 1 second if not set.",
+			},
+			"disable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Disables the exporter without deleting it.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveGraphiteExporterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"target_pve": targetPveIdentityAttribute,
+			"exporter_name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the exporter.",
+			},
 		},
 	}
 }
 
+func (r *PveGraphiteExporterResource) identity(data PveGraphiteExporterResourceModel) PveGraphiteExporterIdentityModel {
+	return PveGraphiteExporterIdentityModel{
+		TargetPve:    types.StringValue(r.cloudInventory.TargetPve),
+		ExporterName: data.ExporterName,
+	}
+}
+
 func (r *PveGraphiteExporterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -100,19 +166,17 @@ func (r *PveGraphiteExporterResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
 	}
 
-	createArgs := map[string]string{
-		"--server":  data.Server.ValueString(),
-		"--port":    strconv.FormatInt(int64(data.Port.ValueInt64()), 10),
-		"--type":    "graphite", // default is udp
-		// something weird going on with k8s nodeport udp, leaving this on the default 1500 causes pvestatd to crash
-		"--mtu": 		 "1400",
-	}
+	createArgs, _ := data.exporterArgs()
+	createArgs["--type"] = "graphite"
 
 	// perform the request
 	cresp, err := client.CreateProxmoxApi(ctx, &pb.CreateProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/metrics/server/graphite-%s", data.ExporterName.ValueString()), CreateArgs: createArgs})
@@ -128,6 +192,9 @@ func (r *PveGraphiteExporterResource) Create(ctx context.Context, req resource.C
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(data))...)
+	}
 }
 
 func (r *PveGraphiteExporterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -140,44 +207,85 @@ func (r *PveGraphiteExporterResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
-	//     return
-	// }
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/metrics/server", "id", fmt.Sprintf("graphite-%s", data.ExporterName.ValueString()))
+	if removeIfMissing(ctx, exists, err, "graphite exporter", resp) {
+		return
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/metrics/server/graphite-%s", data.ExporterName.ValueString()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graphite exporter config, got error: %s", err))
+		return
+	}
+
+	if server, ok := pveConfigString(config, "server"); ok {
+		data.Server = types.StringValue(server)
+	}
+	if port, ok := pveConfigInt(config, "port"); ok {
+		data.Port = types.Int64Value(port)
+	}
+	if proto, ok := pveConfigString(config, "proto"); ok {
+		data.Proto = types.StringValue(proto)
+	}
+	if mtu, ok := pveConfigInt(config, "mtu"); ok {
+		data.Mtu = types.Int64Value(mtu)
+	}
+	data.Timeout = types.Int64Null()
+	if timeout, ok := pveConfigInt(config, "timeout"); ok {
+		data.Timeout = types.Int64Value(timeout)
+	}
+	disable, _ := pveConfigInt(config, "disable")
+	data.Disable = types.BoolValue(disable == 1)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(data))...)
+	}
 }
 
 func (r *PveGraphiteExporterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"This resource does not support in-place updates. Any change to these attributes "+
-			"should have triggered a replacement. This is a provider bug.",
-	)
+	var data PveGraphiteExporterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// var data PveGraphiteExporterResourceModel
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
 
-	// // Read Terraform plan data into the model
-	// resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
 
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
+	setArgs, deletes := data.exporterArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/metrics/server/graphite-%s", data.ExporterName.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating graphite exporter, got error: %s", err))
+		return
+	}
 
 	// Save updated data into Terraform state
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PveGraphiteExporterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -190,7 +298,10 @@ func (r *PveGraphiteExporterResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
@@ -210,5 +321,31 @@ func (r *PveGraphiteExporterResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *PveGraphiteExporterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" {
+		var identity PveGraphiteExporterIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(checkIdentityTargetPve(identity.TargetPve, r.cloudInventory)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("exporter_name"), path.Root("exporter_name"), req, resp)
+}
+
+// exporterArgs returns the pvesh args of the exporter without the type, together
+// with the optional keys that are unset.
+func (data PveGraphiteExporterResourceModel) exporterArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--server":  data.Server.ValueString(),
+		"--port":    strconv.FormatInt(data.Port.ValueInt64(), 10),
+		"--proto":   data.Proto.ValueString(),
+		"--mtu":     strconv.FormatInt(data.Mtu.ValueInt64(), 10),
+		"--disable": boolToPve(data.Disable.ValueBool()),
+	}
+
+	if data.Timeout.IsNull() {
+		return args, []string{"timeout"}
+	}
+	args["--timeout"] = strconv.FormatInt(data.Timeout.ValueInt64(), 10)
+	return args, nil
 }
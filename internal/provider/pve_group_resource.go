@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveGroupResource{}
+var _ resource.ResourceWithImportState = &PveGroupResource{}
+
+func NewPveGroupResource() resource.Resource {
+	return &PveGroupResource{}
+}
+
+// PveGroupResource defines the resource implementation.
+type PveGroupResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveGroupResourceModel describes the resource data model.
+type PveGroupResourceModel struct {
+	GroupId types.String `tfsdk:"groupid"`
+	Comment types.String `tfsdk:"comment"`
+	Members []string     `tfsdk:"members"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveGroup is the subset of pvesh get /access/groups/{groupid} we need.
+type PveGroup struct {
+	Comment string   `json:"comment"`
+	Members []string `json:"members"`
+}
+
+func (r *PveGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_group"
+}
+
+func (r *PveGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a proxmox user group. Memberships are managed on the users (pxc_pve_user groups) or by realm syncs. Import with `<groupid>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"groupid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // groups cant be renamed
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the group.",
+			},
+			"members": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "User ids of the current members.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// readGroup refreshes the comment and the current members of the group.
+func (r *PveGroupResource) readGroup(ctx context.Context, data *PveGroupResourceModel) error {
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return err
+	}
+
+	var group PveGroup
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/groups/%s", data.GroupId.ValueString()), &group)
+	if err != nil {
+		return err
+	}
+
+	data.Comment = optionalString(group.Comment)
+	data.Members = sortedStrings(group.Members)
+	if data.Members == nil {
+		data.Members = []string{}
+	}
+	return nil
+}
+
+func (r *PveGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{"--groupid": data.GroupId.ValueString()}
+	if !data.Comment.IsNull() {
+		createArgs["--comment"] = data.Comment.ValueString()
+	}
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/access/groups", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating group, got error: %s", err))
+		return
+	}
+	data.Members = []string{}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/access/groups", "groupid", data.GroupId.ValueString())
+	if removeIfMissing(ctx, exists, err, "group", resp) {
+		return
+	}
+
+	if err := r.readGroup(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the set call has no delete parameter, an empty comment clears it
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/groups/%s", data.GroupId.ValueString()), map[string]string{
+		"--comment": data.Comment.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating group, got error: %s", err))
+		return
+	}
+
+	if err := r.readGroup(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/groups/%s", data.GroupId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting group, got error: %s", err))
+		return
+	}
+}
+
+func (r *PveGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("groupid"), req, resp)
+}
@@ -77,7 +77,7 @@ func (d *PveInventoryDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
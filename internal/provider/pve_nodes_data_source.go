@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PveNodesDataSource{}
+
+func NewPveNodesDataSource() datasource.DataSource {
+	return &PveNodesDataSource{}
+}
+
+// PveNodesDataSource defines the data source implementation.
+type PveNodesDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// PveNodesDataSourceModel describes the data source data model.
+type PveNodesDataSourceModel struct {
+	Nodes []PveNodeModel `tfsdk:"nodes"`
+}
+
+// PveNodeModel describes a single node of the cluster.
+type PveNodeModel struct {
+	Node              types.String  `tfsdk:"node"`
+	Status            types.String  `tfsdk:"status"`
+	Cpu               types.Float64 `tfsdk:"cpu"`
+	MaxCpu            types.Int64   `tfsdk:"maxcpu"`
+	Mem               types.Int64   `tfsdk:"mem"`
+	MaxMem            types.Int64   `tfsdk:"maxmem"`
+	Disk              types.Int64   `tfsdk:"disk"`
+	MaxDisk           types.Int64   `tfsdk:"maxdisk"`
+	Uptime            types.Int64   `tfsdk:"uptime"`
+	SubscriptionLevel types.String  `tfsdk:"subscription_level"`
+}
+
+// pveNode is an entry of /nodes, usage fields are missing for offline nodes.
+type pveNode struct {
+	Node    string  `json:"node"`
+	Status  string  `json:"status"`
+	Cpu     float64 `json:"cpu"`
+	MaxCpu  int64   `json:"maxcpu"`
+	Mem     int64   `json:"mem"`
+	MaxMem  int64   `json:"maxmem"`
+	Disk    int64   `json:"disk"`
+	MaxDisk int64   `json:"maxdisk"`
+	Uptime  int64   `json:"uptime"`
+	Level   string  `json:"level"`
+}
+
+func (d *PveNodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_nodes"
+}
+
+func (d *PveNodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the nodes of the target_pve cluster with their status and resource usage from `/nodes`, " +
+			"e.g. to place vms on the node with the most free memory.",
+
+		Attributes: map[string]schema.Attribute{
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Nodes of the cluster sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the node.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "`online`, `offline` or `unknown`. Usage values of nodes that aren't online are 0.",
+						},
+						"cpu": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Cpu utilization, between 0 and 1.",
+						},
+						"maxcpu": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of cpu threads.",
+						},
+						"mem": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Used memory in bytes.",
+						},
+						"maxmem": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Total memory in bytes.",
+						},
+						"disk": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Used space of the root filesystem in bytes.",
+						},
+						"maxdisk": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size of the root filesystem in bytes.",
+						},
+						"uptime": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Uptime in seconds.",
+						},
+						"subscription_level": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Subscription level, e.g. `c` for community, null without subscription.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PveNodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *PveNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PveNodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var nodes []pveNode
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, "/nodes", &nodes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list nodes, got error: %s", err))
+		return
+	}
+
+	slices.SortFunc(nodes, func(a, b pveNode) int {
+		return strings.Compare(a.Node, b.Node)
+	})
+
+	data.Nodes = []PveNodeModel{}
+	for _, node := range nodes {
+		data.Nodes = append(data.Nodes, PveNodeModel{
+			Node:              types.StringValue(node.Node),
+			Status:            types.StringValue(node.Status),
+			Cpu:               types.Float64Value(node.Cpu),
+			MaxCpu:            types.Int64Value(node.MaxCpu),
+			Mem:               types.Int64Value(node.Mem),
+			MaxMem:            types.Int64Value(node.MaxMem),
+			Disk:              types.Int64Value(node.Disk),
+			MaxDisk:           types.Int64Value(node.MaxDisk),
+			Uptime:            types.Int64Value(node.Uptime),
+			SubscriptionLevel: optionalString(node.Level),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
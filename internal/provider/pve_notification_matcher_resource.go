@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveNotificationMatcherResource{}
+var _ resource.ResourceWithImportState = &PveNotificationMatcherResource{}
+
+func NewPveNotificationMatcherResource() resource.Resource {
+	return &PveNotificationMatcherResource{}
+}
+
+// PveNotificationMatcherResource defines the resource implementation.
+type PveNotificationMatcherResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveNotificationMatcherResourceModel describes the resource data model.
+type PveNotificationMatcherResourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	Targets       []string     `tfsdk:"targets"`
+	MatchSeverity []string     `tfsdk:"match_severity"`
+	MatchCalendar []string     `tfsdk:"match_calendar"`
+	MatchField    []string     `tfsdk:"match_field"`
+	Mode          types.String `tfsdk:"mode"`
+	InvertMatch   types.Bool   `tfsdk:"invert_match"`
+	Comment       types.String `tfsdk:"comment"`
+	Disable       types.Bool   `tfsdk:"disable"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveNotificationMatcherEntry is an entry of pvesh get /cluster/notifications/matchers.
+type PveNotificationMatcherEntry struct {
+	Name          string          `json:"name"`
+	Target        json.RawMessage `json:"target"`
+	MatchSeverity json.RawMessage `json:"match-severity"`
+	MatchCalendar json.RawMessage `json:"match-calendar"`
+	MatchField    json.RawMessage `json:"match-field"`
+	Mode          string          `json:"mode"`
+	InvertMatch   pveBool         `json:"invert-match"`
+	Comment       string          `json:"comment"`
+	Disable       pveBool         `json:"disable"`
+}
+
+// pve match-field rule, exact:<field>=<value> or regex:<field>=<regex>
+var matchFieldRe = regexp.MustCompile(`^(exact|regex):[\w-]+=.*$`)
+
+func (r *PveNotificationMatcherResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_notification_matcher"
+}
+
+func (r *PveNotificationMatcherResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a notification matcher in your proxmox cluster that routes notifications to targets based on severity, calendar and field rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the matcher.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // pve can't rename matchers
+				},
+			},
+			"targets": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Notification targets that matching notifications are sent to.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"match_severity": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Severities to match, one of info, notice, warning, error or unknown.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(notificationSeverities...)),
+				},
+			},
+			"match_calendar": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Calendar events in systemd time format the notification timestamp has to match (e.g. `mon..fri 8-17`).",
+			},
+			"match_field": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Field rules in pve format `exact:<field>=<value>` or `regex:<field>=<regex>` (e.g. `exact:type=vzdump`).",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(matchFieldRe, "has to be exact:<field>=<value> or regex:<field>=<regex>")),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("all"),
+				MarkdownDescription: "Whether all or any of the match rules have to match.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "any"),
+				},
+			},
+			"invert_match": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Inverts the result of the match rules.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the matcher.",
+			},
+			"disable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Disables the matcher.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveNotificationMatcherResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *PveNotificationMatcherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveNotificationMatcherResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.matcherArgs()
+	createArgs["--name"] = data.Name.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/matchers", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating notification matcher, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveNotificationMatcherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveNotificationMatcherResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var matchers []PveNotificationMatcherEntry
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/matchers", &matchers)
+	idx := slices.IndexFunc(matchers, func(m PveNotificationMatcherEntry) bool { return m.Name == data.Name.ValueString() })
+	if removeIfMissing(ctx, idx >= 0, err, "notification matcher", resp) {
+		return
+	}
+
+	matcher := matchers[idx]
+	data.Targets = pveStringList(matcher.Target)
+	data.MatchSeverity = PveNotificationMatcherSeverity{MatchSeverity: pveStringList(matcher.MatchSeverity)}.severities()
+	data.MatchCalendar = pveStringList(matcher.MatchCalendar)
+	data.MatchField = pveStringList(matcher.MatchField)
+	data.InvertMatch = types.BoolValue(bool(matcher.InvertMatch))
+	data.Disable = types.BoolValue(bool(matcher.Disable))
+	data.Comment = optionalString(matcher.Comment)
+	if matcher.Mode != "" {
+		data.Mode = types.StringValue(matcher.Mode)
+	}
+
+	// keep unset lists null instead of empty
+	if len(data.MatchSeverity) == 0 {
+		data.MatchSeverity = nil
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveNotificationMatcherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveNotificationMatcherResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// unset rules have to be deleted explicitly
+	setArgs, deletes := data.matcherArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/matchers/%s", data.Name.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating notification matcher, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveNotificationMatcherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveNotificationMatcherResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/matchers/%s", data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting notification matcher, got error: %s", err))
+		return
+	}
+}
+
+func (r *PveNotificationMatcherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// matcherArgs returns the pvesh args of the matcher without the name, together
+// with the optional keys that are unset.
+func (data PveNotificationMatcherResourceModel) matcherArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--target":       strings.Join(data.Targets, ","),
+		"--mode":         data.Mode.ValueString(),
+		"--invert-match": boolToPve(data.InvertMatch.ValueBool()),
+		"--disable":      boolToPve(data.Disable.ValueBool()),
+	}
+	deletes := []string{}
+
+	optional := map[string][]string{
+		"match-severity": data.MatchSeverity,
+		"match-calendar": data.MatchCalendar,
+		"match-field":    data.MatchField,
+	}
+	if !data.Comment.IsNull() {
+		optional["comment"] = []string{data.Comment.ValueString()}
+	}
+
+	for _, key := range []string{"match-severity", "match-calendar", "match-field", "comment"} {
+		if len(optional[key]) == 0 {
+			deletes = append(deletes, key)
+			continue
+		}
+		args["--"+key] = strings.Join(optional[key], ",")
+	}
+
+	return args, deletes
+}
@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PvePoolMemberResource{}
+var _ resource.ResourceWithImportState = &PvePoolMemberResource{}
+
+func NewPvePoolMemberResource() resource.Resource {
+	return &PvePoolMemberResource{}
+}
+
+// PvePoolMemberResource defines the resource implementation.
+type PvePoolMemberResource struct {
+	cloudInventory CloudInventory
+}
+
+// PvePoolMemberResourceModel describes the resource data model.
+type PvePoolMemberResourceModel struct {
+	PoolId  types.String `tfsdk:"poolid"`
+	VmId    types.Int64  `tfsdk:"vmid"`
+	Storage types.String `tfsdk:"storage"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *PvePoolMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_pool_member"
+}
+
+func (r *PvePoolMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a single guest or storage to a resource pool, e.g. from the stack that creates the guest. " +
+			"Don't combine with the members attribute of pxc_pve_pool. Import with `<poolid>/<vmid|storage>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"poolid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Vmid of the qemu vm or lxc container to add. Exactly one of vmid or storage has to be set.",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("storage")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"storage": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Id of the storage to add.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PvePoolMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// pool gives access to the member helpers of the pool resource.
+func (r *PvePoolMemberResource) pool() *PvePoolResource {
+	return &PvePoolResource{cloudInventory: r.cloudInventory}
+}
+
+// member returns the member as vms and storages lists for setPoolMembers.
+func (data PvePoolMemberResourceModel) member() ([]int64, []string) {
+	if !data.VmId.IsNull() {
+		return []int64{data.VmId.ValueInt64()}, nil
+	}
+	return nil, []string{data.Storage.ValueString()}
+}
+
+func (r *PvePoolMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PvePoolMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	vms, storages := data.member()
+	err = r.pool().setPoolMembers(ctx, client, data.PoolId.ValueString(), vms, storages, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error adding pool member, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PvePoolMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PvePoolMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/pools", "poolid", data.PoolId.ValueString())
+	if removeIfMissing(ctx, exists, err, "pool", resp) {
+		return
+	}
+
+	pool, err := r.pool().getPool(ctx, client, data.PoolId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool, got error: %s", err))
+		return
+	}
+
+	// a guest is in at most one pool, deleted or moved guests drop the member
+	vms, storages := pool.currentMembers()
+	var isMember bool
+	if !data.VmId.IsNull() {
+		isMember = slices.Contains(vms, data.VmId.ValueInt64())
+	} else {
+		isMember = slices.Contains(storages, data.Storage.ValueString())
+	}
+	if removeIfMissing(ctx, isMember, nil, "pool member", resp) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PvePoolMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all attributes force a replacement
+	var data PvePoolMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PvePoolMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PvePoolMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	vms, storages := data.member()
+	err = r.pool().setPoolMembers(ctx, client, data.PoolId.ValueString(), vms, storages, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error removing pool member, got error: %s", err))
+		return
+	}
+}
+
+func (r *PvePoolMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	poolId, member, found := strings.Cut(req.ID, "/")
+	if !found || poolId == "" || member == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format <poolid>/<vmid|storage>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("poolid"), poolId)...)
+	// numeric members are guests, storage ids can't be numeric only
+	if vmid, err := strconv.ParseInt(member, 10, 64); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmid)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storage"), member)...)
+	}
+}
@@ -0,0 +1,414 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PvePoolResource{}
+var _ resource.ResourceWithImportState = &PvePoolResource{}
+
+func NewPvePoolResource() resource.Resource {
+	return &PvePoolResource{}
+}
+
+// PvePoolResource defines the resource implementation.
+type PvePoolResource struct {
+	cloudInventory CloudInventory
+}
+
+// PvePoolResourceModel describes the resource data model.
+type PvePoolResourceModel struct {
+	PoolId  types.String         `tfsdk:"poolid"`
+	Comment types.String         `tfsdk:"comment"`
+	Members *PvePoolMembersModel `tfsdk:"members"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PvePoolMembersModel describes the declared pool membership.
+type PvePoolMembersModel struct {
+	Vms      []int64  `tfsdk:"vms"`
+	Storages []string `tfsdk:"storages"`
+}
+
+// PvePool is the subset of pvesh get /pools/{poolid} we need.
+type PvePool struct {
+	Comment string `json:"comment"`
+	Members []struct {
+		Type    string `json:"type"`
+		VmId    int64  `json:"vmid"`
+		Storage string `json:"storage"`
+	} `json:"members"`
+}
+
+func (r *PvePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_pool"
+}
+
+func (r *PvePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a proxmox resource pool. If members are declared the pool membership is reconciled in place, guests and storages not listed are removed from the pool.",
+
+		Attributes: map[string]schema.Attribute{
+			"poolid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique id of the pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // pools cant be renamed
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Pool description.",
+			},
+			"members": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Authoritative membership of the pool, leave unset to not manage members. Don't combine with pxc_pve_pool_member.",
+				Attributes: map[string]schema.Attribute{
+					"vms": schema.SetAttribute{
+						ElementType:         types.Int64Type,
+						Optional:            true,
+						MarkdownDescription: "Vmids of qemu vms and lxc containers in the pool.",
+					},
+					"storages": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "Storage ids in the pool.",
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PvePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *PvePoolResource) getPool(ctx context.Context, client pb.CloudServiceClient, poolId string) (*PvePool, error) {
+	cresp, err := client.GetProxmoxApi(ctx, &pb.GetProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/pools/%s", poolId)})
+	if err != nil {
+		return nil, err
+	}
+
+	var pool PvePool
+	err = json.Unmarshal([]byte(cresp.JsonResp), &pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+// currentMembers splits the pool members into vmids and storage ids.
+func (pool *PvePool) currentMembers() ([]int64, []string) {
+	vms := []int64{}
+	storages := []string{}
+	for _, member := range pool.Members {
+		if member.Type == "storage" {
+			storages = append(storages, member.Storage)
+		} else {
+			vms = append(vms, member.VmId)
+		}
+	}
+	return vms, storages
+}
+
+// reconcileMembers adds and removes pool members so the pool matches the declared members exactly.
+func (r *PvePoolResource) reconcileMembers(ctx context.Context, client pb.CloudServiceClient, poolId string, members *PvePoolMembersModel) error {
+	pool, err := r.getPool(ctx, client, poolId)
+	if err != nil {
+		return fmt.Errorf("unable to get pool, got error: %s", err)
+	}
+
+	currentVms, currentStorages := pool.currentMembers()
+
+	addVms, removeVms := diffMembers(members.Vms, currentVms)
+	addStorages, removeStorages := diffMembers(members.Storages, currentStorages)
+
+	// removal first, otherwise moving a guest between pools could fail
+	if len(removeVms) > 0 || len(removeStorages) > 0 {
+		err = r.setPoolMembers(ctx, client, poolId, removeVms, removeStorages, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(addVms) > 0 || len(addStorages) > 0 {
+		err = r.setPoolMembers(ctx, client, poolId, addVms, addStorages, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PvePoolResource) setPoolMembers(ctx context.Context, client pb.CloudServiceClient, poolId string, vms []int64, storages []string, remove bool) error {
+	setArgs := map[string]string{}
+
+	if len(vms) > 0 {
+		vmIds := []string{}
+		for _, vm := range vms {
+			vmIds = append(vmIds, strconv.FormatInt(vm, 10))
+		}
+		setArgs["--vms"] = strings.Join(vmIds, ",")
+	}
+	if len(storages) > 0 {
+		setArgs["--storage"] = strings.Join(storages, ",")
+	}
+	if remove {
+		setArgs["--delete"] = "1"
+	}
+
+	cresp, err := client.SetProxmoxApi(ctx, &pb.SetProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/pools/%s", poolId), SetArgs: setArgs})
+	if err != nil {
+		return fmt.Errorf("unable make set pool members api request, got error: %s", err)
+	}
+
+	if !cresp.Success {
+		return fmt.Errorf("error on server side setting pool members, got error: %s", cresp.ErrMessage)
+	}
+
+	return nil
+}
+
+// diffMembers returns the elements missing in current and the ones that are not desired anymore.
+func diffMembers[T comparable](desired []T, current []T) ([]T, []T) {
+	currentSet := map[T]bool{}
+	for _, c := range current {
+		currentSet[c] = true
+	}
+	desiredSet := map[T]bool{}
+	for _, d := range desired {
+		desiredSet[d] = true
+	}
+
+	add := []T{}
+	for _, d := range desired {
+		if !currentSet[d] {
+			add = append(add, d)
+		}
+	}
+	remove := []T{}
+	for _, c := range current {
+		if !desiredSet[c] {
+			remove = append(remove, c)
+		}
+	}
+
+	return add, remove
+}
+
+func (r *PvePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PvePoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{
+		"--poolid": data.PoolId.ValueString(),
+	}
+	if !data.Comment.IsNull() {
+		createArgs["--comment"] = data.Comment.ValueString()
+	}
+
+	cresp, err := client.CreateProxmoxApi(ctx, &pb.CreateProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: "/pools", CreateArgs: createArgs})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make create pool api request, got error: %s", err))
+		return
+	}
+
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error on server side making pool create call, got error: %s", cresp.ErrMessage))
+		return
+	}
+
+	if data.Members != nil {
+		err = r.reconcileMembers(ctx, client, data.PoolId.ValueString(), data.Members)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reconcile pool members, got error: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PvePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PvePoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	pool, err := r.getPool(ctx, client, data.PoolId.ValueString())
+	if removeIfMissing(ctx, err == nil, err, "pool", resp) {
+		return
+	}
+
+	if pool.Comment != "" || !data.Comment.IsNull() {
+		data.Comment = types.StringValue(pool.Comment)
+	}
+
+	// only refresh members if they are managed, keeps the null / empty distinction of the config
+	if data.Members != nil {
+		vms, storages := pool.currentMembers()
+		if len(vms) > 0 || data.Members.Vms != nil {
+			data.Members.Vms = vms
+		}
+		if len(storages) > 0 || data.Members.Storages != nil {
+			data.Members.Storages = storages
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PvePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PvePoolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.SetProxmoxApi(ctx, &pb.SetProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/pools/%s", data.PoolId.ValueString()), SetArgs: map[string]string{"--comment": data.Comment.ValueString()}})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make set pool api request, got error: %s", err))
+		return
+	}
+
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error on server side making pool set call, got error: %s", cresp.ErrMessage))
+		return
+	}
+
+	// membership is diffed against the live pool, no replace needed
+	if data.Members != nil {
+		err = r.reconcileMembers(ctx, client, data.PoolId.ValueString(), data.Members)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reconcile pool members, got error: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PvePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PvePoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to delete pools that still have members
+	if data.Members != nil {
+		err = r.reconcileMembers(ctx, client, data.PoolId.ValueString(), &PvePoolMembersModel{})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove pool members, got error: %s", err))
+			return
+		}
+	}
+
+	cresp, err := client.DeleteProxmoxApi(ctx, &pb.DeleteProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/pools/%s", data.PoolId.ValueString())})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make delete pool api request, got error: %s", err))
+		return
+	}
+
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error on server side making delete pool call, got error: %s", cresp.ErrMessage))
+		return
+	}
+}
+
+func (r *PvePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("poolid"), req, resp)
+}
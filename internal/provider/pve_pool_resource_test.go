@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakePool serves /pools/{poolid} of a single pool, pvesh set applies the membership changes.
+func fakePool(t *testing.T, vms []int64, storages []string) *fakeRpcConn {
+	return &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+		"GetProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			if req.(*pb.GetProxmoxApiRequest).ApiPath != "/pools/web" {
+				return nil, status.Error(codes.NotFound, "pool does not exist")
+			}
+
+			members := []map[string]any{}
+			for _, vm := range vms {
+				members = append(members, map[string]any{"type": "qemu", "vmid": vm})
+			}
+			for _, storage := range storages {
+				members = append(members, map[string]any{"type": "storage", "storage": storage})
+			}
+			poolJson, _ := json.Marshal(map[string]any{"comment": "", "members": members})
+			return &pb.GetProxmoxApiResponse{JsonResp: string(poolJson)}, nil
+		},
+		"SetProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			args := req.(*pb.SetProxmoxApiRequest).SetArgs
+			remove := args["--delete"] == "1"
+			if args["--vms"] != "" {
+				for _, vmId := range strings.Split(args["--vms"], ",") {
+					vm, err := strconv.ParseInt(vmId, 10, 64)
+					if err != nil {
+						t.Fatalf("invalid vmid %s", vmId)
+					}
+					if remove {
+						vms = slices.DeleteFunc(vms, func(v int64) bool { return v == vm })
+					} else {
+						vms = append(vms, vm)
+					}
+				}
+			}
+			if args["--storage"] != "" {
+				for _, storage := range strings.Split(args["--storage"], ",") {
+					if remove {
+						storages = slices.DeleteFunc(storages, func(s string) bool { return s == storage })
+					} else {
+						storages = append(storages, storage)
+					}
+				}
+			}
+			return &pb.SetProxmoxApiResponse{Success: true}, nil
+		},
+	}}
+}
+
+func TestPvePoolReconcileMembers(t *testing.T) {
+	tests := []struct {
+		name         string
+		vms          []int64
+		storages     []string
+		members      PvePoolMembersModel
+		wantSetCalls int
+	}{
+		{name: "add", vms: []int64{}, storages: []string{}, members: PvePoolMembersModel{Vms: []int64{100, 101}, Storages: []string{"local"}}, wantSetCalls: 1},
+		{name: "remove", vms: []int64{100, 101}, storages: []string{"local"}, members: PvePoolMembersModel{Vms: []int64{100}}, wantSetCalls: 1},
+		{name: "add and remove", vms: []int64{100}, storages: []string{"local"}, members: PvePoolMembersModel{Vms: []int64{101}, Storages: []string{"local", "ceph"}}, wantSetCalls: 2},
+		{name: "in sync", vms: []int64{100}, storages: []string{"local"}, members: PvePoolMembersModel{Vms: []int64{100}, Storages: []string{"local"}}, wantSetCalls: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := fakePool(t, tt.vms, tt.storages)
+			r := &PvePoolResource{cloudInventory: testInventory(conn)}
+			client, _ := GetCloudRpcService(context.Background(), r.cloudInventory)
+
+			if err := r.reconcileMembers(context.Background(), client, "web", &tt.members); err != nil {
+				t.Fatalf("reconcileMembers() error = %s", err)
+			}
+			if calls := len(conn.called("SetProxmoxApi")); calls != tt.wantSetCalls {
+				t.Errorf("first reconcile made %d set calls, want %d", calls, tt.wantSetCalls)
+			}
+
+			// a second run finds the pool in sync
+			if err := r.reconcileMembers(context.Background(), client, "web", &tt.members); err != nil {
+				t.Fatalf("reconcileMembers() error = %s", err)
+			}
+			if calls := len(conn.called("SetProxmoxApi")); calls != tt.wantSetCalls {
+				t.Errorf("second reconcile made %d set calls, want none", calls-tt.wantSetCalls)
+			}
+
+			pool, err := r.getPool(context.Background(), client, "web")
+			if err != nil {
+				t.Fatalf("getPool() error = %s", err)
+			}
+			vms, storages := pool.currentMembers()
+			slices.Sort(vms)
+			slices.Sort(storages)
+			wantVms := slices.Sorted(slices.Values(tt.members.Vms))
+			wantStorages := slices.Sorted(slices.Values(tt.members.Storages))
+			if !slices.Equal(vms, wantVms) || !slices.Equal(storages, wantStorages) {
+				t.Errorf("members = %v %v, want %v %v", vms, storages, wantVms, wantStorages)
+			}
+		})
+	}
+}
+
+func TestPvePoolReadRemovesMissing(t *testing.T) {
+	tests := []struct {
+		name        string
+		poolId      string
+		wantRemoved bool
+	}{
+		{name: "exists", poolId: "web", wantRemoved: false},
+		{name: "missing", poolId: "gone", wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PvePoolResource{cloudInventory: testInventory(fakePool(t, []int64{100}, nil))}
+			state := readResource(t, r, map[string]tftypes.Value{"poolid": tftypes.NewValue(tftypes.String, tt.poolId)})
+
+			if removed := state.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Errorf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
@@ -0,0 +1,723 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveRealmResource{}
+var _ resource.ResourceWithImportState = &PveRealmResource{}
+
+// realm types managed by pxc_pve_realm, pam and pve are built in
+var realmTypes = []string{"ldap", "ad", "openid"}
+
+func NewPveRealmResource() resource.Resource {
+	return &PveRealmResource{}
+}
+
+// PveRealmResource defines the resource implementation.
+type PveRealmResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveRealmResourceModel describes the resource data model.
+type PveRealmResourceModel struct {
+	Realm       types.String           `tfsdk:"realm"`
+	Type        types.String           `tfsdk:"type"`
+	Comment     types.String           `tfsdk:"comment"`
+	Default     types.Bool             `tfsdk:"default"`
+	SyncOptions *RealmSyncOptionsModel `tfsdk:"sync_options"`
+
+	Ldap   *RealmLdapModel   `tfsdk:"ldap"`
+	Ad     *RealmAdModel     `tfsdk:"ad"`
+	OpenId *RealmOpenIdModel `tfsdk:"openid"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// RealmSyncOptionsModel describes the defaults of realm syncs (sync-defaults-options).
+type RealmSyncOptionsModel struct {
+	Scope          types.String `tfsdk:"scope"`
+	EnableNew      types.Bool   `tfsdk:"enable_new"`
+	RemoveVanished []string     `tfsdk:"remove_vanished"`
+}
+
+// RealmLdapModel describes a ldap realm.
+type RealmLdapModel struct {
+	Server1        types.String         `tfsdk:"server1"`
+	Server2        types.String         `tfsdk:"server2"`
+	Port           types.Int64          `tfsdk:"port"`
+	Mode           types.String         `tfsdk:"mode"`
+	Verify         types.Bool           `tfsdk:"verify"`
+	BaseDn         types.String         `tfsdk:"base_dn"`
+	UserAttr       types.String         `tfsdk:"user_attr"`
+	BindDn         types.String         `tfsdk:"bind_dn"`
+	Password       types.String         `tfsdk:"password"`
+	PasswordSecret *CloudSecretRefModel `tfsdk:"password_secret"`
+	Filter         types.String         `tfsdk:"filter"`
+	GroupDn        types.String         `tfsdk:"group_dn"`
+	GroupFilter    types.String         `tfsdk:"group_filter"`
+	GroupNameAttr  types.String         `tfsdk:"group_name_attr"`
+}
+
+// RealmAdModel describes an active directory realm.
+type RealmAdModel struct {
+	Server1        types.String         `tfsdk:"server1"`
+	Server2        types.String         `tfsdk:"server2"`
+	Port           types.Int64          `tfsdk:"port"`
+	Mode           types.String         `tfsdk:"mode"`
+	Verify         types.Bool           `tfsdk:"verify"`
+	Domain         types.String         `tfsdk:"domain"`
+	BaseDn         types.String         `tfsdk:"base_dn"`
+	BindDn         types.String         `tfsdk:"bind_dn"`
+	Password       types.String         `tfsdk:"password"`
+	PasswordSecret *CloudSecretRefModel `tfsdk:"password_secret"`
+	Filter         types.String         `tfsdk:"filter"`
+	GroupFilter    types.String         `tfsdk:"group_filter"`
+	GroupNameAttr  types.String         `tfsdk:"group_name_attr"`
+}
+
+// RealmOpenIdModel describes an openid connect realm.
+type RealmOpenIdModel struct {
+	IssuerUrl        types.String         `tfsdk:"issuer_url"`
+	ClientId         types.String         `tfsdk:"client_id"`
+	ClientKey        types.String         `tfsdk:"client_key"`
+	ClientKeySecret  *CloudSecretRefModel `tfsdk:"client_key_secret"`
+	UsernameClaim    types.String         `tfsdk:"username_claim"`
+	Autocreate       types.Bool           `tfsdk:"autocreate"`
+	Scopes           types.String         `tfsdk:"scopes"`
+	Prompt           types.String         `tfsdk:"prompt"`
+	GroupsClaim      types.String         `tfsdk:"groups_claim"`
+	GroupsAutocreate types.Bool           `tfsdk:"groups_autocreate"`
+}
+
+func (r *PveRealmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_realm"
+}
+
+func (r *PveRealmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// exactly one of the type blocks has to be set
+	typeBlock := func(name string, description string, attributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+		others := []path.Expression{}
+		for _, realmType := range realmTypes {
+			if realmType != name {
+				others = append(others, path.MatchRoot(realmType))
+			}
+		}
+		return schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: description,
+			Attributes:          attributes,
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(others...),
+			},
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+				}, "Changing the realm type recreates the realm.", "Changing the realm type recreates the realm."),
+			},
+		}
+	}
+	optional := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: description,
+		}
+	}
+	// attributes shared by ldap and ad
+	directory := func(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+		attributes["server1"] = schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Address of the directory server.",
+		}
+		attributes["server2"] = optional("Address of the fallback directory server.")
+		attributes["port"] = schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Port of the servers, the default port of the mode if not set.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+		}
+		attributes["mode"] = schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("ldap"),
+			MarkdownDescription: "Connection mode, one of ldap, ldaps or ldap+starttls.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("ldap", "ldaps", "ldap+starttls"),
+			},
+		}
+		attributes["verify"] = schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			MarkdownDescription: "Whether the server certificate is verified for ldaps and starttls.",
+		}
+		attributes["bind_dn"] = optional("Dn of the user used for lookups and syncs, anonymous binds if not set.")
+		attributes["password"] = schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			MarkdownDescription: "Password of the bind user.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password_secret")),
+			},
+		}
+		attributes["password_secret"] = cloudSecretRefAttribute("Cloud secret holding the password of the bind user.")
+		attributes["filter"] = optional("Ldap filter for the synced users.")
+		attributes["group_filter"] = optional("Ldap filter for the synced groups.")
+		attributes["group_name_attr"] = optional("Attribute holding the name of synced groups.")
+		return attributes
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an authentication realm (`/access/domains`) to federate the users of the proxmox web interface. " +
+			"The type is selected by setting exactly one of ldap, ad or openid. Import with `<realm>`, passwords and client keys can't be read back.",
+
+		Attributes: map[string]schema.Attribute{
+			"realm": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the realm, the suffix of the user ids, e.g. `corp` for `jane@corp`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Realm type, derived from the type attribute that is set.",
+			},
+			"comment": optional("Comment of the realm, shown on the login form."),
+			"default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the realm is preselected on the login form.",
+			},
+			"sync_options": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Defaults of realm syncs, only for ldap and ad realms.",
+				Attributes: map[string]schema.Attribute{
+					"scope": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "What is synced, one of users, groups or both.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("users", "groups", "both"),
+						},
+					},
+					"enable_new": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether newly synced users are enabled.",
+					},
+					"remove_vanished": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "What is removed for users and groups no longer in the directory, any of acl, entry and properties.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf("acl", "entry", "properties")),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("openid")),
+				},
+			},
+			"ldap": typeBlock("ldap", "Ldap directory.", directory(map[string]schema.Attribute{
+				"base_dn": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Base dn of the users, e.g. `ou=people,dc=example,dc=com`.",
+				},
+				"user_attr": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Attribute holding the user name, e.g. `uid`. Changing it recreates the realm.",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"group_dn": optional("Base dn of the groups."),
+			})),
+			"ad": typeBlock("ad", "Active directory.", directory(map[string]schema.Attribute{
+				"domain": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Active directory domain, e.g. `corp.example.com`.",
+				},
+				"base_dn": optional("Base dn of the users and groups for syncs."),
+			})),
+			"openid": typeBlock("openid", "OpenID Connect provider, e.g. keycloak.", map[string]schema.Attribute{
+				"issuer_url": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Issuer url of the provider.",
+				},
+				"client_id": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Client id registered at the provider.",
+				},
+				"client_key": schema.StringAttribute{
+					Optional:            true,
+					Sensitive:           true,
+					MarkdownDescription: "Client secret, not needed for public clients.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("client_key_secret")),
+					},
+				},
+				"client_key_secret": cloudSecretRefAttribute("Cloud secret holding the client secret."),
+				"username_claim": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Claim the user name is taken from, e.g. `preferred_username`, the subject if not set. Changing it recreates the realm.",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"autocreate": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "Whether users are created on their first login.",
+				},
+				"scopes":       optional("Space separated scopes requested, `email profile` if not set."),
+				"prompt":       optional("Prompt sent to the provider, e.g. `login` or `consent`."),
+				"groups_claim": optional("Claim the groups of the user are taken from."),
+				"groups_autocreate": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "Whether groups of the groups claim are created on login.",
+				},
+			}),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveRealmResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// realmType returns the type of the set type attribute.
+func (data PveRealmResourceModel) realmType() string {
+	switch {
+	case data.Ldap != nil:
+		return "ldap"
+	case data.Ad != nil:
+		return "ad"
+	case data.OpenId != nil:
+		return "openid"
+	}
+	return ""
+}
+
+// syncSpec builds the sync-defaults-options property string.
+func (options *RealmSyncOptionsModel) syncSpec() string {
+	parts := []string{}
+	if !options.Scope.IsNull() {
+		parts = append(parts, "scope="+options.Scope.ValueString())
+	}
+	if !options.EnableNew.IsNull() {
+		parts = append(parts, "enable-new="+boolToPve(options.EnableNew.ValueBool()))
+	}
+	if len(options.RemoveVanished) > 0 {
+		parts = append(parts, "remove-vanished="+strings.Join(sortedStrings(options.RemoveVanished), ";"))
+	}
+	return strings.Join(parts, ",")
+}
+
+// realmArgs returns the pvesh args of the realm, together with the optional keys that are unset.
+// Fixed args can only be passed on creation.
+func (r *PveRealmResource) realmArgs(ctx context.Context, client pb.CloudServiceClient, data PveRealmResourceModel) (map[string]string, map[string]string, []string, error) {
+	args := map[string]string{
+		"--default": boolToPve(data.Default.ValueBool()),
+	}
+	fixed := map[string]string{}
+	optional := map[string]string{}
+	optionalKeys := []string{"comment"}
+
+	setOptional := func(key string, value types.String) {
+		optionalKeys = append(optionalKeys, key)
+		if !value.IsNull() {
+			optional[key] = value.ValueString()
+		}
+	}
+	setPassword := func(key string, value types.String, ref *CloudSecretRefModel) error {
+		optionalKeys = append(optionalKeys, key)
+		if !value.IsNull() {
+			optional[key] = value.ValueString()
+		}
+		if ref != nil {
+			password, err := resolveCloudSecretRef(ctx, client, r.cloudInventory, ref)
+			if err != nil {
+				return err
+			}
+			optional[key] = password
+		}
+		return nil
+	}
+	setPort := func(port types.Int64) {
+		optionalKeys = append(optionalKeys, "port")
+		if !port.IsNull() {
+			optional["port"] = strconv.FormatInt(port.ValueInt64(), 10)
+		}
+	}
+
+	if !data.Comment.IsNull() {
+		optional["comment"] = data.Comment.ValueString()
+	}
+
+	switch {
+	case data.Ldap != nil:
+		args["--server1"] = data.Ldap.Server1.ValueString()
+		args["--base_dn"] = data.Ldap.BaseDn.ValueString()
+		args["--mode"] = data.Ldap.Mode.ValueString()
+		args["--verify"] = boolToPve(data.Ldap.Verify.ValueBool())
+		fixed["--user_attr"] = data.Ldap.UserAttr.ValueString()
+		setOptional("server2", data.Ldap.Server2)
+		setPort(data.Ldap.Port)
+		setOptional("bind_dn", data.Ldap.BindDn)
+		if err := setPassword("password", data.Ldap.Password, data.Ldap.PasswordSecret); err != nil {
+			return nil, nil, nil, err
+		}
+		setOptional("filter", data.Ldap.Filter)
+		setOptional("group_dn", data.Ldap.GroupDn)
+		setOptional("group_filter", data.Ldap.GroupFilter)
+		setOptional("group_name_attr", data.Ldap.GroupNameAttr)
+	case data.Ad != nil:
+		args["--server1"] = data.Ad.Server1.ValueString()
+		args["--domain"] = data.Ad.Domain.ValueString()
+		args["--mode"] = data.Ad.Mode.ValueString()
+		args["--verify"] = boolToPve(data.Ad.Verify.ValueBool())
+		setOptional("server2", data.Ad.Server2)
+		setPort(data.Ad.Port)
+		setOptional("base_dn", data.Ad.BaseDn)
+		setOptional("bind_dn", data.Ad.BindDn)
+		if err := setPassword("password", data.Ad.Password, data.Ad.PasswordSecret); err != nil {
+			return nil, nil, nil, err
+		}
+		setOptional("filter", data.Ad.Filter)
+		setOptional("group_filter", data.Ad.GroupFilter)
+		setOptional("group_name_attr", data.Ad.GroupNameAttr)
+	case data.OpenId != nil:
+		args["--issuer-url"] = data.OpenId.IssuerUrl.ValueString()
+		args["--client-id"] = data.OpenId.ClientId.ValueString()
+		args["--autocreate"] = boolToPve(data.OpenId.Autocreate.ValueBool())
+		args["--groups-autocreate"] = boolToPve(data.OpenId.GroupsAutocreate.ValueBool())
+		if !data.OpenId.UsernameClaim.IsNull() {
+			fixed["--username-claim"] = data.OpenId.UsernameClaim.ValueString()
+		}
+		if err := setPassword("client-key", data.OpenId.ClientKey, data.OpenId.ClientKeySecret); err != nil {
+			return nil, nil, nil, err
+		}
+		setOptional("scopes", data.OpenId.Scopes)
+		setOptional("prompt", data.OpenId.Prompt)
+		setOptional("groups-claim", data.OpenId.GroupsClaim)
+	}
+
+	if data.OpenId == nil {
+		optionalKeys = append(optionalKeys, "sync-defaults-options")
+		if data.SyncOptions != nil && data.SyncOptions.syncSpec() != "" {
+			optional["sync-defaults-options"] = data.SyncOptions.syncSpec()
+		}
+	}
+
+	deletes := []string{}
+	for _, key := range optionalKeys {
+		if value, ok := optional[key]; ok {
+			args["--"+key] = value
+		} else {
+			deletes = append(deletes, key)
+		}
+	}
+
+	return args, fixed, deletes, nil
+}
+
+// readRealm takes the managed values of the realm config over into the model.
+func (data *PveRealmResourceModel) readRealm(config map[string]interface{}) {
+	value := func(key string) string {
+		configValue, _ := pveConfigString(config, key)
+		return configValue
+	}
+	flag := func(key string) types.Bool {
+		return types.BoolValue(value(key) == "1")
+	}
+	port := func() types.Int64 {
+		if port, ok := pveConfigInt(config, "port"); ok {
+			return types.Int64Value(port)
+		}
+		return types.Int64Null()
+	}
+	mode := func() types.String {
+		// older realms only have the secure flag
+		if mode := value("mode"); mode != "" {
+			return types.StringValue(mode)
+		}
+		if value("secure") == "1" {
+			return types.StringValue("ldaps")
+		}
+		return types.StringValue("ldap")
+	}
+
+	data.Type = types.StringValue(value("type"))
+	data.Comment = optionalString(value("comment"))
+	data.Default = flag("default")
+
+	if spec := value("sync-defaults-options"); spec != "" {
+		_, props := parsePveProps(spec)
+		options := &RealmSyncOptionsModel{
+			Scope:     optionalString(props["scope"]),
+			EnableNew: types.BoolNull(),
+		}
+		if enableNew, ok := props["enable-new"]; ok {
+			options.EnableNew = types.BoolValue(enableNew == "1")
+		}
+		if removeVanished := splitTags(props["remove-vanished"]); len(removeVanished) > 0 {
+			options.RemoveVanished = removeVanished
+		}
+		data.SyncOptions = options
+	} else {
+		data.SyncOptions = nil
+	}
+
+	// secrets are kept in /etc/pve/priv and not returned, they stay as configured
+	switch value("type") {
+	case "ldap":
+		ldap := &RealmLdapModel{Password: types.StringNull()}
+		if data.Ldap != nil {
+			ldap.Password, ldap.PasswordSecret = data.Ldap.Password, data.Ldap.PasswordSecret
+		}
+		ldap.Server1 = types.StringValue(value("server1"))
+		ldap.Server2 = optionalString(value("server2"))
+		ldap.Port = port()
+		ldap.Mode = mode()
+		ldap.Verify = flag("verify")
+		ldap.BaseDn = types.StringValue(value("base_dn"))
+		ldap.UserAttr = types.StringValue(value("user_attr"))
+		ldap.BindDn = optionalString(value("bind_dn"))
+		ldap.Filter = optionalString(value("filter"))
+		ldap.GroupDn = optionalString(value("group_dn"))
+		ldap.GroupFilter = optionalString(value("group_filter"))
+		ldap.GroupNameAttr = optionalString(value("group_name_attr"))
+		data.Ldap = ldap
+	case "ad":
+		ad := &RealmAdModel{Password: types.StringNull()}
+		if data.Ad != nil {
+			ad.Password, ad.PasswordSecret = data.Ad.Password, data.Ad.PasswordSecret
+		}
+		ad.Server1 = types.StringValue(value("server1"))
+		ad.Server2 = optionalString(value("server2"))
+		ad.Port = port()
+		ad.Mode = mode()
+		ad.Verify = flag("verify")
+		ad.Domain = types.StringValue(value("domain"))
+		ad.BaseDn = optionalString(value("base_dn"))
+		ad.BindDn = optionalString(value("bind_dn"))
+		ad.Filter = optionalString(value("filter"))
+		ad.GroupFilter = optionalString(value("group_filter"))
+		ad.GroupNameAttr = optionalString(value("group_name_attr"))
+		data.Ad = ad
+	case "openid":
+		openId := &RealmOpenIdModel{ClientKey: types.StringNull()}
+		if data.OpenId != nil {
+			openId.ClientKey, openId.ClientKeySecret = data.OpenId.ClientKey, data.OpenId.ClientKeySecret
+		}
+		openId.IssuerUrl = types.StringValue(value("issuer-url"))
+		openId.ClientId = types.StringValue(value("client-id"))
+		openId.UsernameClaim = optionalString(value("username-claim"))
+		openId.Autocreate = flag("autocreate")
+		openId.Scopes = optionalString(value("scopes"))
+		openId.Prompt = optionalString(value("prompt"))
+		openId.GroupsClaim = optionalString(value("groups-claim"))
+		openId.GroupsAutocreate = flag("groups-autocreate")
+		data.OpenId = openId
+	}
+}
+
+func (r *PveRealmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveRealmResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, fixed, _, err := r.realmArgs(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve secret, got error: %s", err))
+		return
+	}
+	for key, value := range fixed {
+		createArgs[key] = value
+	}
+	createArgs["--realm"] = data.Realm.ValueString()
+	createArgs["--type"] = data.realmType()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/access/domains", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating realm, got error: %s", err))
+		return
+	}
+	data.Type = types.StringValue(data.realmType())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveRealmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveRealmResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/access/domains", "realm", data.Realm.ValueString())
+	if removeIfMissing(ctx, exists, err, "realm", resp) {
+		return
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/domains/%s", data.Realm.ValueString()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read realm, got error: %s", err))
+		return
+	}
+
+	realmType, _ := pveConfigString(config, "type")
+	if !slices.Contains(realmTypes, realmType) {
+		resp.Diagnostics.AddError("Unsupported Realm Type", fmt.Sprintf("Realm %s is of type %s, which is not managed by pxc_pve_realm.", data.Realm.ValueString(), realmType))
+		return
+	}
+	data.readRealm(config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveRealmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveRealmResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// fixed args force a replacement, unset optional values have to be deleted explicitly
+	setArgs, _, deletes, err := r.realmArgs(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve secret, got error: %s", err))
+		return
+	}
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/domains/%s", data.Realm.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating realm, got error: %s", err))
+		return
+	}
+	data.Type = types.StringValue(data.realmType())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveRealmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveRealmResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// users of the realm are kept, they just can't log in anymore
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/domains/%s", data.Realm.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting realm, got error: %s", err))
+		return
+	}
+}
+
+func (r *PveRealmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("realm"), req, resp)
+}
@@ -0,0 +1,426 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveSmtpTargetResource{}
+var _ resource.ResourceWithImportState = &PveSmtpTargetResource{}
+
+func NewPveSmtpTargetResource() resource.Resource {
+	return &PveSmtpTargetResource{}
+}
+
+// PveSmtpTargetResource defines the resource implementation.
+type PveSmtpTargetResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveSmtpTargetResourceModel describes the resource data model.
+type PveSmtpTargetResourceModel struct {
+	TargetName  types.String `tfsdk:"target_name"`
+	MatcherName types.String `tfsdk:"matcher_name"`
+	Server      types.String `tfsdk:"server"`
+	Port        types.Int64  `tfsdk:"port"`
+	Mode        types.String `tfsdk:"mode"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	FromAddress types.String `tfsdk:"from_address"`
+	Mailto      []string     `tfsdk:"mailto"`
+	MailtoUser  []string     `tfsdk:"mailto_user"`
+	Author      types.String `tfsdk:"author"`
+	Comment     types.String `tfsdk:"comment"`
+	Severities  []string     `tfsdk:"severities"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveSmtpEndpoint is an entry of pvesh get /cluster/notifications/endpoints/smtp.
+type PveSmtpEndpoint struct {
+	Name        string      `json:"name"`
+	Server      string      `json:"server"`
+	Port        json.Number `json:"port"`
+	Mode        string      `json:"mode"`
+	Username    string      `json:"username"`
+	FromAddress string      `json:"from-address"`
+	Mailto      []string    `json:"mailto"`
+	MailtoUser  []string    `json:"mailto-user"`
+	Author      string      `json:"author"`
+	Comment     string      `json:"comment"`
+}
+
+func (r *PveSmtpTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_smtp_target"
+}
+
+func (r *PveSmtpTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a smtp notification target in your proxmox cluster together with a matcher routing notifications to it.",
+
+		Attributes: map[string]schema.Attribute{
+			"target_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the notification target.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // pve can't rename targets
+				},
+			},
+			"matcher_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the matcher routing to the target, defaults to `<target_name>-matcher`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Address of the smtp relay.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Port of the smtp relay, pve picks the default of the mode if not set.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("tls"),
+				MarkdownDescription: "TLS mode, one of insecure, starttls or tls.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("insecure", "starttls", "tls"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username for smtp authentication.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password for smtp authentication.",
+			},
+			"from_address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Sender address of the notification mails.",
+			},
+			"mailto": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Recipient mail addresses.",
+				Validators: []validator.List{
+					listvalidator.AtLeastOneOf(path.MatchRoot("mailto_user")),
+				},
+			},
+			"mailto_user": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Recipient pve users (e.g. root@pam), their configured mail address is used.",
+			},
+			"author": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Author of the mails, pve uses `Proxmox VE` if not set.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Proxmox cloud smtp alerts."),
+				MarkdownDescription: "Comment of the notification target.",
+			},
+			"severities": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("error")})),
+				MarkdownDescription: "Severities the matcher routes to the target, one of info, notice, warning, error or unknown. Defaults to error only.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(notificationSeverities...)),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveSmtpTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *PveSmtpTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveSmtpTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	if data.MatcherName.IsUnknown() {
+		data.MatcherName = types.StringValue(fmt.Sprintf("%s-matcher", data.TargetName.ValueString()))
+	}
+
+	createArgs, _ := data.endpointArgs()
+	createArgs["--name"] = data.TargetName.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/endpoints/smtp", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating smtp target, got error: %s", err))
+		return
+	}
+
+	// create the matcher routing to the target
+	createArgs = map[string]string{
+		"--name":           data.MatcherName.ValueString(),
+		"--target":         data.TargetName.ValueString(),
+		"--match-severity": strings.Join(data.Severities, ","),
+	}
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/matchers", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating smtp matcher, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveSmtpTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveSmtpTargetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var endpoints []PveSmtpEndpoint
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/endpoints/smtp", &endpoints)
+	idx := slices.IndexFunc(endpoints, func(e PveSmtpEndpoint) bool { return e.Name == data.TargetName.ValueString() })
+	if removeIfMissing(ctx, idx >= 0, err, "smtp target", resp) {
+		return
+	}
+
+	var matchers []PveNotificationMatcherSeverity
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/notifications/matchers", &matchers)
+	matcherIdx := slices.IndexFunc(matchers, func(m PveNotificationMatcherSeverity) bool { return m.Name == data.MatcherName.ValueString() })
+	if removeIfMissing(ctx, matcherIdx >= 0, err, "smtp matcher", resp) {
+		return
+	}
+
+	// the password can't be read back, pve doesn't return it
+	endpoint := endpoints[idx]
+	data.Server = types.StringValue(endpoint.Server)
+	data.FromAddress = types.StringValue(endpoint.FromAddress)
+	data.Comment = types.StringValue(endpoint.Comment)
+	if endpoint.Mode != "" {
+		data.Mode = types.StringValue(endpoint.Mode)
+	}
+	data.Username = optionalString(endpoint.Username)
+	data.Author = optionalString(endpoint.Author)
+
+	data.Port = types.Int64Null()
+	if port, err := strconv.ParseInt(endpoint.Port.String(), 10, 64); err == nil {
+		data.Port = types.Int64Value(port)
+	}
+
+	if len(endpoint.Mailto) > 0 || data.Mailto != nil {
+		data.Mailto = endpoint.Mailto
+	}
+	if len(endpoint.MailtoUser) > 0 || data.MailtoUser != nil {
+		data.MailtoUser = endpoint.MailtoUser
+	}
+
+	data.Severities = matchers[matcherIdx].severities()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveSmtpTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveSmtpTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// unset optional values have to be deleted explicitly
+	setArgs, deletes := data.endpointArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	// names force a replacement, everything else is set in place
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/endpoints/smtp/%s", data.TargetName.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating smtp target, got error: %s", err))
+		return
+	}
+
+	setArgs = map[string]string{
+		"--target":         data.TargetName.ValueString(),
+		"--match-severity": strings.Join(data.Severities, ","),
+	}
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/matchers/%s", data.MatcherName.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating smtp matcher, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveSmtpTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveSmtpTargetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// delete the matcher first, it references the target
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/matchers/%s", data.MatcherName.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting smtp matcher, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/notifications/endpoints/smtp/%s", data.TargetName.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting smtp target, got error: %s", err))
+		return
+	}
+}
+
+func (r *PveSmtpTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("target_name"), req, resp)
+}
+
+// endpointArgs returns the pvesh args of the smtp endpoint without the name,
+// together with the optional keys that are unset.
+func (data PveSmtpTargetResourceModel) endpointArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--server":       data.Server.ValueString(),
+		"--mode":         data.Mode.ValueString(),
+		"--from-address": data.FromAddress.ValueString(),
+		"--comment":      data.Comment.ValueString(),
+	}
+	deletes := []string{}
+
+	optional := map[string]string{}
+	if !data.Port.IsNull() {
+		optional["port"] = strconv.FormatInt(data.Port.ValueInt64(), 10)
+	}
+	if !data.Username.IsNull() {
+		optional["username"] = data.Username.ValueString()
+	}
+	if !data.Password.IsNull() {
+		optional["password"] = data.Password.ValueString()
+	}
+	if !data.Author.IsNull() {
+		optional["author"] = data.Author.ValueString()
+	}
+	if len(data.Mailto) > 0 {
+		optional["mailto"] = strings.Join(data.Mailto, ",")
+	}
+	if len(data.MailtoUser) > 0 {
+		optional["mailto-user"] = strings.Join(data.MailtoUser, ",")
+	}
+
+	for _, key := range []string{"port", "username", "password", "author", "mailto", "mailto-user"} {
+		value, ok := optional[key]
+		if !ok {
+			deletes = append(deletes, key)
+			continue
+		}
+		args["--"+key] = value
+	}
+
+	return args, deletes
+}
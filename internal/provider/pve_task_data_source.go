@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PveTaskDataSource{}
+
+func NewPveTaskDataSource() datasource.DataSource {
+	return &PveTaskDataSource{}
+}
+
+// PveTaskDataSource defines the data source implementation.
+type PveTaskDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// PveTaskDataSourceModel describes the data source data model.
+type PveTaskDataSourceModel struct {
+	Upid       types.String `tfsdk:"upid"`
+	LogLines   types.Int64  `tfsdk:"log_lines"`
+	Node       types.String `tfsdk:"node"`
+	Type       types.String `tfsdk:"type"`
+	User       types.String `tfsdk:"user"`
+	StartTime  types.Int64  `tfsdk:"start_time"`
+	Status     types.String `tfsdk:"status"`
+	ExitStatus types.String `tfsdk:"exit_status"`
+	LogTail    types.String `tfsdk:"log_tail"`
+}
+
+// PveTaskStatus is the subset of pvesh get /nodes/{node}/tasks/{upid}/status we expose.
+type PveTaskStatus struct {
+	Type       string `json:"type"`
+	User       string `json:"user"`
+	StartTime  int64  `json:"starttime"`
+	Status     string `json:"status"`
+	ExitStatus string `json:"exitstatus"`
+}
+
+func (d *PveTaskDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_task"
+}
+
+func (d *PveTaskDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the state of a proxmox task by its UPID, e.g. one started through the pxc_pve_api_call action.",
+
+		Attributes: map[string]schema.Attribute{
+			"upid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "UPID of the task.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pveUpidRe, "must be a proxmox UPID"),
+				},
+			},
+			"log_lines": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of task log lines returned in log_tail, defaults to 20.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"node": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Node the task runs on.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the task, e.g. qmstart.",
+			},
+			"user": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "User that started the task.",
+			},
+			"start_time": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unix timestamp the task was started at.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Either running or stopped.",
+			},
+			"exit_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "OK if the task succeeded, otherwise the error message. Empty while the task is running.",
+			},
+			"log_tail": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last lines of the task log.",
+			},
+		},
+	}
+}
+
+func (d *PveTaskDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *PveTaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PveTaskDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// UPID:<node>:<pid>:<pstart>:<starttime>:<type>:<id>:<user>:
+	upid := data.Upid.ValueString()
+	node := strings.Split(upid, ":")[1]
+
+	var status PveTaskStatus
+	err = getPveApiJson(ctx, client, d.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/tasks/%s/status", node, url.PathEscape(upid)), &status)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read task status, got error: %s", err))
+		return
+	}
+
+	logLines := int64(20)
+	if !data.LogLines.IsNull() {
+		logLines = data.LogLines.ValueInt64()
+	}
+
+	// a zero timeout returns the current state without waiting
+	cresp, err := client.WaitForTask(ctx, &pb.WaitForTaskRequest{TargetPve: d.cloudInventory.TargetPve, Upid: upid, TimeoutSeconds: 0, LogLines: logLines})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read task log, got error: %s", err))
+		return
+	}
+
+	data.Node = types.StringValue(node)
+	data.Type = types.StringValue(status.Type)
+	data.User = types.StringValue(status.User)
+	data.StartTime = types.Int64Value(status.StartTime)
+	data.Status = types.StringValue(status.Status)
+	data.ExitStatus = types.StringValue(status.ExitStatus)
+	data.LogTail = types.StringValue(cresp.LogTail)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
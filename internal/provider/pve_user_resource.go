@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PveUserResource{}
+var _ resource.ResourceWithImportState = &PveUserResource{}
+
+// proxmox user ids, <name>@<realm>
+var pveUserIdRe = regexp.MustCompile(`^[^\s:/@]+@[A-Za-z][A-Za-z0-9.\-_]+$`)
+
+func NewPveUserResource() resource.Resource {
+	return &PveUserResource{}
+}
+
+// PveUserResource defines the resource implementation.
+type PveUserResource struct {
+	cloudInventory CloudInventory
+}
+
+// PveUserResourceModel describes the resource data model.
+type PveUserResourceModel struct {
+	UserId  types.String `tfsdk:"userid"`
+	Realm   types.String `tfsdk:"realm"`
+	Comment types.String `tfsdk:"comment"`
+	Email   types.String `tfsdk:"email"`
+	Groups  []string     `tfsdk:"groups"`
+	Enable  types.Bool   `tfsdk:"enable"`
+	Expire  types.Int64  `tfsdk:"expire"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveUser is the subset of pvesh get /access/users/{userid} we manage.
+type PveUser struct {
+	Comment string          `json:"comment"`
+	Email   string          `json:"email"`
+	Groups  json.RawMessage `json:"groups"`
+	Enable  pveBool         `json:"enable"`
+	Expire  int64           `json:"expire"`
+}
+
+func (r *PveUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pve_user"
+}
+
+func (r *PveUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a proxmox user, e.g. the automation users of csi, ccm or monitoring. " +
+			"Passwords are not managed, authenticate with api tokens or realm (pam, ldap) credentials instead. Import with `<userid>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"userid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the user including the realm, e.g. `csi@pve`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pveUserIdRe, "must be <name>@<realm>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // users cant be renamed
+				},
+			},
+			"realm": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Authentication realm of the user, part of the userid.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the user.",
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Email address of the user, receives notifications of mail targets.",
+			},
+			"groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Groups the user is member of, memberships not listed are removed.",
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the user can log in and use its tokens.",
+			},
+			"expire": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Expiration as unix timestamp, 0 never expires.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *PveUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// userArgs returns the pvesh args of the user without the userid. The set call has no
+// delete parameter, unset values are cleared by passing them empty.
+func (data PveUserResourceModel) userArgs() map[string]string {
+	return map[string]string{
+		"--comment": data.Comment.ValueString(),
+		"--email":   data.Email.ValueString(),
+		"--groups":  strings.Join(sortedStrings(data.Groups), ","),
+		"--enable":  boolToPve(data.Enable.ValueBool()),
+		"--expire":  strconv.FormatInt(data.Expire.ValueInt64(), 10),
+	}
+}
+
+// pveUserRealm returns the realm part of a userid.
+func pveUserRealm(userId string) string {
+	_, realm, _ := strings.Cut(userId, "@")
+	return realm
+}
+
+func (r *PveUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PveUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := data.userArgs()
+	createArgs["--userid"] = data.UserId.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/access/users", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating user, got error: %s", err))
+		return
+	}
+
+	data.Realm = types.StringValue(pveUserRealm(data.UserId.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PveUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/access/users", "userid", data.UserId.ValueString())
+	if removeIfMissing(ctx, exists, err, "user", resp) {
+		return
+	}
+
+	var user PveUser
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/users/%s", data.UserId.ValueString()), &user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	data.Realm = types.StringValue(pveUserRealm(data.UserId.ValueString()))
+	data.Comment = optionalString(user.Comment)
+	data.Email = optionalString(user.Email)
+	data.Enable = types.BoolValue(bool(user.Enable))
+	data.Expire = types.Int64Value(user.Expire)
+
+	// groups are returned as list or comma separated string depending on the pve version
+	groups := []string{}
+	for _, value := range pveStringList(user.Groups) {
+		groups = append(groups, splitPveList(value)...)
+	}
+	if len(groups) > 0 || data.Groups != nil {
+		data.Groups = groups
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PveUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/users/%s", data.UserId.ValueString()), data.userArgs())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating user, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PveUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PveUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// deleting the user also removes its tokens and acl entries
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/access/users/%s", data.UserId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting user, got error: %s", err))
+		return
+	}
+}
+
+func (r *PveUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("userid"), req, resp)
+}
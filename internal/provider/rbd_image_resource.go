@@ -0,0 +1,327 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RbdImageResource{}
+var _ resource.ResourceWithImportState = &RbdImageResource{}
+
+// bytes per GiB, the unit of image sizes
+const rbdGiB = 1 << 30
+
+func NewRbdImageResource() resource.Resource {
+	return &RbdImageResource{}
+}
+
+// RbdImageResource defines the resource implementation.
+type RbdImageResource struct {
+	cloudInventory CloudInventory
+}
+
+// RbdImageResourceModel describes the resource data model.
+type RbdImageResourceModel struct {
+	Pool     types.String `tfsdk:"pool"`
+	Name     types.String `tfsdk:"name"`
+	Size     types.Int64  `tfsdk:"size"`
+	Features types.Set    `tfsdk:"features"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// spec returns the rbd image spec <pool>/<name>.
+func (data RbdImageResourceModel) spec() string {
+	return data.Pool.ValueString() + "/" + data.Name.ValueString()
+}
+
+// rbdImageInfo is the json output of rbd info.
+type rbdImageInfo struct {
+	Size     int64    `json:"size"`
+	Features []string `json:"features"`
+}
+
+func (r *RbdImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbd_image"
+}
+
+func (r *RbdImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ceph rbd image, for volumes that are consumed outside of the kubernetes csi driver, e.g. statically provisioned persistent volumes. " +
+			"Deleting the resource deletes the image with all its data. Import with `<pool>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"pool": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Rbd pool of the image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the image.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cephClientNameRe, "must start with a letter or digit and only contain letters, digits, ., - and _"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Size of the image in GiB. The image can only grow.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"features": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				MarkdownDescription: "Image features, e.g. `layering`, `exclusive-lock`, `object-map`, `fast-diff` and `deep-flatten`. " +
+					"The defaults of the cluster if not set. Only exclusive-lock, object-map, fast-diff and journaling can be changed later, " +
+					"krbd of older kernels can't map images with object-map, fast-diff or journaling.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *RbdImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *RbdImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RbdImageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	args := []string{"create", data.spec(), "--size", fmt.Sprintf("%dG", data.Size.ValueInt64())}
+	if !data.Features.IsUnknown() {
+		var features []string
+		resp.Diagnostics.Append(data.Features.ElementsAs(ctx, &features, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		args = append(args, "--image-feature", strings.Join(sortedStrings(features), ","))
+	}
+
+	err = rbdCommand(ctx, client, r.cloudInventory.TargetPve, nil, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating rbd image, got error: %s", err))
+		return
+	}
+
+	var info rbdImageInfo
+	err = rbdCommand(ctx, client, r.cloudInventory.TargetPve, &info, "info", data.spec())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rbd image, got error: %s", err))
+		return
+	}
+	features, diags := types.SetValueFrom(ctx, types.StringType, info.Features)
+	resp.Diagnostics.Append(diags...)
+	data.Features = features
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RbdImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RbdImageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	var info rbdImageInfo
+	err = rbdCommand(ctx, client, r.cloudInventory.TargetPve, &info, "info", data.spec())
+	if removeIfMissing(ctx, err == nil, err, "rbd image", resp) {
+		return
+	}
+
+	// sizes that are no multiple of a GiB are rounded down, so they get grown to the configured size
+	data.Size = types.Int64Value(info.Size / rbdGiB)
+	features, diags := types.SetValueFrom(ctx, types.StringType, info.Features)
+	resp.Diagnostics.Append(diags...)
+	data.Features = features
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RbdImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RbdImageResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Size.ValueInt64() < state.Size.ValueInt64() {
+		resp.Diagnostics.AddError("Unsupported Change", "Shrinking rbd images is not supported.")
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	if data.Size.ValueInt64() > state.Size.ValueInt64() {
+		err = rbdCommand(ctx, client, r.cloudInventory.TargetPve, nil, "resize", data.spec(), "--size", fmt.Sprintf("%dG", data.Size.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error resizing rbd image, got error: %s", err))
+			return
+		}
+	}
+
+	var features, stateFeatures []string
+	resp.Diagnostics.Append(data.Features.ElementsAs(ctx, &features, false)...)
+	resp.Diagnostics.Append(state.Features.ElementsAs(ctx, &stateFeatures, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var enable, disable []string
+	for _, feature := range features {
+		if !slices.Contains(stateFeatures, feature) {
+			enable = append(enable, feature)
+		}
+	}
+	for _, feature := range stateFeatures {
+		if !slices.Contains(features, feature) {
+			disable = append(disable, feature)
+		}
+	}
+
+	// rbd orders the features of one call by their dependencies, e.g. exclusive-lock before object-map
+	if len(disable) > 0 {
+		err = rbdCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"feature", "disable", data.spec()}, disable...)...)
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error disabling rbd image features, got error: %s", err))
+			return
+		}
+	}
+	if len(enable) > 0 {
+		err = rbdCommand(ctx, client, r.cloudInventory.TargetPve, nil, append([]string{"feature", "enable", data.spec()}, enable...)...)
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error enabling rbd image features, got error: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RbdImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RbdImageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// fails while the image is still mapped somewhere
+	err = rbdCommand(ctx, client, r.cloudInventory.TargetPve, nil, "rm", data.spec())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting rbd image, got error: %s", err))
+		return
+	}
+}
+
+func (r *RbdImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pool, name, found := strings.Cut(req.ID, "/")
+	if !found || pool == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <pool>/<name>, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool"), pool)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
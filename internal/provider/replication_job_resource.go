@@ -0,0 +1,344 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReplicationJobResource{}
+var _ resource.ResourceWithImportState = &ReplicationJobResource{}
+
+func NewReplicationJobResource() resource.Resource {
+	return &ReplicationJobResource{}
+}
+
+// ReplicationJobResource defines the resource implementation.
+type ReplicationJobResource struct {
+	cloudInventory CloudInventory
+}
+
+// ReplicationJobResourceModel describes the resource data model.
+type ReplicationJobResourceModel struct {
+	Id        types.String  `tfsdk:"id"`
+	VmId      types.Int64   `tfsdk:"vmid"`
+	JobNumber types.Int64   `tfsdk:"job_number"`
+	Target    types.String  `tfsdk:"target"`
+	Schedule  types.String  `tfsdk:"schedule"`
+	Rate      types.Float64 `tfsdk:"rate"`
+	Comment   types.String  `tfsdk:"comment"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// ReplicationJob is the subset of pvesh get /cluster/replication/{id} we manage.
+type ReplicationJob struct {
+	Target   string   `json:"target"`
+	Schedule string   `json:"schedule"`
+	Rate     *float64 `json:"rate"`
+	Comment  string   `json:"comment"`
+	Disable  pveBool  `json:"disable"`
+}
+
+func (r *ReplicationJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_job"
+}
+
+func (r *ReplicationJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a storage replication job (`/cluster/replication`) that replicates the zfs volumes of a guest to another node, " +
+			"e.g. for fast ha failover without shared storage. Import with `<vmid>-<job_number>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id of the job, `<vmid>-<job_number>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Guest whose volumes are replicated, all of them have to be on zfs storage.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"job_number": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Number of the job, to replicate a guest to multiple targets.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Node the volumes are replicated to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("*/15"),
+				MarkdownDescription: "Proxmox calendar event schedule of the replication, e.g. `*/5` for every five minutes.",
+			},
+			"rate": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Bandwidth limit in MB/s, unlimited if not set.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(1),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment of the job.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the job is scheduled, disabled jobs keep the replicated volumes.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *ReplicationJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// jobId returns the `<vmid>-<job_number>` id of the job.
+func (data ReplicationJobResourceModel) jobId() string {
+	return fmt.Sprintf("%d-%d", data.VmId.ValueInt64(), data.JobNumber.ValueInt64())
+}
+
+// jobArgs returns the pvesh args of the job without id and target, together with the
+// optional keys that are unset.
+func (data ReplicationJobResourceModel) jobArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--schedule": data.Schedule.ValueString(),
+		"--disable":  boolToPve(!data.Enabled.ValueBool()),
+	}
+	deletes := []string{}
+
+	if !data.Rate.IsNull() {
+		args["--rate"] = strconv.FormatFloat(data.Rate.ValueFloat64(), 'f', -1, 64)
+	} else {
+		deletes = append(deletes, "rate")
+	}
+	if !data.Comment.IsNull() {
+		args["--comment"] = data.Comment.ValueString()
+	} else {
+		deletes = append(deletes, "comment")
+	}
+
+	return args, deletes
+}
+
+func (r *ReplicationJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReplicationJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.jobArgs()
+	createArgs["--id"] = data.jobId()
+	createArgs["--target"] = data.Target.ValueString()
+	createArgs["--type"] = "local"
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/replication", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating replication job, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(data.jobId())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReplicationJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReplicationJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// jobs marked for removal stay listed until the next replication run removed them
+	var jobs []map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/replication", &jobs)
+	exists := false
+	for _, job := range jobs {
+		id, _ := pveConfigString(job, "id")
+		removeJob, _ := pveConfigString(job, "remove_job")
+		exists = exists || (id == data.Id.ValueString() && removeJob == "")
+	}
+	if removeIfMissing(ctx, exists, err, "replication job", resp) {
+		return
+	}
+
+	var job ReplicationJob
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, "/cluster/replication/"+data.Id.ValueString(), &job)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read replication job, got error: %s", err))
+		return
+	}
+
+	data.Target = types.StringValue(job.Target)
+	// jobs without schedule run with the default
+	data.Schedule = types.StringValue(job.Schedule)
+	if job.Schedule == "" {
+		data.Schedule = types.StringValue("*/15")
+	}
+	data.Rate = types.Float64PointerValue(job.Rate)
+	data.Comment = optionalString(job.Comment)
+	data.Enabled = types.BoolValue(!bool(job.Disable))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReplicationJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReplicationJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs, deletes := data.jobArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, "/cluster/replication/"+data.Id.ValueString(), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating replication job, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReplicationJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReplicationJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// only marks the job for removal, the next replication run deletes the replicated volumes on the target
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, "/cluster/replication/"+data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting replication job, got error: %s", err))
+		return
+	}
+}
+
+func (r *ReplicationJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmIdStr, jobNumberStr, found := strings.Cut(req.ID, "-")
+	vmId, vmIdErr := strconv.ParseInt(vmIdStr, 10, 64)
+	jobNumber, jobNumberErr := strconv.ParseInt(jobNumberStr, 10, 64)
+	if !found || vmIdErr != nil || jobNumberErr != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <vmid>-<job_number>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("job_number"), jobNumber)...)
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// targetPveIdentityAttribute is the identity attribute scoping a resource to its proxmox cluster.
+var targetPveIdentityAttribute = identityschema.StringAttribute{
+	RequiredForImport: true,
+	Description:       "Target proxmox cluster of the resource, has to match the target_pve of the provider.",
+}
+
+// checkIdentityTargetPve fails imports by identity that point to another cluster than the provider is configured for.
+func checkIdentityTargetPve(targetPve types.String, cloudInventory CloudInventory) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if targetPve.ValueString() != cloudInventory.TargetPve {
+		diags.AddError("Invalid Import Identity", fmt.Sprintf("Identity target_pve %s doesn't match the provider target_pve %s", targetPve.ValueString(), cloudInventory.TargetPve))
+	}
+	return diags
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// removeIfMissing handles the result of an existence check inside Read. It
+// adds an error diagnostic if the check failed and removes the resource from
+// state if the remote object is gone (also if the backend reported NOT_FOUND),
+// returning true in both cases so the caller can stop.
+func removeIfMissing(ctx context.Context, exists bool, err error, name string, resp *resource.ReadResponse) bool {
+	if err != nil && rpcErrorCode(err) != pb.ErrorCode_NOT_FOUND {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", name, err))
+		return true
+	}
+
+	if err != nil || !exists {
+		tflog.Warn(ctx, fmt.Sprintf("%s no longer exists, removing from state", name))
+		resp.State.RemoveResource(ctx)
+		return true
+	}
+
+	return false
+}
+
+// jsonEqual checks if two json documents are semantically equal, ignoring
+// formatting and key order. Invalid json is compared as plain string.
+func jsonEqual(a string, b string) bool {
+	var aObj, bObj interface{}
+	if json.Unmarshal([]byte(a), &aObj) != nil || json.Unmarshal([]byte(b), &bObj) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(aObj, bObj)
+}
+
+// optionalString maps an empty backend value to null.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
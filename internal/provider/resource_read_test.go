@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCloudSecretAgeResourceReadExists(t *testing.T) {
+	tests := []struct {
+		name        string
+		found       bool
+		err         error
+		wantRemoved bool
+	}{
+		{name: "exists", found: true},
+		{name: "missing", found: false, wantRemoved: true},
+		{name: "not found error", err: status.Error(codes.NotFound, "secret does not exist"), wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+				"GetCloudSecretByName": func(req proto.Message) (proto.Message, error) {
+					return &pb.GetCloudSecretByNameResponse{Found: tt.found, SecretType: "age"}, tt.err
+				},
+			}}
+			r := &CloudSecretAgeResource{cloudInventory: testInventory(conn)}
+
+			state := readResource(t, r, map[string]tftypes.Value{"secret_name": tftypes.NewValue(tftypes.String, "db")})
+			if removed := state.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Errorf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestPveGotifyTargetResourceReadExists(t *testing.T) {
+	endpoints := `[{"name": "gotify-web", "server": "https://gotify.example.com", "comment": ""}]`
+	matchers := `[{"name": "gotify-web-matcher", "match-severity": ["error"]}]`
+
+	tests := []struct {
+		name        string
+		responses   map[string]string
+		wantRemoved bool
+	}{
+		{
+			name:      "exists",
+			responses: map[string]string{"/cluster/notifications/endpoints/gotify": endpoints, "/cluster/notifications/matchers": matchers},
+		},
+		{
+			name:        "endpoint missing",
+			responses:   map[string]string{"/cluster/notifications/endpoints/gotify": `[]`, "/cluster/notifications/matchers": matchers},
+			wantRemoved: true,
+		},
+		{
+			name:        "matcher missing",
+			responses:   map[string]string{"/cluster/notifications/endpoints/gotify": endpoints, "/cluster/notifications/matchers": `[]`},
+			wantRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := testInventory(fakePveApi(tt.responses))
+			inv.StackName = "web"
+			r := &PveGotifyTargetResource{cloudInventory: inv}
+
+			state := readResource(t, r, map[string]tftypes.Value{})
+			if removed := state.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Errorf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestPveGraphiteExporterResourceReadExists(t *testing.T) {
+	tests := []struct {
+		name        string
+		responses   map[string]string
+		wantRemoved bool
+	}{
+		{
+			name: "exists",
+			responses: map[string]string{
+				"/cluster/metrics/server":              `[{"id": "graphite-web", "type": "graphite"}]`,
+				"/cluster/metrics/server/graphite-web": `{"server": "graphite.example.com", "port": 2003, "proto": "udp"}`,
+			},
+		},
+		{
+			name:        "missing",
+			responses:   map[string]string{"/cluster/metrics/server": `[{"id": "influx-web", "type": "influxdb"}]`},
+			wantRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PveGraphiteExporterResource{cloudInventory: testInventory(fakePveApi(tt.responses))}
+
+			state := readResource(t, r, map[string]tftypes.Value{"exporter_name": tftypes.NewValue(tftypes.String, "web")})
+			if removed := state.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Errorf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestGotifyAppResourceReadExists(t *testing.T) {
+	tests := []struct {
+		name        string
+		apps        []GotifyAppResponse
+		wantRemoved bool
+	}{
+		{name: "exists", apps: []GotifyAppResponse{{Id: 1, Name: "other"}, {Id: 7, Name: "pve", AppToken: "token"}}},
+		{name: "missing", apps: []GotifyAppResponse{{Id: 1, Name: "other"}}, wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/application" {
+					http.NotFound(w, req)
+					return
+				}
+				json.NewEncoder(w).Encode(tt.apps)
+			}))
+			defer server.Close()
+
+			r := &GotifyAppResource{cloudInventory: testInventory(&fakeRpcConn{})}
+			state := readResource(t, r, map[string]tftypes.Value{
+				"gotify_host":    tftypes.NewValue(tftypes.String, strings.TrimPrefix(server.URL, "https://")),
+				"allow_insecure": tftypes.NewValue(tftypes.Bool, true),
+				"app_id":         tftypes.NewValue(tftypes.Number, 7),
+			})
+			if removed := state.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Errorf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
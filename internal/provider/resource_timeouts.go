@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// go durations like 90s or 1h30m
+var durationRe = regexp.MustCompile(`^(\d+(\.\d+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// TimeoutsModel describes the timeouts block of resources.
+type TimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// timeoutsBlock is the timeouts block shared by all resources calling the backend.
+func timeoutsBlock() schema.Block {
+	attribute := func(operation string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: "Timeout of " + operation + " as go duration, e.g. `30m`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationRe, "must be a go duration, e.g. 90s or 1h30m"),
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Timeouts of the operations spanning all their backend calls and task waits, single task waits are further bounded by the timeout attribute of resources having one. Without a timeout each backend call is bounded by the provider default_timeout.",
+		Attributes: map[string]schema.Attribute{
+			"create": attribute("create"),
+			"read":   attribute("read"),
+			"update": attribute("update"),
+			"delete": attribute("delete"),
+		},
+	}
+}
+
+// timeoutContext bounds ctx by the timeout configured for operation, ctx is
+// returned as is if the resource has none.
+func timeoutContext(ctx context.Context, timeouts *TimeoutsModel, operation string) (context.Context, context.CancelFunc) {
+	if timeouts == nil {
+		return ctx, func() {}
+	}
+
+	timeout := map[string]types.String{
+		"create": timeouts.Create,
+		"read":   timeouts.Read,
+		"update": timeouts.Update,
+		"delete": timeouts.Delete,
+	}[operation]
+
+	// invalid durations are rejected by the schema validator
+	d, err := time.ParseDuration(timeout.ValueString())
+	if timeout.IsNull() || err != nil {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, d)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+)
+
+// rpcBackendConfig describes where the python backend is installed.
+type rpcBackendConfig struct {
+	// virtualenv pip installs the backend into, empty if pcrpc is installed otherwise
+	VenvPath string
+	// pcrpc executable to launch
+	PcrpcPath string
+	// auto, skip or the path of a local wheel
+	InstallMode string
+}
+
+// resolveRpcBackend determines the backend installation from the provider attributes,
+// falling back to the PXC_PYTHON_VENV / PXC_PCRPC_PATH env vars, an activated virtualenv
+// and finally pcrpc on the PATH (system python or pipx installs).
+func resolveRpcBackend(venvPath types.String, pcrpcPath types.String, installMode types.String) (rpcBackendConfig, error) {
+	var backend rpcBackendConfig
+
+	backend.InstallMode = os.Getenv("PXC_BACKEND_INSTALL_MODE")
+	if !installMode.IsNull() {
+		backend.InstallMode = installMode.ValueString()
+	}
+	if backend.InstallMode == "" {
+		backend.InstallMode = "auto"
+	}
+
+	backend.VenvPath = os.Getenv("PXC_PYTHON_VENV")
+	if backend.VenvPath == "" {
+		backend.VenvPath = os.Getenv("VIRTUAL_ENV")
+	}
+	if !venvPath.IsNull() {
+		backend.VenvPath = venvPath.ValueString()
+	}
+	backend.VenvPath = strings.TrimSuffix(backend.VenvPath, "/")
+
+	backend.PcrpcPath = os.Getenv("PXC_PCRPC_PATH")
+	if !pcrpcPath.IsNull() {
+		backend.PcrpcPath = pcrpcPath.ValueString()
+	}
+	if backend.InstallMode != "auto" && backend.InstallMode != "skip" {
+		if _, err := os.Stat(backend.InstallMode); err != nil {
+			return backend, fmt.Errorf("backend_install_mode is neither auto, skip nor an existing wheel: %w", err)
+		}
+		if backend.VenvPath == "" {
+			return backend, fmt.Errorf("installing the backend from %s requires a virtualenv, set python_venv_path", backend.InstallMode)
+		}
+	}
+
+	if backend.PcrpcPath != "" {
+		return backend, nil
+	}
+
+	if backend.VenvPath != "" {
+		backend.PcrpcPath = fmt.Sprintf("%s/bin/pcrpc", backend.VenvPath)
+		return backend, nil
+	}
+
+	pcrpc, err := exec.LookPath("pcrpc")
+	if err != nil {
+		return backend, fmt.Errorf("no virtualenv configured and pcrpc not found in PATH, set python_venv_path or pcrpc_path")
+	}
+	backend.PcrpcPath = pcrpc
+
+	return backend, nil
+}
+
+// launchRpcServer installs the python backend matching the provider version and
+// starts it as daemon listening on address, only accepting calls with token. The
+// daemon outlives ctx, the caller has to kill it on exit.
+func launchRpcServer(ctx context.Context, backend rpcBackendConfig, version string, address string, token string) (*exec.Cmd, error) {
+	// with this env var we can determine if we are running in a pytest context
+	pytestCurrent := os.Getenv("PYTEST_CURRENT_TEST")
+
+	if strings.HasPrefix(address, "unix://") {
+		reapStaleRpcSockets(ctx, filepath.Dir(strings.TrimPrefix(address, "unix://")))
+	}
+
+	// providers launched in parallel (e.g. aliases) would race each others pip installs
+	if backend.VenvPath != "" {
+		unlock, err := lockFile(ctx, fmt.Sprintf("%s/.pxc-install.lock", backend.VenvPath))
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock virtualenv for backend install: %w", err)
+		}
+		defer unlock()
+	}
+
+	pip := fmt.Sprintf("%s/bin/pip", backend.VenvPath)
+	switch {
+	case backend.InstallMode == "skip":
+		tflog.Info(ctx, "Skipping backend installation, using pre-installed pcrpc")
+	case backend.InstallMode != "auto":
+		// air-gapped install, dependencies are resolved from wheels next to the given one
+		tflog.Info(ctx, fmt.Sprintf("Installing backend from %s", backend.InstallMode))
+		pipCmd := exec.CommandContext(ctx, pip, "install", "--no-index", "--find-links", filepath.Dir(backend.InstallMode), backend.InstallMode)
+
+		output, err := pipCmd.CombinedOutput()
+		if err != nil {
+			return nil, fmt.Errorf("pip install failed with error: %v - %s", err, string(output))
+		}
+	// only install the pypi package if not in e2e scenario (in this case its installed via pip -e .)
+	// outside of a virtualenv pcrpc is managed by the user (pipx, system packages)
+	case pytestCurrent == "" && version != "dev" && backend.VenvPath != "":
+		// package will be published to pypi with same version tag as provider
+		// todo: check against installed version and prevent from removing / missmatching
+		pipCmd := exec.CommandContext(ctx, pip, "install", fmt.Sprintf("rpyc-pve-cloud==%s", version))
+
+		output, err := pipCmd.CombinedOutput()
+		if err != nil {
+			return nil, fmt.Errorf("pip install failed with error: %v - %s", err, string(output))
+		}
+	}
+
+	// start pyhon grpc server as daemon
+	tflog.Info(ctx, fmt.Sprintf("Launching python rpc server on %s", address))
+	cmd := exec.Command(backend.PcrpcPath, strconv.Itoa(os.Getpid()), address)
+	// passed via env, the command line is visible to all local users
+	cmd.Env = append(os.Environ(), "PXC_RPC_TOKEN="+token)
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+// reapStaleRpcSockets removes the sockets of crashed providers in dir, their
+// pid is part of the socket name.
+func reapStaleRpcSockets(ctx context.Context, dir string) {
+	sockets, err := filepath.Glob(filepath.Join(dir, "pc-rpc-*.sock"))
+	if err != nil {
+		return
+	}
+
+	for _, socket := range sockets {
+		pid, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(filepath.Base(socket), "pc-rpc-"), ".sock"))
+		if err != nil || pid <= 0 {
+			continue
+		}
+
+		// signal 0 only checks existence, EPERM means the process lives but belongs to another user
+		if err := syscall.Kill(pid, 0); err != syscall.ESRCH {
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Removing stale rpc socket %s", socket))
+		if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Failed to remove stale rpc socket %s: %s", socket, err))
+		}
+	}
+}
+
+// lockFile takes an exclusive flock on path, waiting until it is free or ctx is done.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			break
+		}
+		if err != syscall.EWOULDBLOCK {
+			f.Close()
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			f.Close()
+			return nil, ctx.Err()
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
+
+var errRpcBackendVersionMismatch = errors.New("backend version mismatch")
+
+// checkRpcBackendVersion fails with errRpcBackendVersionMismatch if the backend reports
+// another version than expected, dev builds on either side skip the check.
+func checkRpcBackendVersion(ctx context.Context, conn grpc.ClientConnInterface, expected string) error {
+	vresp, err := pb.NewHealthClient(conn).Version(ctx, &pb.VersionRequest{})
+	if err != nil {
+		return err
+	}
+
+	if expected == "dev" || vresp.Version == "dev" {
+		tflog.Info(ctx, fmt.Sprintf("Skipping backend version check, backend is %s", vresp.Version))
+		return nil
+	}
+
+	if strings.TrimPrefix(expected, "v") != strings.TrimPrefix(vresp.Version, "v") {
+		return fmt.Errorf("%w: installed backend rpyc-pve-cloud %s doesn't match the expected version %s, install the matching version or set backend_version", errRpcBackendVersionMismatch, vresp.Version, expected)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckRpcBackendVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		err          error
+		expected     string
+		wantErr      bool
+		wantMismatch bool
+	}{
+		{name: "matching", version: "1.2.0", expected: "v1.2.0"},
+		{name: "dev provider", version: "1.2.0", expected: "dev"},
+		{name: "dev backend", version: "dev", expected: "v1.2.0"},
+		{name: "mismatch", version: "1.1.0", expected: "v1.2.0", wantErr: true, wantMismatch: true},
+		{name: "backend exception", err: errors.New("Exception calling application"), expected: "v1.2.0", wantErr: true},
+		{name: "not up yet", err: status.Error(codes.Unavailable, "connection refused"), expected: "v1.2.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+				"Version": func(req proto.Message) (proto.Message, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &pb.VersionResponse{Version: tt.version}, nil
+				},
+			}}
+
+			err := checkRpcBackendVersion(context.Background(), conn, tt.expected)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkRpcBackendVersion() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if mismatch := errors.Is(err, errRpcBackendVersionMismatch); mismatch != tt.wantMismatch {
+				t.Errorf("mismatch = %t, want %t, error = %v", mismatch, tt.wantMismatch, err)
+			}
+		})
+	}
+}
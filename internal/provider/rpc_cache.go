@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"path"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// calls whose response doesn't change within a run, cached per request (and thereby per target_pve)
+var rpcCachedMethods = map[string]bool{
+	"GetClusterVars":  true,
+	"GetPveInventory": true,
+}
+
+// rpcCache keeps the responses of rpcCachedMethods for the lifetime of the provider,
+// which is a single plan or apply. A nil cache caches nothing.
+type rpcCache struct {
+	mu      sync.Mutex
+	entries map[string]*rpcCacheEntry
+}
+
+type rpcCacheEntry struct {
+	// held while the first call is in flight, concurrent callers wait for its response
+	mu    sync.Mutex
+	reply proto.Message
+}
+
+func newRpcCache() *rpcCache {
+	return &rpcCache{entries: map[string]*rpcCacheEntry{}}
+}
+
+// do returns the cached response of the call into reply or performs it with invoke.
+// Failed calls are not cached.
+func (c *rpcCache) do(method string, req any, reply any, invoke func() error) error {
+	if c == nil || !rpcCachedMethods[path.Base(method)] {
+		return invoke()
+	}
+
+	reqBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.(proto.Message))
+	if err != nil {
+		return invoke()
+	}
+	key := method + "\x00" + string(reqBytes)
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &rpcCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.reply != nil {
+		proto.Merge(reply.(proto.Message), entry.reply)
+		return nil
+	}
+
+	if err := invoke(); err != nil {
+		return err
+	}
+	entry.reply = proto.Clone(reply.(proto.Message))
+
+	return nil
+}
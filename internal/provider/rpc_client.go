@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+// default timeout of rpc calls whose ctx has no deadline, overridden by default_timeout
+const rpcCallTimeout = 120 * time.Second
+
+// rpcAddress returns the address of the python rpc server, the configured rpc_address
+// or the socket of the server launched by this provider.
+func rpcAddress(address types.String, socketDir types.String) string {
+	if !address.IsNull() {
+		return address.ValueString()
+	}
+
+	// if this env var is set we connect to a manually launched pve cloud rpc server
+	// for easier debugging
+	manualPid := os.Getenv("PXC_RPC_MANUAL_PID")
+	if manualPid != "" {
+		return fmt.Sprintf("unix:///tmp/pc-rpc-%s.sock", manualPid)
+	}
+
+	dir := "/tmp"
+	if !socketDir.IsNull() {
+		dir = strings.TrimSuffix(socketDir.ValueString(), "/")
+	}
+	return fmt.Sprintf("unix://%s/pc-rpc-%d.sock", dir, os.Getpid())
+}
+
+// rpcCallConfigFromProvider parses the default_timeout and rpc_retry_* provider attributes.
+func rpcCallConfigFromProvider(timeout types.String, attempts types.Int64, backoff types.String) (rpcCallConfig, error) {
+	retry := defaultRpcCallConfig
+	if !timeout.IsNull() {
+		d, err := time.ParseDuration(timeout.ValueString())
+		if err != nil {
+			return retry, fmt.Errorf("invalid default_timeout: %w", err)
+		}
+		retry.Timeout = d
+	}
+	if !attempts.IsNull() {
+		retry.Attempts = attempts.ValueInt64()
+	}
+	if !backoff.IsNull() {
+		d, err := time.ParseDuration(backoff.ValueString())
+		if err != nil {
+			return retry, fmt.Errorf("invalid rpc_retry_backoff: %w", err)
+		}
+		retry.Backoff = d
+	}
+	return retry, nil
+}
+
+// rpcIdempotent reports if method only reads and can be repeated safely.
+func rpcIdempotent(method string) bool {
+	name := path.Base(method)
+	return strings.HasPrefix(name, "Get") || name == "WaitForTask"
+}
+
+// newRpcUnaryInterceptor serves cached responses, applies the default timeout, retries
+// transient failures and turns errors into rpcErrors prefixed with the called method.
+func newRpcUnaryInterceptor(retry rpcCallConfig) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return retry.Cache.do(method, req, reply, func() error {
+			return rpcCall(ctx, retry, method, req, reply, cc, invoker, opts...)
+		})
+	}
+}
+
+// rpcCall performs the call, retrying transient failures.
+func rpcCall(ctx context.Context, retry rpcCallConfig, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	backoff := retry.Backoff
+	for attempt := int64(1); ; attempt++ {
+		var trailer metadata.MD
+		err := rpcAttempt(ctx, retry.Timeout, method, req, reply, cc, invoker, append(opts, grpc.Trailer(&trailer))...)
+		if err == nil {
+			return nil
+		}
+
+		rpcErr := &rpcError{Code: classifyRpcError(err, trailer), Method: path.Base(method), Err: err}
+		if attempt >= retry.Attempts || !rpcRetryable(method, err) {
+			return rpcErr
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Retrying %s in %s after attempt %d failed: %s", path.Base(method), backoff, attempt, err))
+		select {
+		case <-ctx.Done():
+			return rpcErr
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, rpcMaxBackoff)
+	}
+}
+
+// rpcAttempt performs a single call, bounded by timeout if ctx has no deadline.
+func rpcAttempt(ctx context.Context, timeout time.Duration, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// rpcTokenCredentials authenticates calls against the python rpc server with the session token.
+type rpcTokenCredentials string
+
+func (t rpcTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// the token is only sent over the local socket or a channel secured by the user
+func (t rpcTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// newRpcToken generates the token the launched rpc server accepts calls with.
+func newRpcToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// dialRpc creates the connection to the python rpc server that is shared by all
+// resources, grpc connections are safe for concurrent use.
+func dialRpc(address string, token string, retry rpcCallConfig) (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(newRpcUnaryInterceptor(retry)),
+		// python grpc servers answer pings more frequent than 5 minutes with GOAWAY
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    5 * time.Minute,
+			Timeout: 20 * time.Second,
+		}),
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(rpcTokenCredentials(token)))
+	}
+
+	return grpc.NewClient(address, opts...)
+}
+
+// GetCloudRpcService returns a client on the connection the provider established in Configure.
+func GetCloudRpcService(ctx context.Context, cloudInv CloudInventory) (pb.CloudServiceClient, error) {
+	if cloudInv.RpcConn == nil {
+		return nil, fmt.Errorf("rpc connection not initialized, the provider is not configured")
+	}
+
+	return pb.NewCloudServiceClient(cloudInv.RpcConn), nil
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// trailer the python backend sends the ErrorCode name in
+const rpcErrorCodeTrailer = "pxc-error-code"
+
+// rpcError is a failed backend call, classified by the backend or derived from the grpc status.
+type rpcError struct {
+	Code   pb.ErrorCode
+	Method string
+	Err    error
+}
+
+// hints appended to the error messages shown in diagnostics
+var rpcErrorHints = map[pb.ErrorCode]string{
+	pb.ErrorCode_NOT_FOUND:   "the object doesn't exist",
+	pb.ErrorCode_CONFLICT:    "the object already exists or was changed concurrently",
+	pb.ErrorCode_UNREACHABLE: "the cluster is unreachable, check the network and ssh access to the pve hosts",
+	pb.ErrorCode_AUTH:        "authentication against the backend or cluster failed",
+}
+
+func (e *rpcError) Error() string {
+	if hint, ok := rpcErrorHints[e.Code]; ok {
+		return fmt.Sprintf("%s: %s (%s)", e.Method, e.Err, hint)
+	}
+	return fmt.Sprintf("%s: %s", e.Method, e.Err)
+}
+
+func (e *rpcError) Unwrap() error {
+	return e.Err
+}
+
+// rpcErrorCode returns the classification of a failed call, ERROR_CODE_UNSPECIFIED for other errors.
+func rpcErrorCode(err error) pb.ErrorCode {
+	var rpcErr *rpcError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.Code
+	}
+	return pb.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// classifyRpcError prefers the code sent by the backend and falls back to the grpc status.
+func classifyRpcError(err error, trailer metadata.MD) pb.ErrorCode {
+	if values := trailer.Get(rpcErrorCodeTrailer); len(values) > 0 {
+		if code, ok := pb.ErrorCode_value[values[0]]; ok {
+			return pb.ErrorCode(code)
+		}
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound:
+		return pb.ErrorCode_NOT_FOUND
+	case codes.AlreadyExists, codes.Aborted:
+		return pb.ErrorCode_CONFLICT
+	case codes.Unavailable:
+		return pb.ErrorCode_UNREACHABLE
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return pb.ErrorCode_AUTH
+	}
+
+	return pb.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// rpcCallConfig controls the timeout and the retries of transient failures of backend calls.
+type rpcCallConfig struct {
+	// timeout of calls whose ctx has no deadline
+	Timeout  time.Duration
+	Attempts int64
+	// doubled after each attempt, capped at rpcMaxBackoff
+	Backoff time.Duration
+	// nil disables caching
+	Cache *rpcCache
+}
+
+const rpcMaxBackoff = 30 * time.Second
+
+var defaultRpcCallConfig = rpcCallConfig{Timeout: rpcCallTimeout, Attempts: 3, Backoff: time.Second}
+
+// rpcRetryable reports if a failed call can be repeated. Unavailable backends and unreachable
+// clusters never processed the call, timed out calls are only repeated for reads.
+func rpcRetryable(method string, err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.DeadlineExceeded:
+		return rpcIdempotent(method)
+	}
+	return false
+}
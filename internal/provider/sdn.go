@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+)
+
+// ids of sdn zones and vnets, proxmox limits them to 8 characters
+var sdnIdRe = regexp.MustCompile(`^[a-z][a-z0-9]{0,7}$`)
+
+// seconds to wait for the network reload on all nodes
+const sdnApplyTimeout = 300
+
+// sdnApplyMu serializes the reloads of the resources of one apply, each reload
+// already applies all pending changes of the cluster.
+var sdnApplyMu sync.Mutex
+
+// applySdn commits the pending sdn changes and waits until all nodes reloaded their network.
+func applySdn(ctx context.Context, client pb.CloudServiceClient, targetPve string) error {
+	sdnApplyMu.Lock()
+	defer sdnApplyMu.Unlock()
+
+	return pveApiCallWait(ctx, client, targetPve, "PUT", "/cluster/sdn", nil, true, sdnApplyTimeout)
+}
@@ -0,0 +1,381 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SdnSubnetResource{}
+var _ resource.ResourceWithImportState = &SdnSubnetResource{}
+
+func NewSdnSubnetResource() resource.Resource {
+	return &SdnSubnetResource{}
+}
+
+// SdnSubnetResource defines the resource implementation.
+type SdnSubnetResource struct {
+	cloudInventory CloudInventory
+}
+
+// SdnSubnetResourceModel describes the resource data model.
+type SdnSubnetResourceModel struct {
+	Vnet          types.String        `tfsdk:"vnet"`
+	Cidr          types.String        `tfsdk:"cidr"`
+	SubnetId      types.String        `tfsdk:"subnet_id"`
+	Gateway       types.String        `tfsdk:"gateway"`
+	Snat          types.Bool          `tfsdk:"snat"`
+	DhcpDnsServer types.String        `tfsdk:"dhcp_dns_server"`
+	DhcpRanges    []SdnDhcpRangeModel `tfsdk:"dhcp_ranges"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// SdnDhcpRangeModel describes an address range leased by the dhcp server of the zone.
+type SdnDhcpRangeModel struct {
+	StartAddress types.String `tfsdk:"start_address"`
+	EndAddress   types.String `tfsdk:"end_address"`
+}
+
+// PveSdnSubnet is the subset of pvesh get /cluster/sdn/vnets/{vnet}/subnets/{subnet} we manage.
+type PveSdnSubnet struct {
+	Gateway       string            `json:"gateway"`
+	Snat          pveBool           `json:"snat"`
+	DhcpDnsServer string            `json:"dhcp-dns-server"`
+	DhcpRange     []json.RawMessage `json:"dhcp-range"`
+}
+
+func (r *SdnSubnetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_subnet"
+}
+
+func (r *SdnSubnetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a subnet of a proxmox sdn vnet, with optional dhcp ranges served in simple zones. " +
+			"The pending sdn configuration is applied to all nodes on every change. Import with `<vnet>/<cidr>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"vnet": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Vnet of the subnet.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Network of the subnet, e.g. `10.10.0.0/24`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id proxmox assigns to the subnet, `<zone>-<address>-<mask>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Gateway address of the subnet, assigned to the vnet bridge in simple and evpn zones.",
+			},
+			"snat": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Masquerades outgoing traffic of the subnet with the address of the node.",
+			},
+			"dhcp_dns_server": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Dns server announced by dhcp.",
+			},
+			"dhcp_ranges": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Address ranges leased by the dhcp server of the zone.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_address": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "First address of the range.",
+						},
+						"end_address": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Last address of the range.",
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *SdnSubnetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// subnetArgs returns the pvesh args of the subnet without its cidr, together with the optional keys that are unset.
+func (data SdnSubnetResourceModel) subnetArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--snat": boolToPve(data.Snat.ValueBool()),
+	}
+	deletes := []string{}
+
+	if !data.Gateway.IsNull() {
+		args["--gateway"] = data.Gateway.ValueString()
+	} else {
+		deletes = append(deletes, "gateway")
+	}
+	if !data.DhcpDnsServer.IsNull() {
+		args["--dhcp-dns-server"] = data.DhcpDnsServer.ValueString()
+	} else {
+		deletes = append(deletes, "dhcp-dns-server")
+	}
+	if len(data.DhcpRanges) > 0 {
+		// one --dhcp-range arg per range
+		ranges := []string{}
+		for _, dhcpRange := range data.DhcpRanges {
+			ranges = append(ranges, fmt.Sprintf("start-address=%s,end-address=%s", dhcpRange.StartAddress.ValueString(), dhcpRange.EndAddress.ValueString()))
+		}
+		args["--dhcp-range"] = strings.Join(ranges, "\n")
+	} else {
+		deletes = append(deletes, "dhcp-range")
+	}
+
+	return args, deletes
+}
+
+// findSubnetId looks up the id proxmox assigned to the cidr in the vnet, empty if there is none.
+func (r *SdnSubnetResource) findSubnetId(ctx context.Context, client pb.CloudServiceClient, vnet string, cidr string) (string, error) {
+	var subnets []map[string]interface{}
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/vnets/%s/subnets", vnet), &subnets)
+	if err != nil {
+		return "", err
+	}
+
+	for _, subnet := range subnets {
+		if subnetCidr, _ := pveConfigString(subnet, "cidr"); subnetCidr == cidr {
+			subnetId, _ := pveConfigString(subnet, "subnet")
+			return subnetId, nil
+		}
+	}
+	return "", nil
+}
+
+// apiPath returns the api path of the subnet.
+func (data SdnSubnetResourceModel) apiPath() string {
+	return fmt.Sprintf("/cluster/sdn/vnets/%s/subnets/%s", data.Vnet.ValueString(), data.SubnetId.ValueString())
+}
+
+func (r *SdnSubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SdnSubnetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.subnetArgs()
+	createArgs["--subnet"] = data.Cidr.ValueString()
+	createArgs["--type"] = "subnet"
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/vnets/%s/subnets", data.Vnet.ValueString()), createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating subnet, got error: %s", err))
+		return
+	}
+
+	subnetId, err := r.findSubnetId(ctx, client, data.Vnet.ValueString(), data.Cidr.ValueString())
+	if err != nil || subnetId == "" {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to look up the id of the created subnet, got error: %v", err))
+		return
+	}
+	data.SubnetId = types.StringValue(subnetId)
+
+	// the subnet exists from here on, also if the reload fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnSubnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SdnSubnetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// also resolves the id after an import
+	subnetId, err := r.findSubnetId(ctx, client, data.Vnet.ValueString(), data.Cidr.ValueString())
+	if removeIfMissing(ctx, subnetId != "", err, "subnet", resp) {
+		return
+	}
+	data.SubnetId = types.StringValue(subnetId)
+
+	var subnet PveSdnSubnet
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), &subnet)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subnet, got error: %s", err))
+		return
+	}
+
+	data.Gateway = optionalString(subnet.Gateway)
+	data.Snat = types.BoolValue(bool(subnet.Snat))
+	data.DhcpDnsServer = optionalString(subnet.DhcpDnsServer)
+
+	ranges := []SdnDhcpRangeModel{}
+	for _, raw := range subnet.DhcpRange {
+		props := parsePveObject(raw)
+		ranges = append(ranges, SdnDhcpRangeModel{
+			StartAddress: types.StringValue(props["start-address"]),
+			EndAddress:   types.StringValue(props["end-address"]),
+		})
+	}
+	if len(ranges) > 0 || data.DhcpRanges != nil {
+		data.DhcpRanges = ranges
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SdnSubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SdnSubnetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs, deletes := data.subnetArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, data.apiPath(), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating subnet, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnSubnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SdnSubnetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, data.apiPath())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting subnet, got error: %s", err))
+		return
+	}
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnSubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// the cidr contains a slash itself
+	vnet, cidr, found := strings.Cut(req.ID, "/")
+	if !found || vnet == "" || cidr == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <vnet>/<cidr>, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vnet"), vnet)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cidr"), cidr)...)
+}
@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SdnVnetResource{}
+var _ resource.ResourceWithImportState = &SdnVnetResource{}
+
+func NewSdnVnetResource() resource.Resource {
+	return &SdnVnetResource{}
+}
+
+// SdnVnetResource defines the resource implementation.
+type SdnVnetResource struct {
+	cloudInventory CloudInventory
+}
+
+// SdnVnetResourceModel describes the resource data model.
+type SdnVnetResourceModel struct {
+	Vnet      types.String `tfsdk:"vnet"`
+	Zone      types.String `tfsdk:"zone"`
+	Alias     types.String `tfsdk:"alias"`
+	Tag       types.Int64  `tfsdk:"tag"`
+	VlanAware types.Bool   `tfsdk:"vlanaware"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *SdnVnetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_vnet"
+}
+
+func (r *SdnVnetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a proxmox sdn vnet, a virtual network guests attach to like a bridge. " +
+			"The pending sdn configuration is applied to all nodes on every change. Import with `<vnet>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"vnet": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the vnet, used as bridge name of guest network devices.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sdnIdRe, "must start with a letter and only contain up to 8 lowercase letters and digits"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Zone of the vnet.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Descriptive name of the vnet.",
+			},
+			"tag": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Vlan or vxlan id of the vnet, required in vlan, vxlan and evpn zones.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16777215),
+				},
+			},
+			"vlanaware": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Allows guests to use vlans inside the vnet.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *SdnVnetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// vnetArgs returns the pvesh args of the vnet without its id, together with the optional keys that are unset.
+func (data SdnVnetResourceModel) vnetArgs() (map[string]string, []string) {
+	args := map[string]string{
+		"--vlanaware": boolToPve(data.VlanAware.ValueBool()),
+	}
+	deletes := []string{}
+
+	if !data.Alias.IsNull() {
+		args["--alias"] = data.Alias.ValueString()
+	} else {
+		deletes = append(deletes, "alias")
+	}
+	if !data.Tag.IsNull() {
+		args["--tag"] = strconv.FormatInt(data.Tag.ValueInt64(), 10)
+	} else {
+		deletes = append(deletes, "tag")
+	}
+
+	return args, deletes
+}
+
+func (r *SdnVnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SdnVnetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.vnetArgs()
+	createArgs["--vnet"] = data.Vnet.ValueString()
+	createArgs["--zone"] = data.Zone.ValueString()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/sdn/vnets", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating vnet, got error: %s", err))
+		return
+	}
+
+	// the vnet exists from here on, also if the reload fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnVnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SdnVnetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/sdn/vnets", "vnet", data.Vnet.ValueString())
+	if removeIfMissing(ctx, exists, err, "vnet", resp) {
+		return
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/vnets/%s", data.Vnet.ValueString()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vnet, got error: %s", err))
+		return
+	}
+
+	zone, _ := pveConfigString(config, "zone")
+	alias, _ := pveConfigString(config, "alias")
+	vlanAware, _ := pveConfigInt(config, "vlanaware")
+	data.Zone = types.StringValue(zone)
+	data.Alias = optionalString(alias)
+	data.VlanAware = types.BoolValue(vlanAware == 1)
+	if tag, ok := pveConfigInt(config, "tag"); ok {
+		data.Tag = types.Int64Value(tag)
+	} else {
+		data.Tag = types.Int64Null()
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SdnVnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SdnVnetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs, deletes := data.vnetArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/vnets/%s", data.Vnet.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating vnet, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnVnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SdnVnetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to delete vnets that still contain subnets
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/vnets/%s", data.Vnet.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting vnet, got error: %s", err))
+		return
+	}
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnVnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("vnet"), req, resp)
+}
@@ -0,0 +1,502 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SdnZoneResource{}
+var _ resource.ResourceWithImportState = &SdnZoneResource{}
+
+// zone types managed by the resource, each has its own attribute
+var sdnZoneTypes = []string{"simple", "vlan", "vxlan", "evpn"}
+
+func NewSdnZoneResource() resource.Resource {
+	return &SdnZoneResource{}
+}
+
+// SdnZoneResource defines the resource implementation.
+type SdnZoneResource struct {
+	cloudInventory CloudInventory
+}
+
+// SdnZoneResourceModel describes the resource data model.
+type SdnZoneResourceModel struct {
+	Zone  types.String `tfsdk:"zone"`
+	Type  types.String `tfsdk:"type"`
+	Nodes []string     `tfsdk:"nodes"`
+	Mtu   types.Int64  `tfsdk:"mtu"`
+	Ipam  types.String `tfsdk:"ipam"`
+
+	Simple *SdnSimpleZoneModel `tfsdk:"simple"`
+	Vlan   *SdnVlanZoneModel   `tfsdk:"vlan"`
+	Vxlan  *SdnVxlanZoneModel  `tfsdk:"vxlan"`
+	Evpn   *SdnEvpnZoneModel   `tfsdk:"evpn"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// SdnSimpleZoneModel describes an isolated bridge zone.
+type SdnSimpleZoneModel struct {
+	Dhcp types.String `tfsdk:"dhcp"`
+}
+
+// SdnVlanZoneModel describes a zone of vlans on an existing bridge.
+type SdnVlanZoneModel struct {
+	Bridge types.String `tfsdk:"bridge"`
+}
+
+// SdnVxlanZoneModel describes a zone of vxlan tunnels between the peers.
+type SdnVxlanZoneModel struct {
+	Peers []string `tfsdk:"peers"`
+}
+
+// SdnEvpnZoneModel describes a routed zone of an evpn controller.
+type SdnEvpnZoneModel struct {
+	Controller       types.String `tfsdk:"controller"`
+	VrfVxlan         types.Int64  `tfsdk:"vrf_vxlan"`
+	ExitNodes        []string     `tfsdk:"exit_nodes"`
+	Mac              types.String `tfsdk:"mac"`
+	AdvertiseSubnets types.Bool   `tfsdk:"advertise_subnets"`
+}
+
+func (r *SdnZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_zone"
+}
+
+func (r *SdnZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// exactly one of the type blocks has to be set
+	typeBlock := func(name string, description string, attributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+		others := []path.Expression{}
+		for _, zoneType := range sdnZoneTypes {
+			if zoneType != name {
+				others = append(others, path.MatchRoot(zoneType))
+			}
+		}
+		return schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: description,
+			Attributes:          attributes,
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(others...),
+			},
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+				}, "Changing the zone type recreates the zone.", "Changing the zone type recreates the zone."),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a proxmox sdn zone, the pending sdn configuration is applied to all nodes on every change. " +
+			"Import with `<zone>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the zone, up to 8 lowercase letters and digits.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sdnIdRe, "must start with a letter and only contain up to 8 lowercase letters and digits"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the zone, derived from the set type attribute.",
+			},
+			"nodes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Nodes the zone is deployed to, all nodes if not set.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Mtu of the vnets of the zone, has to account for the overhead of vlan and vxlan encapsulation.",
+				Validators: []validator.Int64{
+					int64validator.Between(576, 65535),
+				},
+			},
+			"ipam": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ipam plugin tracking the addresses of the subnets, e.g. `pve`.",
+			},
+			"simple": typeBlock("simple", "Isolated bridge per vnet, routed and natted by the node.", map[string]schema.Attribute{
+				"dhcp": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Dhcp server for the dhcp ranges of the subnets, `dnsmasq` is the only one supported.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("dnsmasq"),
+					},
+				},
+			}),
+			"vlan": typeBlock("vlan", "Vnets are vlans of an existing vlan aware bridge.", map[string]schema.Attribute{
+				"bridge": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Bridge on the nodes carrying the vlans, e.g. `vmbr0`.",
+				},
+			}),
+			"vxlan": typeBlock("vxlan", "Vnets are vxlan tunnels between the peers.", map[string]schema.Attribute{
+				"peers": schema.SetAttribute{
+					ElementType:         types.StringType,
+					Required:            true,
+					MarkdownDescription: "Addresses of all nodes of the zone.",
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+			}),
+			"evpn": typeBlock("evpn", "Routed vxlan vnets announced by an evpn controller.", map[string]schema.Attribute{
+				"controller": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Id of the evpn controller.",
+				},
+				"vrf_vxlan": schema.Int64Attribute{
+					Required:            true,
+					MarkdownDescription: "Vxlan id of the vrf routing the vnets of the zone.",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 16777215),
+					},
+				},
+				"exit_nodes": schema.SetAttribute{
+					ElementType:         types.StringType,
+					Optional:            true,
+					MarkdownDescription: "Nodes routing the traffic of the zone to the outside.",
+				},
+				"mac": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Anycast mac address of the vnet gateways, generated if not set.",
+				},
+				"advertise_subnets": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "Announces the full subnets instead of single guest addresses.",
+				},
+			}),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *SdnZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// zoneType returns the type of the set type attribute.
+func (data SdnZoneResourceModel) zoneType() string {
+	switch {
+	case data.Simple != nil:
+		return "simple"
+	case data.Vlan != nil:
+		return "vlan"
+	case data.Vxlan != nil:
+		return "vxlan"
+	case data.Evpn != nil:
+		return "evpn"
+	}
+	return ""
+}
+
+// zoneArgs returns the pvesh args of the zone, together with the optional keys that are unset.
+func (data SdnZoneResourceModel) zoneArgs() (map[string]string, []string) {
+	args := map[string]string{}
+	optional := map[string]string{}
+	optionalKeys := []string{"nodes", "mtu", "ipam"}
+
+	if data.Nodes != nil {
+		optional["nodes"] = strings.Join(sortedStrings(data.Nodes), ",")
+	}
+	if !data.Mtu.IsNull() {
+		optional["mtu"] = strconv.FormatInt(data.Mtu.ValueInt64(), 10)
+	}
+	if !data.Ipam.IsNull() {
+		optional["ipam"] = data.Ipam.ValueString()
+	}
+
+	switch {
+	case data.Simple != nil:
+		optionalKeys = append(optionalKeys, "dhcp")
+		if !data.Simple.Dhcp.IsNull() {
+			optional["dhcp"] = data.Simple.Dhcp.ValueString()
+		}
+	case data.Vlan != nil:
+		args["--bridge"] = data.Vlan.Bridge.ValueString()
+	case data.Vxlan != nil:
+		args["--peers"] = strings.Join(sortedStrings(data.Vxlan.Peers), ",")
+	case data.Evpn != nil:
+		args["--controller"] = data.Evpn.Controller.ValueString()
+		args["--vrf-vxlan"] = strconv.FormatInt(data.Evpn.VrfVxlan.ValueInt64(), 10)
+		args["--advertise-subnets"] = boolToPve(data.Evpn.AdvertiseSubnets.ValueBool())
+		optionalKeys = append(optionalKeys, "exitnodes", "mac")
+		if data.Evpn.ExitNodes != nil {
+			optional["exitnodes"] = strings.Join(sortedStrings(data.Evpn.ExitNodes), ",")
+		}
+		if !data.Evpn.Mac.IsNull() {
+			optional["mac"] = data.Evpn.Mac.ValueString()
+		}
+	}
+
+	deletes := []string{}
+	for _, key := range optionalKeys {
+		if value, ok := optional[key]; ok {
+			args["--"+key] = value
+		} else {
+			deletes = append(deletes, key)
+		}
+	}
+
+	return args, deletes
+}
+
+// readZone takes the managed values of the zone config over into the model.
+func (data *SdnZoneResourceModel) readZone(config map[string]interface{}) {
+	value := func(key string) string {
+		configValue, _ := pveConfigString(config, key)
+		return configValue
+	}
+	// unset lists stay null, set ones are refreshed
+	list := func(key string, current []string) []string {
+		values := splitPveList(value(key))
+		if len(values) == 0 && current == nil {
+			return nil
+		}
+		return values
+	}
+
+	zoneType := value("type")
+	data.Type = types.StringValue(zoneType)
+	data.Nodes = list("nodes", data.Nodes)
+	data.Ipam = optionalString(value("ipam"))
+	if mtu, ok := pveConfigInt(config, "mtu"); ok {
+		data.Mtu = types.Int64Value(mtu)
+	} else {
+		data.Mtu = types.Int64Null()
+	}
+
+	var exitNodes []string
+	if data.Evpn != nil {
+		exitNodes = data.Evpn.ExitNodes
+	}
+
+	data.Simple, data.Vlan, data.Vxlan, data.Evpn = nil, nil, nil, nil
+	switch zoneType {
+	case "simple":
+		data.Simple = &SdnSimpleZoneModel{Dhcp: optionalString(value("dhcp"))}
+	case "vlan":
+		data.Vlan = &SdnVlanZoneModel{Bridge: types.StringValue(value("bridge"))}
+	case "vxlan":
+		data.Vxlan = &SdnVxlanZoneModel{Peers: splitPveList(value("peers"))}
+	case "evpn":
+		vrfVxlan, _ := pveConfigInt(config, "vrf-vxlan")
+		data.Evpn = &SdnEvpnZoneModel{
+			Controller:       types.StringValue(value("controller")),
+			VrfVxlan:         types.Int64Value(vrfVxlan),
+			ExitNodes:        list("exitnodes", exitNodes),
+			Mac:              optionalString(value("mac")),
+			AdvertiseSubnets: types.BoolValue(value("advertise-subnets") == "1"),
+		}
+	}
+}
+
+func (r *SdnZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SdnZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _ := data.zoneArgs()
+	createArgs["--zone"] = data.Zone.ValueString()
+	createArgs["--type"] = data.zoneType()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/cluster/sdn/zones", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating zone, got error: %s", err))
+		return
+	}
+	data.Type = types.StringValue(data.zoneType())
+
+	// the zone exists from here on, also if the reload fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SdnZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/cluster/sdn/zones", "zone", data.Zone.ValueString())
+	if removeIfMissing(ctx, exists, err, "zone", resp) {
+		return
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/zones/%s", data.Zone.ValueString()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone, got error: %s", err))
+		return
+	}
+
+	zoneType, _ := pveConfigString(config, "type")
+	if !slices.Contains(sdnZoneTypes, zoneType) {
+		resp.Diagnostics.AddError("Unsupported Zone Type", fmt.Sprintf("Zone %s is of type %s, which is not managed by pxc_sdn_zone.", data.Zone.ValueString(), zoneType))
+		return
+	}
+	data.readZone(config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SdnZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SdnZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	setArgs, deletes := data.zoneArgs()
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/zones/%s", data.Zone.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating zone, got error: %s", err))
+		return
+	}
+	data.Type = types.StringValue(data.zoneType())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SdnZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to delete zones that still contain vnets
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/cluster/sdn/zones/%s", data.Zone.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting zone, got error: %s", err))
+		return
+	}
+
+	err = applySdn(ctx, client, r.cloudInventory.TargetPve)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error applying sdn changes, got error: %s", err))
+		return
+	}
+}
+
+func (r *SdnZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone"), req, resp)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SecretsToMapFunction{}
+
+func NewSecretsToMapFunction() function.Function {
+	return &SecretsToMapFunction{}
+}
+
+// SecretsToMapFunction defines the function implementation.
+type SecretsToMapFunction struct{}
+
+func (f *SecretsToMapFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "secrets_to_map"
+}
+
+func (f *SecretsToMapFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Converts a secrets json blob into a map",
+		MarkdownDescription: "Parses the `secrets_data` json of the pxc_cloud_secrets data source into a map of secret name to the json encoded secret value. Accepts both a json object keyed by secret name and a json list of objects with `secret_name` and `secret_data` keys.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "secrets_data",
+				MarkdownDescription: "Secrets json blob as returned by the pxc_cloud_secrets data source.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *SecretsToMapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var secretsData string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &secretsData))
+	if resp.Error != nil {
+		return
+	}
+
+	secrets, err := secretsToMap(secretsData)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse secrets json, got error: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, secrets))
+}
+
+// secretsToMap converts the secrets json into secret name => json encoded secret data.
+func secretsToMap(secretsData string) (map[string]string, error) {
+	secrets := map[string]string{}
+
+	// keyed by secret name
+	var secretsObj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(secretsData), &secretsObj); err == nil {
+		for name, value := range secretsObj {
+			secrets[name] = string(value)
+		}
+		return secrets, nil
+	}
+
+	// list of secret rows
+	var secretsList []struct {
+		SecretName string          `json:"secret_name"`
+		SecretData json.RawMessage `json:"secret_data"`
+	}
+	if err := json.Unmarshal([]byte(secretsData), &secretsList); err != nil {
+		return nil, fmt.Errorf("expected a json object or list of secrets: %s", err)
+	}
+
+	for _, secret := range secretsList {
+		if secret.SecretName == "" {
+			return nil, fmt.Errorf("secret entry without secret_name")
+		}
+		secrets[secret.SecretName] = string(secret.SecretData)
+	}
+
+	return secrets, nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecretsToMap(t *testing.T) {
+	tests := []struct {
+		name        string
+		secretsData string
+		want        map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "object keyed by name",
+			secretsData: `{"db": {"user": "app", "password": "x"}, "token": "abc"}`,
+			want:        map[string]string{"db": `{"user": "app", "password": "x"}`, "token": `"abc"`},
+		},
+		{
+			name:        "list of secret rows",
+			secretsData: `[{"secret_name": "db", "secret_data": {"user": "app"}}, {"secret_name": "token", "secret_data": "abc"}]`,
+			want:        map[string]string{"db": `{"user": "app"}`, "token": `"abc"`},
+		},
+		{
+			name:        "empty object",
+			secretsData: `{}`,
+			want:        map[string]string{},
+		},
+		{
+			name:        "empty list",
+			secretsData: `[]`,
+			want:        map[string]string{},
+		},
+		{
+			name:        "row without secret_name",
+			secretsData: `[{"secret_data": "abc"}]`,
+			wantErr:     true,
+		},
+		{
+			name:        "truncated json",
+			secretsData: `{"db": {"user": `,
+			wantErr:     true,
+		},
+		{
+			name:        "scalar",
+			secretsData: `"db"`,
+			wantErr:     true,
+		},
+		{
+			name:        "empty string",
+			secretsData: ``,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := secretsToMap(tt.secretsData)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("secretsToMap() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("secretsToMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretsToMapFunctionRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		secretsData string
+		want        types.Map
+		wantErr     bool
+	}{
+		{
+			name:        "well formed",
+			secretsData: `{"token": "abc"}`,
+			want:        types.MapValueMust(types.StringType, map[string]attr.Value{"token": types.StringValue(`"abc"`)}),
+		},
+		{
+			name:        "malformed",
+			secretsData: `not json`,
+			want:        types.MapNull(types.StringType),
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.secretsData)})}
+			resp := function.RunResponse{Result: function.NewResultData(types.MapNull(types.StringType))}
+
+			NewSecretsToMapFunction().Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %t", resp.Error, tt.wantErr)
+			}
+			if !resp.Result.Value().Equal(tt.want) {
+				t.Errorf("Run() result = %s, want %s", resp.Result.Value(), tt.want)
+			}
+		})
+	}
+}
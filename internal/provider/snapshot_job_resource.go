@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnapshotJobResource{}
+var _ resource.ResourceWithImportState = &SnapshotJobResource{}
+
+func NewSnapshotJobResource() resource.Resource {
+	return &SnapshotJobResource{}
+}
+
+// SnapshotJobResource defines the resource implementation.
+type SnapshotJobResource struct {
+	cloudInventory CloudInventory
+}
+
+// SnapshotJobResourceModel describes the resource data model.
+type SnapshotJobResourceModel struct {
+	JobId        types.String `tfsdk:"job_id"`
+	VmId         types.Int64  `tfsdk:"vmid"`
+	Schedule     types.String `tfsdk:"schedule"`
+	MaxSnapshots types.Int64  `tfsdk:"max_snapshots"`
+	Prefix       types.String `tfsdk:"prefix"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// SnapshotJob is the subset of pvesh get /cluster/jobs/snapshot/{id} we manage.
+type SnapshotJob struct {
+	VmId         json.Number `json:"vmid"`
+	Schedule     string      `json:"schedule"`
+	MaxSnapshots json.Number `json:"max-snapshots"`
+	Prefix       string      `json:"prefix"`
+}
+
+func (r *SnapshotJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_job"
+}
+
+func (r *SnapshotJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a scheduled snapshot job for a guest via the proxmox cluster jobs api.",
+
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique id of the snapshot job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // lazy replace
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Vmid of the guest to snapshot.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(), // lazy replace
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox calendar event schedule, e.g. `*-*-* 02:00` or `hourly`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // lazy replace
+				},
+			},
+			"max_snapshots": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of snapshots to keep, older ones created by this job are pruned.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(), // lazy replace
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name prefix of the created snapshots.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // lazy replace
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *SnapshotJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *SnapshotJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnapshotJobResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{
+		"--vmid":     strconv.FormatInt(data.VmId.ValueInt64(), 10),
+		"--schedule": data.Schedule.ValueString(),
+	}
+	if !data.MaxSnapshots.IsNull() {
+		createArgs["--max-snapshots"] = strconv.FormatInt(data.MaxSnapshots.ValueInt64(), 10)
+	}
+	if !data.Prefix.IsNull() {
+		createArgs["--prefix"] = data.Prefix.ValueString()
+	}
+
+	// perform the request
+	cresp, err := client.CreateProxmoxApi(ctx, &pb.CreateProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/jobs/snapshot/%s", data.JobId.ValueString()), CreateArgs: createArgs})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make create snapshot job api request, got error: %s", err))
+		return
+	}
+
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error on server side making snapshot job create call, got error: %s", cresp.ErrMessage))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnapshotJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnapshotJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.GetProxmoxApi(ctx, &pb.GetProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/jobs/snapshot/%s", data.JobId.ValueString())})
+	if removeIfMissing(ctx, err == nil, err, "snapshot job", resp) {
+		return
+	}
+
+	var job SnapshotJob
+	err = json.Unmarshal([]byte(cresp.JsonResp), &job)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unmarschal snapshot job, got error: %s", err))
+		return
+	}
+
+	if vmId, err := job.VmId.Int64(); err == nil {
+		data.VmId = types.Int64Value(vmId)
+	}
+	data.Schedule = types.StringValue(job.Schedule)
+	if maxSnapshots, err := job.MaxSnapshots.Int64(); err == nil {
+		data.MaxSnapshots = types.Int64Value(maxSnapshots)
+	}
+	if job.Prefix != "" || !data.Prefix.IsNull() {
+		data.Prefix = types.StringValue(job.Prefix)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnapshotJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"This resource does not support in-place updates. Any change to these attributes "+
+			"should have triggered a replacement. This is a provider bug.",
+	)
+}
+
+func (r *SnapshotJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnapshotJobResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.DeleteProxmoxApi(ctx, &pb.DeleteProxmoxApiRequest{TargetPve: r.cloudInventory.TargetPve, ApiPath: fmt.Sprintf("/cluster/jobs/snapshot/%s", data.JobId.ValueString())})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make delete snapshot job api request, got error: %s", err))
+		return
+	}
+
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error on server side making delete snapshot job call, got error: %s", cresp.ErrMessage))
+		return
+	}
+}
+
+func (r *SnapshotJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("job_id"), req, resp)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"encoding/json"
+	"maps"
+	"strings"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeSnapshotJobs serves /cluster/jobs/snapshot/{id} from jobs, keyed by job id.
+func fakeSnapshotJobs(jobs map[string]map[string]string) *fakeRpcConn {
+	jobId := func(apiPath string) string { return strings.TrimPrefix(apiPath, "/cluster/jobs/snapshot/") }
+
+	return &fakeRpcConn{handlers: map[string]func(proto.Message) (proto.Message, error){
+		"CreateProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			creq := req.(*pb.CreateProxmoxApiRequest)
+			job := map[string]string{}
+			for arg, value := range creq.CreateArgs {
+				job[strings.TrimPrefix(arg, "--")] = value
+			}
+			jobs[jobId(creq.ApiPath)] = job
+			return &pb.CreateProxmoxApiResponse{Success: true}, nil
+		},
+		"GetProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			job, ok := jobs[jobId(req.(*pb.GetProxmoxApiRequest).ApiPath)]
+			if !ok {
+				return nil, status.Error(codes.NotFound, "job does not exist")
+			}
+			jobJson, _ := json.Marshal(job)
+			return &pb.GetProxmoxApiResponse{JsonResp: string(jobJson)}, nil
+		},
+		"DeleteProxmoxApi": func(req proto.Message) (proto.Message, error) {
+			delete(jobs, jobId(req.(*pb.DeleteProxmoxApiRequest).ApiPath))
+			return &pb.DeleteProxmoxApiResponse{Success: true}, nil
+		},
+	}}
+}
+
+func TestSnapshotJobResourceLifecycle(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobId   string
+		plan    map[string]tftypes.Value
+		wantJob map[string]string
+	}{
+		{
+			name:  "schedule only",
+			jobId: "nightly",
+			plan: map[string]tftypes.Value{
+				"vmid":     tftypes.NewValue(tftypes.Number, 100),
+				"schedule": tftypes.NewValue(tftypes.String, "*-*-* 02:00"),
+			},
+			wantJob: map[string]string{"vmid": "100", "schedule": "*-*-* 02:00"},
+		},
+		{
+			name:  "retention and prefix",
+			jobId: "hourly",
+			plan: map[string]tftypes.Value{
+				"vmid":          tftypes.NewValue(tftypes.Number, 101),
+				"schedule":      tftypes.NewValue(tftypes.String, "hourly"),
+				"max_snapshots": tftypes.NewValue(tftypes.Number, 24),
+				"prefix":        tftypes.NewValue(tftypes.String, "auto"),
+			},
+			wantJob: map[string]string{"vmid": "101", "schedule": "hourly", "max-snapshots": "24", "prefix": "auto"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs := map[string]map[string]string{}
+			r := &SnapshotJobResource{cloudInventory: testInventory(fakeSnapshotJobs(jobs))}
+
+			tt.plan["job_id"] = tftypes.NewValue(tftypes.String, tt.jobId)
+			created := createResource(t, r, tt.plan)
+			if !maps.Equal(jobs[tt.jobId], tt.wantJob) {
+				t.Fatalf("created job = %v, want %v", jobs[tt.jobId], tt.wantJob)
+			}
+
+			var state map[string]tftypes.Value
+			if err := created.Raw.As(&state); err != nil {
+				t.Fatalf("unable to convert state: %s", err)
+			}
+			read := readResource(t, r, state)
+			if !read.Raw.Equal(created.Raw) {
+				t.Errorf("Read() state = %s, want %s", read.Raw, created.Raw)
+			}
+
+			deleteResource(t, r, read)
+			if _, ok := jobs[tt.jobId]; ok {
+				t.Fatalf("job %s still exists after Delete()", tt.jobId)
+			}
+
+			// a deleted job is dropped from state on the next refresh
+			if gone := readResource(t, r, state); !gone.Raw.IsNull() {
+				t.Errorf("Read() after delete state = %s, want removed", gone.Raw)
+			}
+		})
+	}
+}
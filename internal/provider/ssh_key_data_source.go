@@ -36,7 +36,8 @@ func (d *SshKeyDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *SshKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetch different ssh keys from proxmox cloud based on key type.",
+		MarkdownDescription: "Fetch different ssh keys from proxmox cloud based on key type. The keys are private keys, " +
+			"use the ephemeral pxc_ssh_key to keep them out of the state.",
 
 		Attributes: map[string]schema.Attribute{
 			"key_type": schema.StringAttribute{
@@ -48,6 +49,7 @@ func (d *SshKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 			"key": schema.StringAttribute{
 				Computed:            true,
+				Sensitive:           true,
 				MarkdownDescription: "The raw key",
 			},
 		},
@@ -89,7 +91,7 @@ func (d *SshKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	client, err := GetCloudRpcService(ctx)
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
 		return
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &SshKeyEphemeralResource{}
+
+func NewSshKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &SshKeyEphemeralResource{}
+}
+
+// SshKeyEphemeralResource defines the ephemeral resource implementation.
+type SshKeyEphemeralResource struct {
+	cloudInventory CloudInventory
+}
+
+// SshKeyEphemeralResourceModel describes the ephemeral resource data model.
+type SshKeyEphemeralResourceModel struct {
+	KeyType types.String `tfsdk:"key_type"`
+	Key     types.String `tfsdk:"key"`
+}
+
+func (r *SshKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *SshKeyEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get ssh keys from proxmox cloud based on key type without persisting them in the state, like the pxc_ssh_key data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"key_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AUTOMATION", "PVE_HOST_RSA"),
+				},
+				MarkdownDescription: "Specified key type enum",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The raw key",
+			},
+		},
+	}
+}
+
+func (r *SshKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Always perform a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *SshKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SshKeyEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// convert ephemeral resource arg to keytype
+	keyTypeInt, ok := pb.GetSshKeyRequest_KeyType_value[data.KeyType.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddError("Unknown key", fmt.Sprintf("unknown key type: %s", data.KeyType.ValueString()))
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// perform the request
+	cresp, err := client.GetSshKey(ctx, &pb.GetSshKeyRequest{TargetPve: r.cloudInventory.TargetPve, KeyType: pb.GetSshKeyRequest_KeyType(keyTypeInt)})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get ssh key, got error: %s", err))
+		return
+	}
+
+	data.Key = types.StringValue(cresp.Key)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StorageContentDataSource{}
+
+func NewStorageContentDataSource() datasource.DataSource {
+	return &StorageContentDataSource{}
+}
+
+// StorageContentDataSource defines the data source implementation.
+type StorageContentDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// StorageContentDataSourceModel describes the data source data model.
+type StorageContentDataSourceModel struct {
+	Node       types.String              `tfsdk:"node"`
+	Storage    types.String              `tfsdk:"storage"`
+	Content    types.String              `tfsdk:"content"`
+	VmId       types.Int64               `tfsdk:"vmid"`
+	VolIdRegex types.String              `tfsdk:"volid_regex"`
+	Volumes    []StorageContentItemModel `tfsdk:"volumes"`
+}
+
+// StorageContentItemModel describes a single volume of the storage.
+type StorageContentItemModel struct {
+	VolId     types.String `tfsdk:"volid"`
+	Content   types.String `tfsdk:"content"`
+	Format    types.String `tfsdk:"format"`
+	Size      types.Int64  `tfsdk:"size"`
+	Ctime     types.Int64  `tfsdk:"ctime"`
+	VmId      types.Int64  `tfsdk:"vmid"`
+	Notes     types.String `tfsdk:"notes"`
+	Protected types.Bool   `tfsdk:"protected"`
+}
+
+// pveStorageContent is an entry of /nodes/{node}/storage/{storage}/content.
+type pveStorageContent struct {
+	VolId     string  `json:"volid"`
+	Content   string  `json:"content"`
+	Format    string  `json:"format"`
+	Size      int64   `json:"size"`
+	Ctime     int64   `json:"ctime"`
+	VmId      *int64  `json:"vmid"`
+	Notes     string  `json:"notes"`
+	Protected pveBool `json:"protected"`
+}
+
+func (d *StorageContentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_content"
+}
+
+func (d *StorageContentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the iso images, lxc templates, backups, disk images and snippets on a storage, " +
+			"e.g. to reference an existing artifact by its volid or pick the latest backup of a guest.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the storage is listed on, for shared storages any node that has it.",
+			},
+			"storage": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the storage.",
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return volumes of this content type.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("iso", "vztmpl", "backup", "images", "rootdir", "import", "snippets"),
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return disks and backups of this guest.",
+			},
+			"volid_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return volumes whose volid matches this regular expression.",
+			},
+			"volumes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Matching volumes sorted by volid, backups of a guest are ordered from oldest to newest.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"volid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Volume id, e.g. `local:iso/debian-12.iso`.",
+						},
+						"content": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Content type of the volume.",
+						},
+						"format": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Format of the volume, e.g. `iso`, `qcow2` or `vma.zst`.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size in bytes.",
+						},
+						"ctime": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Creation time as unix timestamp, 0 if the storage doesn't track it.",
+						},
+						"vmid": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Guest owning the disk or backup, null for other content.",
+						},
+						"notes": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Notes of a backup.",
+						},
+						"protected": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "If the backup is protected from pruning and deletion.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageContentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *StorageContentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StorageContentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var volIdRe *regexp.Regexp
+	if !data.VolIdRegex.IsNull() {
+		var err error
+		volIdRe, err = regexp.Compile(data.VolIdRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid volid_regex", err.Error())
+			return
+		}
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// content and vmid are filtered by proxmox
+	args := map[string]string{}
+	if !data.Content.IsNull() {
+		args["--content"] = data.Content.ValueString()
+	}
+	if !data.VmId.IsNull() {
+		args["--vmid"] = fmt.Sprint(data.VmId.ValueInt64())
+	}
+
+	var entries []pveStorageContent
+	apiPath := fmt.Sprintf("/nodes/%s/storage/%s/content", data.Node.ValueString(), data.Storage.ValueString())
+	err = getPveApiJsonArgs(ctx, client, d.cloudInventory.TargetPve, apiPath, args, &entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list content of %s, got error: %s", data.Storage.ValueString(), err))
+		return
+	}
+
+	slices.SortFunc(entries, func(a, b pveStorageContent) int {
+		return strings.Compare(a.VolId, b.VolId)
+	})
+
+	data.Volumes = []StorageContentItemModel{}
+	for _, entry := range entries {
+		if volIdRe != nil && !volIdRe.MatchString(entry.VolId) {
+			continue
+		}
+
+		data.Volumes = append(data.Volumes, StorageContentItemModel{
+			VolId:     types.StringValue(entry.VolId),
+			Content:   types.StringValue(entry.Content),
+			Format:    types.StringValue(entry.Format),
+			Size:      types.Int64Value(entry.Size),
+			Ctime:     types.Int64Value(entry.Ctime),
+			VmId:      types.Int64PointerValue(entry.VmId),
+			Notes:     optionalString(entry.Notes),
+			Protected: types.BoolValue(bool(entry.Protected)),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
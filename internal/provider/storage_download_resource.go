@@ -0,0 +1,410 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StorageDownloadResource{}
+
+// file names proxmox accepts per content type of a storage
+var storageDownloadFilenameRes = map[string]*regexp.Regexp{
+	"iso":    regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-]*\.(iso|img)$`),
+	"vztmpl": regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-]*\.tar\.(gz|xz|zst|bz2)$`),
+	"import": regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-]*\.(qcow2|raw|vmdk|ova)$`),
+}
+
+var storageDownloadChecksumAlgorithms = []string{"md5", "sha1", "sha224", "sha256", "sha384", "sha512"}
+
+func NewStorageDownloadResource() resource.Resource {
+	return &StorageDownloadResource{}
+}
+
+// StorageDownloadResource defines the resource implementation.
+type StorageDownloadResource struct {
+	cloudInventory CloudInventory
+}
+
+// StorageDownloadResourceModel describes the resource data model.
+type StorageDownloadResourceModel struct {
+	Node               types.String `tfsdk:"node"`
+	Storage            types.String `tfsdk:"storage"`
+	Content            types.String `tfsdk:"content"`
+	Url                types.String `tfsdk:"url"`
+	Filename           types.String `tfsdk:"filename"`
+	Checksum           types.String `tfsdk:"checksum"`
+	ChecksumAlgorithm  types.String `tfsdk:"checksum_algorithm"`
+	VerifyCertificates types.Bool   `tfsdk:"verify_certificates"`
+	VolumeId           types.String `tfsdk:"volume_id"`
+	Size               types.Int64  `tfsdk:"size"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// storageDownload describes a file downloaded to the content of a storage with the download-url api.
+type storageDownload struct {
+	Node               string
+	Storage            string
+	Content            string
+	Url                string
+	Filename           string
+	Checksum           string
+	ChecksumAlgorithm  string
+	VerifyCertificates bool
+}
+
+// volumeId returns the volume id the file is stored as.
+func (download storageDownload) volumeId() string {
+	return fmt.Sprintf("%s:%s/%s", download.Storage, download.Content, download.Filename)
+}
+
+// storageDownloadFilename returns filename or the last segment of the url, checked against the names
+// proxmox accepts for content.
+func storageDownloadFilename(content string, downloadUrl string, filename types.String) (string, error) {
+	name := filename.ValueString()
+	if filename.IsNull() || filename.IsUnknown() {
+		parsed, err := url.Parse(downloadUrl)
+		if err != nil {
+			return "", err
+		}
+		name = path.Base(parsed.Path)
+	}
+
+	if re, ok := storageDownloadFilenameRes[content]; ok && !re.MatchString(name) {
+		return "", fmt.Errorf("%s is no valid file name for %s content, set a file name matching %s", name, content, re)
+	}
+	return name, nil
+}
+
+// findStorageContent looks up a volume in the content of a storage and returns its size, nil if it doesn't exist.
+func findStorageContent(ctx context.Context, client pb.CloudServiceClient, targetPve string, node string, storage string, content string, volumeId string) (*int64, error) {
+	var entries []map[string]interface{}
+	err := getPveApiJsonArgs(ctx, client, targetPve, fmt.Sprintf("/nodes/%s/storage/%s/content", node, storage), map[string]string{"--content": content}, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if volid, _ := pveConfigString(entry, "volid"); volid == volumeId {
+			size, _ := pveConfigInt(entry, "size")
+			return &size, nil
+		}
+	}
+	return nil, nil
+}
+
+// downloadToStorage downloads a file with the download-url api and waits up to timeout seconds for it.
+// Proxmox refuses to overwrite files, an existing file is deleted first if replace is set.
+func downloadToStorage(ctx context.Context, client pb.CloudServiceClient, targetPve string, download storageDownload, replace bool, timeout int64) error {
+	storagePath := fmt.Sprintf("/nodes/%s/storage/%s", download.Node, download.Storage)
+
+	if replace {
+		size, err := findStorageContent(ctx, client, targetPve, download.Node, download.Storage, download.Content, download.volumeId())
+		if err != nil {
+			return err
+		}
+		if size != nil {
+			err = pveApiCallWait(ctx, client, targetPve, "DELETE", storagePath+"/content/"+download.volumeId(), nil, true, timeout)
+			if err != nil {
+				return fmt.Errorf("deleting existing %s: %w", download.volumeId(), err)
+			}
+		}
+	}
+
+	args := map[string]string{
+		"--content":             download.Content,
+		"--filename":            download.Filename,
+		"--url":                 download.Url,
+		"--verify-certificates": boolToPve(download.VerifyCertificates),
+	}
+	if download.Checksum != "" {
+		args["--checksum"] = download.Checksum
+		args["--checksum-algorithm"] = download.ChecksumAlgorithm
+	}
+
+	return pveApiCallWait(ctx, client, targetPve, "POST", storagePath+"/download-url", args, true, timeout)
+}
+
+func (r *StorageDownloadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_download"
+}
+
+func (r *StorageDownloadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Downloads an iso image, lxc template or disk image to the content of a storage with the proxmox download-url api. " +
+			"Proxmox verifies the checksum after the download. The file is downloaded again if the url or checksum change, " +
+			"or if it was deleted or its size changed outside of terraform. Deleting the resource deletes the file.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node that downloads the file, for shared storages any node that has it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "File based storage the file is stored on, it needs the content type enabled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Content type of the file, `iso` for iso images, `vztmpl` for lxc templates or `import` for vm disk images.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("iso", "vztmpl", "import"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Url the file is downloaded from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "File name the file is stored as, the last segment of the url if not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Expected checksum of the file, the download fails on a mismatch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sha256"),
+				MarkdownDescription: "Algorithm of the checksum.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(storageDownloadChecksumAlgorithms...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"verify_certificates": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Verifies the tls certificate of the url.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"volume_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Volume id of the file, e.g. `local:iso/debian-12.iso`, for the cdrom of a vm or the template of an lxc container.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size of the file in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1800),
+				MarkdownDescription: "Seconds to wait for the download.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *StorageDownloadResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *StorageDownloadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StorageDownloadResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	filename, err := storageDownloadFilename(data.Content.ValueString(), data.Url.ValueString(), data.Filename)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid File Name", err.Error())
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	download := storageDownload{
+		Node:               data.Node.ValueString(),
+		Storage:            data.Storage.ValueString(),
+		Content:            data.Content.ValueString(),
+		Url:                data.Url.ValueString(),
+		Filename:           filename,
+		Checksum:           data.Checksum.ValueString(),
+		ChecksumAlgorithm:  data.ChecksumAlgorithm.ValueString(),
+		VerifyCertificates: data.VerifyCertificates.ValueBool(),
+	}
+
+	// a file left from a previous run might be outdated or incomplete, so it is always downloaded again
+	err = downloadToStorage(ctx, client, r.cloudInventory.TargetPve, download, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to download %s, got error: %s", data.Url.ValueString(), err))
+		return
+	}
+
+	size, err := findStorageContent(ctx, client, r.cloudInventory.TargetPve, download.Node, download.Storage, download.Content, download.volumeId())
+	if err != nil || size == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find downloaded %s, got error: %v", download.volumeId(), err))
+		return
+	}
+
+	data.Filename = types.StringValue(filename)
+	data.VolumeId = types.StringValue(download.volumeId())
+	data.Size = types.Int64Value(*size)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageDownloadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageDownloadResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	size, err := findStorageContent(ctx, client, r.cloudInventory.TargetPve, data.Node.ValueString(), data.Storage.ValueString(), data.Content.ValueString(), data.VolumeId.ValueString())
+	if removeIfMissing(ctx, size != nil, err, "downloaded file", resp) {
+		return
+	}
+
+	// proxmox can't hash stored files, a changed size is the sign that the file isn't the verified download anymore
+	if *size != data.Size.ValueInt64() {
+		removeIfMissing(ctx, false, nil, "verified download "+data.VolumeId.ValueString(), resp)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageDownloadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StorageDownloadResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the timeout can change in place
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageDownloadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StorageDownloadResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// fails while a guest still uses the file, e.g. as cdrom
+	apiPath := fmt.Sprintf("/nodes/%s/storage/%s/content/%s", data.Node.ValueString(), data.Storage.ValueString(), data.VolumeId.ValueString())
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", apiPath, nil, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to delete %s, got error: %s", data.VolumeId.ValueString(), err))
+		return
+	}
+}
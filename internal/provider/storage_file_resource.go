@@ -0,0 +1,294 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StorageFileResource{}
+var _ resource.ResourceWithImportState = &StorageFileResource{}
+
+// file names of snippets, the volume id is built from it
+var storageFileFilenameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-]*$`)
+
+func NewStorageFileResource() resource.Resource {
+	return &StorageFileResource{}
+}
+
+// StorageFileResource defines the resource implementation.
+type StorageFileResource struct {
+	cloudInventory CloudInventory
+}
+
+// StorageFileResourceModel describes the resource data model.
+type StorageFileResourceModel struct {
+	Node          types.String `tfsdk:"node"`
+	Storage       types.String `tfsdk:"storage"`
+	Filename      types.String `tfsdk:"filename"`
+	Content       types.String `tfsdk:"content"`
+	Executable    types.Bool   `tfsdk:"executable"`
+	ContentSha256 types.String `tfsdk:"content_sha256"`
+	VolumeId      types.String `tfsdk:"volume_id"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// storageFileSha256 hashes content like sha256sum on the node.
+func storageFileSha256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *StorageFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_file"
+}
+
+func (r *StorageFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a snippet, e.g. cloud-init user-data / vendor-data or a hook script, to a storage with the `snippets` content type enabled. " +
+			"The proxmox upload api doesn't support snippets, the file is written on the node via ssh instead. " +
+			"Its sha256 is compared on every refresh, the file is written again if it was changed outside of terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the file is written on, for shared storages any node that has it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "File based storage the file is stored on, it needs the `snippets` content type enabled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "File name in the snippets directory of the storage, e.g. `user-data.yaml`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(storageFileFilenameRe, "must be a plain file name"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Content of the file, e.g. from `file()` or `templatefile()`.",
+			},
+			"executable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Makes the file executable, required for hook scripts.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hex encoded sha256 of the content, e.g. for `replace_triggered_by` of vms using the snippet.",
+			},
+			"volume_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Volume id of the file, e.g. `local:snippets/user-data.yaml`, for the `cicustom` or `hookscript` option of a guest.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *StorageFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// write uploads the content of data and sets the computed attributes.
+func (r *StorageFileResource) write(ctx context.Context, data *StorageFileResourceModel) error {
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return err
+	}
+
+	volumeId := fmt.Sprintf("%s:snippets/%s", data.Storage.ValueString(), data.Filename.ValueString())
+	_, err = client.WriteStorageFile(ctx, &pb.WriteStorageFileRequest{
+		TargetPve:  r.cloudInventory.TargetPve,
+		Node:       data.Node.ValueString(),
+		VolumeId:   volumeId,
+		Content:    []byte(data.Content.ValueString()),
+		Executable: data.Executable.ValueBool(),
+	})
+	if err != nil {
+		return err
+	}
+
+	data.VolumeId = types.StringValue(volumeId)
+	data.ContentSha256 = types.StringValue(storageFileSha256(data.Content.ValueString()))
+	return nil
+}
+
+func (r *StorageFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StorageFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	err := r.write(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to write %s, got error: %s", data.Filename.ValueString(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	hash, err := client.HashStorageFile(ctx, &pb.HashStorageFileRequest{
+		TargetPve: r.cloudInventory.TargetPve,
+		Node:      data.Node.ValueString(),
+		VolumeId:  data.VolumeId.ValueString(),
+	})
+	if removeIfMissing(ctx, true, err, "storage file "+data.VolumeId.ValueString(), resp) {
+		return
+	}
+
+	// the content is unknown after outside changes, clearing it plans the rewrite
+	if hash.Sha256 != data.ContentSha256.ValueString() {
+		data.Content = types.StringNull()
+		data.ContentSha256 = types.StringValue(hash.Sha256)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StorageFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	err := r.write(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to write %s, got error: %s", data.Filename.ValueString(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StorageFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	_, err = client.DeleteStorageFile(ctx, &pb.DeleteStorageFileRequest{
+		TargetPve: r.cloudInventory.TargetPve,
+		Node:      data.Node.ValueString(),
+		VolumeId:  data.VolumeId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to delete %s, got error: %s", data.VolumeId.ValueString(), err))
+		return
+	}
+}
+
+// ImportState takes `<node>/<storage>/<filename>`, the content is written on the next apply.
+func (r *StorageFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || !storageFileFilenameRe.MatchString(parts[2]) {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <node>/<storage>/<filename>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storage"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("filename"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("executable"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), fmt.Sprintf("%s:snippets/%s", parts[1], parts[2]))...)
+}
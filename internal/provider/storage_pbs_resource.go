@@ -0,0 +1,405 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StoragePbsResource{}
+var _ resource.ResourceWithImportState = &StoragePbsResource{}
+
+func NewStoragePbsResource() resource.Resource {
+	return &StoragePbsResource{}
+}
+
+// StoragePbsResource defines the resource implementation.
+type StoragePbsResource struct {
+	cloudInventory CloudInventory
+}
+
+// StoragePbsResourceModel describes the resource data model.
+type StoragePbsResourceModel struct {
+	StorageId      types.String         `tfsdk:"storage_id"`
+	Server         types.String         `tfsdk:"server"`
+	Port           types.Int64          `tfsdk:"port"`
+	Datastore      types.String         `tfsdk:"datastore"`
+	Namespace      types.String         `tfsdk:"namespace"`
+	Username       types.String         `tfsdk:"username"`
+	Password       types.String         `tfsdk:"password"`
+	PasswordSecret *CloudSecretRefModel `tfsdk:"password_secret"`
+	Fingerprint    types.String         `tfsdk:"fingerprint"`
+	EncryptionKey  types.String         `tfsdk:"encryption_key"`
+	Nodes          []string             `tfsdk:"nodes"`
+	Disable        types.Bool           `tfsdk:"disable"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveStorage is the subset of pvesh get /storage/{storage} shared by the storage types.
+type PveStorage struct {
+	Type        string      `json:"type"`
+	Server      string      `json:"server"`
+	Port        json.Number `json:"port"`
+	Datastore   string      `json:"datastore"`
+	Namespace   string      `json:"namespace"`
+	Username    string      `json:"username"`
+	Fingerprint string      `json:"fingerprint"`
+	Nodes       string      `json:"nodes"`
+	Disable     pveBool     `json:"disable"`
+}
+
+func (r *StoragePbsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_pbs"
+}
+
+func (r *StoragePbsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a proxmox backup server datastore as backup storage to the cluster. Import with `<storage_id>`, the password and encryption key can't be read back.",
+
+		Attributes: map[string]schema.Attribute{
+			"storage_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the storage in the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Address of the backup server.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Api port of the backup server, proxmox uses 8007 if not set.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"datastore": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the datastore on the backup server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Namespace inside the datastore, e.g. to share a datastore between clusters.",
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "User or api token id on the backup server, e.g. `backup@pbs` or `backup@pbs!pve`.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password or api token secret. Exactly one of password or password_secret has to be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_secret")),
+				},
+			},
+			"password_secret": func() schema.SingleNestedAttribute {
+				attribute := cloudSecretRefAttribute("Cloud secret holding the password or api token secret. The secret is resolved on every apply, changes to it are only picked up together with another change.")
+				attribute.Validators = []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("password")),
+				}
+				return attribute
+			}(),
+			"fingerprint": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sha256 fingerprint of the backup server certificate, required for self signed certificates.",
+			},
+			"encryption_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Client side encryption key as json or `autogen` to let proxmox generate one. Keep a copy of the key, backups can't be restored without it. Changing it recreates the storage.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nodes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Nodes the storage is available on, all nodes if not set.",
+			},
+			"disable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Disables the storage without removing it.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *StoragePbsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// storageArgs returns the updatable pvesh args of the storage, together with the
+// optional keys that are unset.
+func (r *StoragePbsResource) storageArgs(ctx context.Context, client pb.CloudServiceClient, data StoragePbsResourceModel) (map[string]string, []string, error) {
+	password := data.Password.ValueString()
+	if data.PasswordSecret != nil {
+		var err error
+		password, err = resolveCloudSecretRef(ctx, client, r.cloudInventory, data.PasswordSecret)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	args := map[string]string{
+		"--server":   data.Server.ValueString(),
+		"--username": data.Username.ValueString(),
+		"--password": password,
+		"--disable":  boolToPve(data.Disable.ValueBool()),
+	}
+	deletes := []string{}
+
+	optional := map[string]string{}
+	if !data.Port.IsNull() {
+		optional["port"] = strconv.FormatInt(data.Port.ValueInt64(), 10)
+	}
+	if !data.Namespace.IsNull() {
+		optional["namespace"] = data.Namespace.ValueString()
+	}
+	if !data.Fingerprint.IsNull() {
+		optional["fingerprint"] = data.Fingerprint.ValueString()
+	}
+	if len(data.Nodes) > 0 {
+		optional["nodes"] = strings.Join(data.Nodes, ",")
+	}
+
+	for _, key := range []string{"port", "namespace", "fingerprint", "nodes"} {
+		if value, ok := optional[key]; ok {
+			args["--"+key] = value
+		} else {
+			deletes = append(deletes, key)
+		}
+	}
+
+	return args, deletes, nil
+}
+
+func (r *StoragePbsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StoragePbsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, _, err := r.storageArgs(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve password, got error: %s", err))
+		return
+	}
+	createArgs["--storage"] = data.StorageId.ValueString()
+	createArgs["--type"] = "pbs"
+	createArgs["--datastore"] = data.Datastore.ValueString()
+	createArgs["--content"] = "backup"
+	if !data.EncryptionKey.IsNull() {
+		createArgs["--encryption-key"] = data.EncryptionKey.ValueString()
+	}
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/storage", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating pbs storage, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StoragePbsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StoragePbsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/storage", "storage", data.StorageId.ValueString())
+	if removeIfMissing(ctx, exists, err, "pbs storage", resp) {
+		return
+	}
+
+	var storage PveStorage
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/storage/%s", data.StorageId.ValueString()), &storage)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pbs storage, got error: %s", err))
+		return
+	}
+	if storage.Type != "pbs" {
+		resp.Diagnostics.AddError("Storage Type Mismatch", fmt.Sprintf("Storage %s is of type %s, expected pbs.", data.StorageId.ValueString(), storage.Type))
+		return
+	}
+
+	// the password and encryption key are kept in /etc/pve/priv and not returned
+	data.Server = types.StringValue(storage.Server)
+	data.Datastore = types.StringValue(storage.Datastore)
+	data.Username = types.StringValue(storage.Username)
+	data.Namespace = optionalString(storage.Namespace)
+	data.Fingerprint = optionalString(storage.Fingerprint)
+	data.Disable = types.BoolValue(bool(storage.Disable))
+
+	data.Port = types.Int64Null()
+	if port, err := storage.Port.Int64(); err == nil {
+		data.Port = types.Int64Value(port)
+	}
+
+	nodes := splitPveList(storage.Nodes)
+	if !slices.Equal(sortedStrings(nodes), sortedStrings(data.Nodes)) {
+		data.Nodes = nodes
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StoragePbsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StoragePbsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// unset optional values have to be deleted explicitly
+	setArgs, deletes, err := r.storageArgs(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve password, got error: %s", err))
+		return
+	}
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/storage/%s", data.StorageId.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating pbs storage, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StoragePbsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StoragePbsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// backups on the server are kept, only the storage definition is removed
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/storage/%s", data.StorageId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting pbs storage, got error: %s", err))
+		return
+	}
+}
+
+func (r *StoragePbsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("storage_id"), req, resp)
+}
+
+// splitPveList splits a comma separated pve list, nil if empty.
+func splitPveList(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
+}
+
+// sortedStrings returns a sorted copy, sets are compared order independent.
+func sortedStrings(values []string) []string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,646 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StorageResource{}
+var _ resource.ResourceWithImportState = &StorageResource{}
+
+// storage types managed by pxc_storage, pbs has its own resource
+var storageTypes = []string{"dir", "nfs", "cifs", "lvm", "lvmthin", "zfspool", "rbd"}
+
+// content types a storage can hold
+var storageContentTypes = []string{"images", "rootdir", "vztmpl", "iso", "backup", "snippets", "import"}
+
+func NewStorageResource() resource.Resource {
+	return &StorageResource{}
+}
+
+// StorageResource defines the resource implementation.
+type StorageResource struct {
+	cloudInventory CloudInventory
+}
+
+// StorageResourceModel describes the resource data model.
+type StorageResourceModel struct {
+	StorageId types.String `tfsdk:"storage_id"`
+	Type      types.String `tfsdk:"type"`
+	Content   []string     `tfsdk:"content"`
+	Nodes     []string     `tfsdk:"nodes"`
+	Disable   types.Bool   `tfsdk:"disable"`
+
+	Dir     *StorageDirModel     `tfsdk:"dir"`
+	Nfs     *StorageNfsModel     `tfsdk:"nfs"`
+	Cifs    *StorageCifsModel    `tfsdk:"cifs"`
+	Lvm     *StorageLvmModel     `tfsdk:"lvm"`
+	LvmThin *StorageLvmThinModel `tfsdk:"lvmthin"`
+	ZfsPool *StorageZfsPoolModel `tfsdk:"zfspool"`
+	Rbd     *StorageRbdModel     `tfsdk:"rbd"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// StorageDirModel describes a directory storage.
+type StorageDirModel struct {
+	Path   types.String `tfsdk:"path"`
+	Shared types.Bool   `tfsdk:"shared"`
+}
+
+// StorageNfsModel describes a nfs storage.
+type StorageNfsModel struct {
+	Server  types.String `tfsdk:"server"`
+	Export  types.String `tfsdk:"export"`
+	Options types.String `tfsdk:"options"`
+}
+
+// StorageCifsModel describes a cifs storage.
+type StorageCifsModel struct {
+	Server         types.String         `tfsdk:"server"`
+	Share          types.String         `tfsdk:"share"`
+	Domain         types.String         `tfsdk:"domain"`
+	Username       types.String         `tfsdk:"username"`
+	Password       types.String         `tfsdk:"password"`
+	PasswordSecret *CloudSecretRefModel `tfsdk:"password_secret"`
+	SmbVersion     types.String         `tfsdk:"smb_version"`
+}
+
+// StorageLvmModel describes a lvm storage.
+type StorageLvmModel struct {
+	VgName types.String `tfsdk:"vgname"`
+	Shared types.Bool   `tfsdk:"shared"`
+}
+
+// StorageLvmThinModel describes a lvm thin pool storage.
+type StorageLvmThinModel struct {
+	VgName   types.String `tfsdk:"vgname"`
+	ThinPool types.String `tfsdk:"thinpool"`
+}
+
+// StorageZfsPoolModel describes a zfs pool storage.
+type StorageZfsPoolModel struct {
+	Pool      types.String `tfsdk:"pool"`
+	Sparse    types.Bool   `tfsdk:"sparse"`
+	BlockSize types.String `tfsdk:"blocksize"`
+}
+
+// StorageRbdModel describes a ceph rbd storage.
+type StorageRbdModel struct {
+	Pool     types.String `tfsdk:"pool"`
+	MonHost  []string     `tfsdk:"monhost"`
+	Username types.String `tfsdk:"username"`
+	Keyring  types.String `tfsdk:"keyring"`
+	Krbd     types.Bool   `tfsdk:"krbd"`
+}
+
+func (r *StorageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage"
+}
+
+func (r *StorageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// exactly one of the type blocks has to be set
+	typeBlock := func(name string, description string, attributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+		others := []path.Expression{}
+		for _, storageType := range storageTypes {
+			if storageType != name {
+				others = append(others, path.MatchRoot(storageType))
+			}
+		}
+		return schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: description,
+			Attributes:          attributes,
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(others...),
+			},
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+				}, "Changing the storage type recreates the storage.", "Changing the storage type recreates the storage."),
+			},
+		}
+	}
+	fixed := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: description + " Changing it recreates the storage.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	shared := schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Marks the storage as available on all nodes with the same content, e.g. a shared san lun or cluster filesystem.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a storage of the cluster (`/storage`). The type is selected by setting exactly one of the type attributes, e.g. `nfs`. Import with `<storage_id>`, passwords and keyrings can't be read back.",
+
+		Attributes: map[string]schema.Attribute{
+			"storage_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the storage in the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Storage type, derived from the type attribute that is set.",
+			},
+			"content": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Content types of the storage, one of %s. Proxmox picks the defaults of the type if not set.", strings.Join(storageContentTypes, ", ")),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(storageContentTypes...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nodes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Nodes the storage is available on, all nodes if not set.",
+			},
+			"disable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Disables the storage without removing it.",
+			},
+			"dir": typeBlock("dir", "Directory on the nodes.", map[string]schema.Attribute{
+				"path":   fixed("Absolute path of the directory."),
+				"shared": shared,
+			}),
+			"nfs": typeBlock("nfs", "Nfs export, mounted by proxmox on all nodes.", map[string]schema.Attribute{
+				"server": fixed("Address of the nfs server."),
+				"export": fixed("Exported path on the server."),
+				"options": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Nfs mount options, e.g. `vers=4.2`.",
+				},
+			}),
+			"cifs": typeBlock("cifs", "Cifs/smb share, mounted by proxmox on all nodes.", map[string]schema.Attribute{
+				"server": fixed("Address of the cifs server."),
+				"share":  fixed("Name of the share."),
+				"domain": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Domain of the user.",
+				},
+				"username": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "User for the share, guest access if not set.",
+				},
+				"password": schema.StringAttribute{
+					Optional:            true,
+					Sensitive:           true,
+					MarkdownDescription: "Password of the user.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password_secret")),
+					},
+				},
+				"password_secret": cloudSecretRefAttribute("Cloud secret holding the password of the user."),
+				"smb_version": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Smb protocol version, e.g. `3.11` or `default`.",
+				},
+			}),
+			"lvm": typeBlock("lvm", "Lvm volume group, disks are thick provisioned.", map[string]schema.Attribute{
+				"vgname": fixed("Name of the volume group."),
+				"shared": shared,
+			}),
+			"lvmthin": typeBlock("lvmthin", "Lvm thin pool.", map[string]schema.Attribute{
+				"vgname":   fixed("Name of the volume group."),
+				"thinpool": fixed("Name of the thin pool in the volume group."),
+			}),
+			"zfspool": typeBlock("zfspool", "Zfs pool or dataset on the nodes.", map[string]schema.Attribute{
+				"pool": fixed("Pool or dataset, e.g. `rpool/data`."),
+				"sparse": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "Creates thin provisioned zvols.",
+				},
+				"blocksize": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Block size of new zvols, e.g. `16k`.",
+				},
+			}),
+			"rbd": typeBlock("rbd", "Ceph rbd pool, of the cluster itself or of an external ceph cluster.", map[string]schema.Attribute{
+				"pool": fixed("Name of the ceph pool."),
+				"monhost": schema.ListAttribute{
+					ElementType:         types.StringType,
+					Optional:            true,
+					MarkdownDescription: "Monitors of an external ceph cluster, the ceph of the cluster itself is used if not set.",
+				},
+				"username": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Ceph client of an external cluster, e.g. `admin`.",
+				},
+				"keyring": schema.StringAttribute{
+					Optional:            true,
+					Sensitive:           true,
+					MarkdownDescription: "Keyring of the ceph client of an external cluster. Changing it recreates the storage.",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"krbd": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "Maps the disks through the kernel rbd module instead of librbd.",
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			}),
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *StorageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// storageType returns the type of the set type attribute.
+func (data StorageResourceModel) storageType() string {
+	switch {
+	case data.Dir != nil:
+		return "dir"
+	case data.Nfs != nil:
+		return "nfs"
+	case data.Cifs != nil:
+		return "cifs"
+	case data.Lvm != nil:
+		return "lvm"
+	case data.LvmThin != nil:
+		return "lvmthin"
+	case data.ZfsPool != nil:
+		return "zfspool"
+	case data.Rbd != nil:
+		return "rbd"
+	}
+	return ""
+}
+
+// storageArgs returns the pvesh args of the storage, together with the optional keys that are unset.
+// Fixed args can only be passed on creation.
+func (r *StorageResource) storageArgs(ctx context.Context, client pb.CloudServiceClient, data StorageResourceModel) (map[string]string, map[string]string, []string, error) {
+	args := map[string]string{
+		"--disable": boolToPve(data.Disable.ValueBool()),
+	}
+	fixed := map[string]string{}
+	optional := map[string]string{}
+	optionalKeys := []string{"content", "nodes"}
+
+	if len(data.Content) > 0 {
+		optional["content"] = strings.Join(data.Content, ",")
+	}
+	if len(data.Nodes) > 0 {
+		optional["nodes"] = strings.Join(data.Nodes, ",")
+	}
+
+	switch {
+	case data.Dir != nil:
+		fixed["--path"] = data.Dir.Path.ValueString()
+		args["--shared"] = boolToPve(data.Dir.Shared.ValueBool())
+	case data.Nfs != nil:
+		fixed["--server"] = data.Nfs.Server.ValueString()
+		fixed["--export"] = data.Nfs.Export.ValueString()
+		optionalKeys = append(optionalKeys, "options")
+		if !data.Nfs.Options.IsNull() {
+			optional["options"] = data.Nfs.Options.ValueString()
+		}
+	case data.Cifs != nil:
+		fixed["--server"] = data.Cifs.Server.ValueString()
+		fixed["--share"] = data.Cifs.Share.ValueString()
+		optionalKeys = append(optionalKeys, "domain", "username", "password", "smbversion")
+		if !data.Cifs.Domain.IsNull() {
+			optional["domain"] = data.Cifs.Domain.ValueString()
+		}
+		if !data.Cifs.Username.IsNull() {
+			optional["username"] = data.Cifs.Username.ValueString()
+		}
+		if !data.Cifs.Password.IsNull() {
+			optional["password"] = data.Cifs.Password.ValueString()
+		}
+		if data.Cifs.PasswordSecret != nil {
+			password, err := resolveCloudSecretRef(ctx, client, r.cloudInventory, data.Cifs.PasswordSecret)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			optional["password"] = password
+		}
+		if !data.Cifs.SmbVersion.IsNull() {
+			optional["smbversion"] = data.Cifs.SmbVersion.ValueString()
+		}
+	case data.Lvm != nil:
+		fixed["--vgname"] = data.Lvm.VgName.ValueString()
+		args["--shared"] = boolToPve(data.Lvm.Shared.ValueBool())
+	case data.LvmThin != nil:
+		fixed["--vgname"] = data.LvmThin.VgName.ValueString()
+		fixed["--thinpool"] = data.LvmThin.ThinPool.ValueString()
+	case data.ZfsPool != nil:
+		fixed["--pool"] = data.ZfsPool.Pool.ValueString()
+		args["--sparse"] = boolToPve(data.ZfsPool.Sparse.ValueBool())
+		optionalKeys = append(optionalKeys, "blocksize")
+		if !data.ZfsPool.BlockSize.IsNull() {
+			optional["blocksize"] = data.ZfsPool.BlockSize.ValueString()
+		}
+	case data.Rbd != nil:
+		fixed["--pool"] = data.Rbd.Pool.ValueString()
+		if !data.Rbd.Keyring.IsNull() {
+			fixed["--keyring"] = data.Rbd.Keyring.ValueString()
+		}
+		args["--krbd"] = boolToPve(data.Rbd.Krbd.ValueBool())
+		optionalKeys = append(optionalKeys, "monhost", "username")
+		if len(data.Rbd.MonHost) > 0 {
+			optional["monhost"] = strings.Join(data.Rbd.MonHost, " ")
+		}
+		if !data.Rbd.Username.IsNull() {
+			optional["username"] = data.Rbd.Username.ValueString()
+		}
+	}
+
+	deletes := []string{}
+	for _, key := range optionalKeys {
+		if value, ok := optional[key]; ok {
+			args["--"+key] = value
+		} else {
+			deletes = append(deletes, key)
+		}
+	}
+
+	return args, fixed, deletes, nil
+}
+
+// readStorage takes the managed values of the storage config over into the model.
+func (data *StorageResourceModel) readStorage(config map[string]interface{}) {
+	value := func(key string) string {
+		configValue, _ := pveConfigString(config, key)
+		return configValue
+	}
+	flag := func(key string) types.Bool {
+		return types.BoolValue(value(key) == "1")
+	}
+
+	data.Type = types.StringValue(value("type"))
+	data.Content = splitPveList(value("content"))
+	nodes := splitPveList(value("nodes"))
+	if !slices.Equal(sortedStrings(nodes), sortedStrings(data.Nodes)) {
+		data.Nodes = nodes
+	}
+	data.Disable = flag("disable")
+
+	// secrets are kept in /etc/pve/priv and not returned, they stay as configured
+	switch value("type") {
+	case "dir":
+		data.Dir = &StorageDirModel{Path: types.StringValue(value("path")), Shared: flag("shared")}
+	case "nfs":
+		data.Nfs = &StorageNfsModel{Server: types.StringValue(value("server")), Export: types.StringValue(value("export")), Options: optionalString(value("options"))}
+	case "cifs":
+		cifs := &StorageCifsModel{Password: types.StringNull()}
+		if data.Cifs != nil {
+			cifs.Password, cifs.PasswordSecret = data.Cifs.Password, data.Cifs.PasswordSecret
+		}
+		cifs.Server = types.StringValue(value("server"))
+		cifs.Share = types.StringValue(value("share"))
+		cifs.Domain = optionalString(value("domain"))
+		cifs.Username = optionalString(value("username"))
+		cifs.SmbVersion = optionalString(value("smbversion"))
+		data.Cifs = cifs
+	case "lvm":
+		data.Lvm = &StorageLvmModel{VgName: types.StringValue(value("vgname")), Shared: flag("shared")}
+	case "lvmthin":
+		data.LvmThin = &StorageLvmThinModel{VgName: types.StringValue(value("vgname")), ThinPool: types.StringValue(value("thinpool"))}
+	case "zfspool":
+		data.ZfsPool = &StorageZfsPoolModel{Pool: types.StringValue(value("pool")), Sparse: flag("sparse"), BlockSize: optionalString(value("blocksize"))}
+	case "rbd":
+		rbd := &StorageRbdModel{Keyring: types.StringNull()}
+		if data.Rbd != nil {
+			rbd.Keyring = data.Rbd.Keyring
+		}
+		rbd.Pool = types.StringValue(value("pool"))
+		rbd.MonHost = strings.FieldsFunc(value("monhost"), func(c rune) bool { return c == ' ' || c == ',' || c == ';' })
+		if len(rbd.MonHost) == 0 {
+			rbd.MonHost = nil
+		}
+		rbd.Username = optionalString(value("username"))
+		rbd.Krbd = flag("krbd")
+		data.Rbd = rbd
+	}
+}
+
+func (r *StorageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StorageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs, fixed, _, err := r.storageArgs(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve password, got error: %s", err))
+		return
+	}
+	for key, value := range fixed {
+		createArgs[key] = value
+	}
+	createArgs["--storage"] = data.StorageId.ValueString()
+	createArgs["--type"] = data.storageType()
+
+	err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, "/storage", createArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error creating storage, got error: %s", err))
+		return
+	}
+
+	// read back the content defaults proxmox picked
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/storage/%s", data.StorageId.ValueString()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created storage, got error: %s", err))
+		return
+	}
+	data.Type = types.StringValue(data.storageType())
+	if data.Content == nil {
+		content, _ := pveConfigString(config, "content")
+		data.Content = splitPveList(content)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, "/storage", "storage", data.StorageId.ValueString())
+	if removeIfMissing(ctx, exists, err, "storage", resp) {
+		return
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/storage/%s", data.StorageId.ValueString()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage, got error: %s", err))
+		return
+	}
+
+	storageType, _ := pveConfigString(config, "type")
+	if !slices.Contains(storageTypes, storageType) {
+		resp.Diagnostics.AddError("Unsupported Storage Type", fmt.Sprintf("Storage %s is of type %s, which is not managed by pxc_storage.", data.StorageId.ValueString(), storageType))
+		return
+	}
+	data.readStorage(config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StorageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// fixed args force a replacement, unset optional values have to be deleted explicitly
+	setArgs, _, deletes, err := r.storageArgs(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve password, got error: %s", err))
+		return
+	}
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/storage/%s", data.StorageId.ValueString()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error updating storage, got error: %s", err))
+		return
+	}
+	data.Type = types.StringValue(data.storageType())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StorageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// data on the storage is kept, only the storage definition is removed
+	err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/storage/%s", data.StorageId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error deleting storage, got error: %s", err))
+		return
+	}
+}
+
+func (r *StorageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("storage_id"), req, resp)
+}
@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmFirewallOptionsResource{}
+var _ resource.ResourceWithImportState = &VmFirewallOptionsResource{}
+
+// guest firewall option keys managed by the resource, reset on delete
+var vmFirewallOptionKeys = []string{"enable", "dhcp", "macfilter", "ipfilter", "ndp", "radv", "policy_in", "policy_out", "log_level_in", "log_level_out"}
+
+var firewallLogLevels = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug", "nolog"}
+
+func NewVmFirewallOptionsResource() resource.Resource {
+	return &VmFirewallOptionsResource{}
+}
+
+// VmFirewallOptionsResource defines the resource implementation.
+type VmFirewallOptionsResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmFirewallOptionsResourceModel describes the resource data model.
+type VmFirewallOptionsResourceModel struct {
+	VmId           types.Int64  `tfsdk:"vmid"`
+	Enable         types.Bool   `tfsdk:"enable"`
+	Dhcp           types.Bool   `tfsdk:"dhcp"`
+	MacFilter      types.Bool   `tfsdk:"macfilter"`
+	IpFilter       types.Bool   `tfsdk:"ipfilter"`
+	Ndp            types.Bool   `tfsdk:"ndp"`
+	Radv           types.Bool   `tfsdk:"radv"`
+	PolicyIn       types.String `tfsdk:"policy_in"`
+	PolicyOut      types.String `tfsdk:"policy_out"`
+	LogLevelIn     types.String `tfsdk:"log_level_in"`
+	LogLevelOut    types.String `tfsdk:"log_level_out"`
+	SecurityGroups []string     `tfsdk:"security_groups"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *VmFirewallOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_firewall_options"
+}
+
+func (r *VmFirewallOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	flag := func(description string, value bool) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(value),
+			MarkdownDescription: description,
+		}
+	}
+	policy := func(description string, value string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(value),
+			MarkdownDescription: description,
+			Validators: []validator.String{
+				stringvalidator.OneOf("ACCEPT", "DROP", "REJECT"),
+			},
+		}
+	}
+	logLevel := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("nolog"),
+			MarkdownDescription: description,
+			Validators: []validator.String{
+				stringvalidator.OneOf(firewallLogLevels...),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the firewall options of a qemu vm or lxc container and the security groups attached to it. " +
+			"Deleting the resource resets the options to the proxmox defaults. Import with `<vmid>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the qemu vm or lxc container.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"enable":        flag("Whether the firewall of the guest is active, it also has to be enabled on the network devices.", true),
+			"dhcp":          flag("Allows dhcp traffic.", true),
+			"macfilter":     flag("Drops traffic of other mac addresses than the one of the network device.", true),
+			"ipfilter":      flag("Drops traffic of addresses not in the `ipfilter-net<n>` ipsets of the guest.", false),
+			"ndp":           flag("Allows the ipv6 neighbor discovery protocol.", true),
+			"radv":          flag("Allows the guest to send ipv6 router advertisements.", false),
+			"policy_in":     policy("Policy for incoming traffic no rule matches.", "DROP"),
+			"policy_out":    policy("Policy for outgoing traffic no rule matches.", "ACCEPT"),
+			"log_level_in":  logLevel("Log level of incoming traffic handled by the policy."),
+			"log_level_out": logLevel("Log level of outgoing traffic handled by the policy."),
+			"security_groups": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Security groups attached to the guest, added as `group` rules in front of its other rules. " +
+					"All group rules of the guest are replaced, not set leaves them untouched. Don't combine with pxc_firewall_rules for the same guest.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(firewallNameRe, "must be a security group name")),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *VmFirewallOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// optionArgs returns the pvesh args of the firewall options.
+func (data VmFirewallOptionsResourceModel) optionArgs() map[string]string {
+	return map[string]string{
+		"--enable":        boolToPve(data.Enable.ValueBool()),
+		"--dhcp":          boolToPve(data.Dhcp.ValueBool()),
+		"--macfilter":     boolToPve(data.MacFilter.ValueBool()),
+		"--ipfilter":      boolToPve(data.IpFilter.ValueBool()),
+		"--ndp":           boolToPve(data.Ndp.ValueBool()),
+		"--radv":          boolToPve(data.Radv.ValueBool()),
+		"--policy_in":     data.PolicyIn.ValueString(),
+		"--policy_out":    data.PolicyOut.ValueString(),
+		"--log_level_in":  data.LogLevelIn.ValueString(),
+		"--log_level_out": data.LogLevelOut.ValueString(),
+	}
+}
+
+// setSecurityGroups replaces the group rules of the guest, the groups are inserted in order at the top.
+func (r *VmFirewallOptionsResource) setSecurityGroups(ctx context.Context, client pb.CloudServiceClient, basePath string, groups []string) error {
+	rulesPath := basePath + "/rules"
+	rules, err := readRules(ctx, client, r.cloudInventory.TargetPve, rulesPath)
+	if err != nil {
+		return err
+	}
+
+	// from the end, so the positions of the remaining rules don't shift
+	for pos := len(rules) - 1; pos >= 0; pos-- {
+		if rules[pos].Type.ValueString() != "group" {
+			continue
+		}
+		err = pveApiDelete(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/%d", rulesPath, pos))
+		if err != nil {
+			return fmt.Errorf("group rule %d: %w", pos, err)
+		}
+	}
+
+	for pos, group := range groups {
+		err = pveApiCreate(ctx, client, r.cloudInventory.TargetPve, rulesPath, map[string]string{
+			"--type":   "group",
+			"--action": group,
+			"--enable": "1",
+			"--pos":    strconv.Itoa(pos),
+		})
+		if err != nil {
+			return fmt.Errorf("security group %s: %w", group, err)
+		}
+	}
+
+	return nil
+}
+
+// apply sets the options and, if managed, the security groups of the guest.
+func (r *VmFirewallOptionsResource) apply(ctx context.Context, client pb.CloudServiceClient, data VmFirewallOptionsResourceModel) error {
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if err != nil {
+		return err
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, basePath+"/options", data.optionArgs())
+	if err != nil {
+		return err
+	}
+
+	if data.SecurityGroups == nil {
+		return nil
+	}
+	return r.setSecurityGroups(ctx, client, basePath, data.SecurityGroups)
+}
+
+func (r *VmFirewallOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VmFirewallOptionsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	err = r.apply(ctx, client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Error setting guest firewall options, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmFirewallOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VmFirewallOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if errors.Is(err, errFirewallGuestMissing) {
+		removeIfMissing(ctx, false, nil, "guest", resp)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	var options map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, basePath+"/options", &options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read guest firewall options, got error: %s", err))
+		return
+	}
+
+	// unset options are at their proxmox default
+	flag := func(key string, fallback bool) types.Bool {
+		value, ok := pveConfigInt(options, key)
+		if !ok {
+			return types.BoolValue(fallback)
+		}
+		return types.BoolValue(value == 1)
+	}
+	text := func(key string, fallback string) types.String {
+		value, ok := pveConfigString(options, key)
+		if !ok {
+			return types.StringValue(fallback)
+		}
+		return types.StringValue(value)
+	}
+
+	data.Enable = flag("enable", false)
+	data.Dhcp = flag("dhcp", true)
+	data.MacFilter = flag("macfilter", true)
+	data.IpFilter = flag("ipfilter", false)
+	data.Ndp = flag("ndp", true)
+	data.Radv = flag("radv", false)
+	data.PolicyIn = text("policy_in", "DROP")
+	data.PolicyOut = text("policy_out", "ACCEPT")
+	data.LogLevelIn = text("log_level_in", "nolog")
+	data.LogLevelOut = text("log_level_out", "nolog")
+
+	if data.SecurityGroups != nil {
+		rules, err := readRules(ctx, client, r.cloudInventory.TargetPve, basePath+"/rules")
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read guest firewall rules, got error: %s", err))
+			return
+		}
+
+		groups := []string{}
+		for _, rule := range rules {
+			if rule.Type.ValueString() == "group" {
+				groups = append(groups, rule.Action.ValueString())
+			}
+		}
+		data.SecurityGroups = groups
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmFirewallOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VmFirewallOptionsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// groups that are no longer managed are detached
+	applied := data
+	if data.SecurityGroups == nil && state.SecurityGroups != nil {
+		applied.SecurityGroups = []string{}
+	}
+
+	err = r.apply(ctx, client, applied)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Error setting guest firewall options, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmFirewallOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VmFirewallOptionsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the firewall config of a deleted guest is gone with it
+	basePath, err := firewallBasePath(ctx, client, r.cloudInventory.TargetPve, types.StringNull(), data.VmId)
+	if errors.Is(err, errFirewallGuestMissing) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve firewall, got error: %s", err))
+		return
+	}
+
+	if data.SecurityGroups != nil {
+		err = r.setSecurityGroups(ctx, client, basePath, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error detaching security groups, got error: %s", err))
+			return
+		}
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, basePath+"/options", map[string]string{
+		"--delete": strings.Join(vmFirewallOptionKeys, ","),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error resetting guest firewall options, got error: %s", err))
+		return
+	}
+}
+
+func (r *VmFirewallOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmid, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric vmid, got: %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmid)...)
+}
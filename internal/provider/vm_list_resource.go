@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &VmListResource{}
+var _ list.ListResourceWithConfigure = &VmListResource{}
+
+func NewVmListResource() list.ListResource {
+	return &VmListResource{}
+}
+
+// VmListResource lists the qemu vms of the target_pve cluster.
+type VmListResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmListResourceModel describes the list config data model.
+type VmListResourceModel struct {
+	Node types.String `tfsdk:"node"`
+	Tags []string     `tfsdk:"tags"`
+}
+
+// PveClusterVm is an entry of pvesh get /cluster/resources --type vm.
+type PveClusterVm struct {
+	Type   string `json:"type"`
+	VmId   int64  `json:"vmid"`
+	Node   string `json:"node"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Tags   string `json:"tags"`
+}
+
+func (l *VmListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm"
+}
+
+func (l *VmListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the qemu vms of the target_pve cluster, e.g. to generate import configuration for vms not managed yet.",
+
+		Attributes: map[string]schema.Attribute{
+			"node": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list vms running on this node.",
+			},
+			"tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Only list vms that have all of these proxmox tags.",
+			},
+		},
+	}
+}
+
+func (l *VmListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.cloudInventory = cloudInv
+}
+
+func (l *VmListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data VmListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, l.cloudInventory)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cresp, err := client.GetProxmoxApi(ctx, &pb.GetProxmoxApiRequest{TargetPve: l.cloudInventory.TargetPve, ApiPath: "/cluster/resources", GetArgs: map[string]string{"--type": "vm"}})
+	var vms []PveClusterVm
+	if err == nil {
+		err = json.Unmarshal([]byte(cresp.JsonResp), &vms)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list vms, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// lxc containers are part of the vm resources as well
+	vms = slices.DeleteFunc(vms, func(vm PveClusterVm) bool {
+		if vm.Type != "qemu" || (!data.Node.IsNull() && vm.Node != data.Node.ValueString()) {
+			return true
+		}
+		tags := strings.Split(vm.Tags, ";")
+		return slices.ContainsFunc(data.Tags, func(tag string) bool { return !slices.Contains(tags, tag) })
+	})
+	slices.SortFunc(vms, func(a, b PveClusterVm) int { return int(a.VmId - b.VmId) })
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, vm := range vms {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = fmt.Sprintf("%s (%s/%d)", vm.Name, vm.Node, vm.VmId)
+
+			vmData := VmResourceModel{
+				VmId:    types.Int64Value(vm.VmId),
+				Node:    types.StringValue(vm.Node),
+				Wait:    types.BoolValue(true),
+				Timeout: types.Int64Value(600),
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, VmIdentityModel{
+				TargetPve: types.StringValue(l.cloudInventory.TargetPve),
+				Node:      vmData.Node,
+				VmId:      vmData.VmId,
+			})...)
+
+			if req.IncludeResource {
+				var config map[string]interface{}
+				err := getPveApiJson(ctx, client, l.cloudInventory.TargetPve, fmt.Sprintf("%s/config", vmData.apiPath()), &config)
+				if err != nil {
+					result.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config of vm %d, got error: %s", vm.VmId, err))
+				} else {
+					vmData.readConfig(config)
+					vmData.Started = types.BoolValue(vm.Status == "running")
+					result.Diagnostics.Append(result.Resource.Set(ctx, &vmData)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
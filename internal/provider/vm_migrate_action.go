@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &VmMigrateAction{}
+var _ action.ActionWithConfigure = &VmMigrateAction{}
+
+func NewVmMigrateAction() action.Action {
+	return &VmMigrateAction{}
+}
+
+// VmMigrateAction defines the action implementation.
+type VmMigrateAction struct {
+	cloudInventory CloudInventory
+}
+
+// VmMigrateActionModel describes the action data model.
+type VmMigrateActionModel struct {
+	VmId           types.Int64  `tfsdk:"vmid"`
+	TargetNode     types.String `tfsdk:"target_node"`
+	Online         types.Bool   `tfsdk:"online"`
+	WithLocalDisks types.Bool   `tfsdk:"with_local_disks"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *VmMigrateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_migrate"
+}
+
+func (a *VmMigrateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Migrates a vm or lxc container to another node of the cluster and waits for the migration task, e.g. to drain a node before maintenance. Guests already on the target node are skipped.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the guest, the current node is looked up in the cluster resources.",
+			},
+			"target_node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Node to migrate the guest to.",
+			},
+			"online": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Live migrates running vms, running lxc containers are restarted on the target node instead. Stopped guests are always migrated offline. Defaults to true.",
+			},
+			"with_local_disks": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Also migrates disks on local storage of qemu vms. Defaults to false.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Seconds to wait for the migration to finish, defaults to 1800.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *VmMigrateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *VmMigrateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data VmMigrateActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	guest, err := findPveGuest(ctx, client, a.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guests, got error: %s", err))
+		return
+	}
+	if guest == nil {
+		resp.Diagnostics.AddError("Guest not found", fmt.Sprintf("No vm or lxc container with vmid %d exists.", data.VmId.ValueInt64()))
+		return
+	}
+
+	targetNode := data.TargetNode.ValueString()
+	if guest.Node == targetNode {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Guest %d already runs on %s", guest.VmId, targetNode)})
+		return
+	}
+
+	online := data.Online.IsNull() || data.Online.ValueBool()
+	args := map[string]string{"--target": targetNode}
+	switch guest.Type {
+	case "qemu":
+		if online && guest.Status == "running" {
+			args["--online"] = "1"
+		}
+		if data.WithLocalDisks.ValueBool() {
+			args["--with-local-disks"] = "1"
+		}
+	case "lxc":
+		if data.WithLocalDisks.ValueBool() {
+			resp.Diagnostics.AddError("Unsupported Option", "with_local_disks is only supported for qemu vms.")
+			return
+		}
+		// containers can't be live migrated
+		if online && guest.Status == "running" {
+			args["--restart"] = "1"
+		}
+	}
+
+	apiPath := fmt.Sprintf("/nodes/%s/%s/%d/migrate", guest.Node, guest.Type, guest.VmId)
+	output, err := pveApiCall(ctx, client, a.cloudInventory.TargetPve, "POST", apiPath, args)
+	if err != nil {
+		resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to migrate guest %d to %s, got error: %s", guest.VmId, targetNode, err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Migrating guest %d from %s to %s, task %s", guest.VmId, guest.Node, targetNode, output)})
+
+	timeout := int64(1800)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+	err = pveWaitForTask(ctx, client, a.cloudInventory.TargetPve, output, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Task Error", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Guest %d migrated to %s", guest.VmId, targetNode)})
+}
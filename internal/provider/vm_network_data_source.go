@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VmNetworkDataSource{}
+
+// interval between guest agent polls while waiting for an address
+const vmNetworkPollInterval = 5 * time.Second
+
+func NewVmNetworkDataSource() datasource.DataSource {
+	return &VmNetworkDataSource{}
+}
+
+// VmNetworkDataSource defines the data source implementation.
+type VmNetworkDataSource struct {
+	cloudInventory CloudInventory
+}
+
+// VmNetworkDataSourceModel describes the data source data model.
+type VmNetworkDataSourceModel struct {
+	VmId          types.Int64               `tfsdk:"vmid"`
+	WaitForIp     types.String              `tfsdk:"wait_for_ip"`
+	Node          types.String              `tfsdk:"node"`
+	GuestType     types.String              `tfsdk:"guest_type"`
+	Interfaces    []VmNetworkInterfaceModel `tfsdk:"interfaces"`
+	Ipv4Address   types.String              `tfsdk:"ipv4_address"`
+	Ipv4Addresses []string                  `tfsdk:"ipv4_addresses"`
+	Ipv6Addresses []string                  `tfsdk:"ipv6_addresses"`
+}
+
+// VmNetworkInterfaceModel describes a single interface of the data source.
+type VmNetworkInterfaceModel struct {
+	Name        types.String `tfsdk:"name"`
+	MacAddress  types.String `tfsdk:"mac_address"`
+	IpAddresses []string     `tfsdk:"ip_addresses"`
+}
+
+func (d *VmNetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_network"
+}
+
+func (d *VmNetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the runtime network interfaces of a guest of the target_pve cluster. For qemu vms they are reported by the guest agent (`agent/network-get-interfaces`), which has to be enabled and running inside the vm.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				MarkdownDescription: "Id of the guest.",
+				Required:            true,
+			},
+			"wait_for_ip": schema.StringAttribute{
+				MarkdownDescription: "Polls the guest for up to this duration (e.g. `5m`) until it reports a global ipv4 address. Without it the interfaces are read once and the guest has to be running.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationRe, "must be a go duration, e.g. 90s or 1h30m"),
+				},
+			},
+			"node": schema.StringAttribute{
+				MarkdownDescription: "Node the guest runs on.",
+				Computed:            true,
+			},
+			"guest_type": schema.StringAttribute{
+				MarkdownDescription: "qemu or lxc.",
+				Computed:            true,
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Network interfaces of the guest, loopback excluded.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the interface inside the guest.",
+						},
+						"mac_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Hardware address of the interface.",
+						},
+						"ip_addresses": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Addresses of the interface in cidr notation.",
+						},
+					},
+				},
+			},
+			"ipv4_address": schema.StringAttribute{
+				MarkdownDescription: "First global ipv4 address of the guest, null if there is none.",
+				Computed:            true,
+			},
+			"ipv4_addresses": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Global ipv4 addresses of the guest.",
+				Computed:            true,
+			},
+			"ipv6_addresses": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Global ipv6 addresses of the guest.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VmNetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.cloudInventory = cloudInv
+}
+
+func (d *VmNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VmNetworkDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, d.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	guest, err := findPveGuest(ctx, client, d.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guests, got error: %s", err))
+		return
+	}
+	if guest == nil {
+		resp.Diagnostics.AddError("Guest not found", fmt.Sprintf("No vm or lxc container with vmid %d exists.", data.VmId.ValueInt64()))
+		return
+	}
+
+	node, guestType := guest.Node, guest.Type
+	data.Node = types.StringValue(node)
+	data.GuestType = types.StringValue(guestType)
+
+	var interfaces []guestInterface
+	if data.WaitForIp.IsNull() {
+		interfaces, err = getGuestInterfaces(ctx, client, d.cloudInventory.TargetPve, node, guestType, data.VmId.ValueInt64())
+	} else {
+		wait, _ := time.ParseDuration(data.WaitForIp.ValueString())
+		interfaces, err = waitForGuestIpv4(ctx, client, d.cloudInventory.TargetPve, node, guestType, data.VmId.ValueInt64(), wait)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read guest interfaces, got error: %s", err))
+		return
+	}
+
+	data.Interfaces = []VmNetworkInterfaceModel{}
+	for _, iface := range interfaces {
+		data.Interfaces = append(data.Interfaces, VmNetworkInterfaceModel{
+			Name:        types.StringValue(iface.Name),
+			MacAddress:  types.StringValue(iface.MacAddress),
+			IpAddresses: append([]string{}, iface.IpAddresses...),
+		})
+	}
+
+	data.Ipv4Addresses, data.Ipv6Addresses = splitIpFamilies(guestIpAddresses(interfaces))
+	data.Ipv4Address = types.StringNull()
+	if len(data.Ipv4Addresses) > 0 {
+		data.Ipv4Address = types.StringValue(data.Ipv4Addresses[0])
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitForGuestIpv4 polls the guest interfaces until a global ipv4 address shows up or wait passes.
+// Errors are expected while the guest or its agent is still booting and only end the wait on timeout.
+func waitForGuestIpv4(ctx context.Context, client pb.CloudServiceClient, targetPve string, node string, guestType string, vmid int64, wait time.Duration) ([]guestInterface, error) {
+	deadline := time.Now().Add(wait)
+
+	for {
+		interfaces, err := getGuestInterfaces(ctx, client, targetPve, node, guestType, vmid)
+		if err == nil {
+			ipv4, _ := splitIpFamilies(guestIpAddresses(interfaces))
+			if len(ipv4) > 0 {
+				return interfaces, nil
+			}
+		} else {
+			tflog.Debug(ctx, "guest interfaces not available yet", map[string]any{"vmid": vmid, "error": err.Error()})
+		}
+
+		if time.Now().Add(vmNetworkPollInterval).After(deadline) {
+			if err != nil {
+				return nil, fmt.Errorf("no ipv4 address reported within %s, last error: %w", wait, err)
+			}
+			return nil, fmt.Errorf("no ipv4 address reported within %s", wait)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(vmNetworkPollInterval):
+		}
+	}
+}
+
+// splitIpFamilies splits plain ip addresses into ipv4 and ipv6.
+func splitIpFamilies(addresses []string) ([]string, []string) {
+	ipv4 := []string{}
+	ipv6 := []string{}
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip != nil && ip.To4() != nil {
+			ipv4 = append(ipv4, address)
+		} else {
+			ipv6 = append(ipv6, address)
+		}
+	}
+	return ipv4, ipv6
+}
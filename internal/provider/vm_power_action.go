@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &VmPowerAction{}
+var _ action.ActionWithConfigure = &VmPowerAction{}
+
+func NewVmStartAction() action.Action {
+	return &VmPowerAction{command: "start"}
+}
+
+func NewVmStopAction() action.Action {
+	return &VmPowerAction{command: "stop"}
+}
+
+func NewVmShutdownAction() action.Action {
+	return &VmPowerAction{command: "shutdown"}
+}
+
+func NewVmRebootAction() action.Action {
+	return &VmPowerAction{command: "reboot"}
+}
+
+// VmPowerAction defines the action implementation, one action per status command.
+type VmPowerAction struct {
+	cloudInventory CloudInventory
+	command        string
+}
+
+// VmPowerActionModel describes the action data model.
+type VmPowerActionModel struct {
+	VmId            types.Int64 `tfsdk:"vmid"`
+	Timeout         types.Int64 `tfsdk:"timeout"`
+	ShutdownTimeout types.Int64 `tfsdk:"shutdown_timeout"`
+	ForceStop       types.Bool  `tfsdk:"force_stop"`
+}
+
+// graceful commands ask the guest os to power off and accept a shutdown timeout
+func (a *VmPowerAction) graceful() bool {
+	return a.command == "shutdown" || a.command == "reboot"
+}
+
+func (a *VmPowerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_" + a.command
+}
+
+func (a *VmPowerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	descriptions := map[string]string{
+		"start":    "Starts a vm or lxc container and waits for the start task. Guests that are already running are skipped.",
+		"stop":     "Stops a vm or lxc container immediately, like pulling the plug. Stopped guests are skipped.",
+		"shutdown": "Shuts a vm or lxc container down gracefully via acpi or the guest agent. Stopped guests are skipped.",
+		"reboot":   "Reboots a running vm or lxc container gracefully, pending config changes are applied.",
+	}
+
+	attributes := map[string]schema.Attribute{
+		"vmid": schema.Int64Attribute{
+			Required:            true,
+			MarkdownDescription: "Id of the guest, the node is looked up in the cluster resources.",
+		},
+		"timeout": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Seconds to wait for the task to finish, defaults to 600.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+	if a.graceful() {
+		attributes["shutdown_timeout"] = schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Seconds the guest gets to power off, proxmox defaults to 180.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		}
+	}
+	if a.command == "shutdown" {
+		attributes["force_stop"] = schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Stops the guest if it didn't shut down within shutdown_timeout instead of failing. Defaults to false.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: descriptions[a.command],
+		Attributes:          attributes,
+	}
+}
+
+func (a *VmPowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *VmPowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data VmPowerActionModel
+
+	// the schema differs per command, attributes missing from it stay null
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vmid"), &data.VmId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &data.Timeout)...)
+	if a.graceful() {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shutdown_timeout"), &data.ShutdownTimeout)...)
+	}
+	if a.command == "shutdown" {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("force_stop"), &data.ForceStop)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	guest, err := findPveGuest(ctx, client, a.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guests, got error: %s", err))
+		return
+	}
+	if guest == nil {
+		resp.Diagnostics.AddError("Guest not found", fmt.Sprintf("No vm or lxc container with vmid %d exists.", data.VmId.ValueInt64()))
+		return
+	}
+
+	// keep the actions idempotent
+	alreadyDone := (a.command == "start" && guest.Status == "running") ||
+		((a.command == "stop" || a.command == "shutdown") && guest.Status == "stopped")
+	if alreadyDone {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Guest %d is already %s", guest.VmId, guest.Status)})
+		return
+	}
+
+	args := map[string]string{}
+	if !data.ShutdownTimeout.IsNull() {
+		args["--timeout"] = strconv.FormatInt(data.ShutdownTimeout.ValueInt64(), 10)
+	}
+	if data.ForceStop.ValueBool() {
+		args["--forceStop"] = "1"
+	}
+
+	apiPath := fmt.Sprintf("/nodes/%s/%s/%d/status/%s", guest.Node, guest.Type, guest.VmId, a.command)
+	output, err := pveApiCall(ctx, client, a.cloudInventory.TargetPve, "POST", apiPath, args)
+	if err != nil {
+		resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to %s guest %d, got error: %s", a.command, guest.VmId, err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started task %s", output)})
+
+	timeout := int64(600)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+	err = pveWaitForTask(ctx, client, a.cloudInventory.TargetPve, output, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Task Error", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Guest %d %s finished", guest.VmId, a.command)})
+}
@@ -0,0 +1,875 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmResource{}
+var _ resource.ResourceWithImportState = &VmResource{}
+var _ resource.ResourceWithIdentity = &VmResource{}
+
+func NewVmResource() resource.Resource {
+	return &VmResource{}
+}
+
+// VmResource defines the resource implementation.
+type VmResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmResourceModel describes the resource data model.
+type VmResourceModel struct {
+	VmId      types.Int64       `tfsdk:"vmid"`
+	Node      types.String      `tfsdk:"node"`
+	Name      types.String      `tfsdk:"name"`
+	Cores     types.Int64       `tfsdk:"cores"`
+	Memory    types.Int64       `tfsdk:"memory"`
+	Started   types.Bool        `tfsdk:"started"`
+	Tags      []string          `tfsdk:"tags"`
+	Disks     []VmDiskModel     `tfsdk:"disks"`
+	Networks  []VmNetworkModel  `tfsdk:"networks"`
+	CloudInit *VmCloudInitModel `tfsdk:"cloud_init"`
+	Wait      types.Bool        `tfsdk:"wait_for_completion"`
+	Timeout   types.Int64       `tfsdk:"timeout"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// VmIdentityModel describes the resource identity.
+type VmIdentityModel struct {
+	TargetPve types.String `tfsdk:"target_pve"`
+	Node      types.String `tfsdk:"node"`
+	VmId      types.Int64  `tfsdk:"vmid"`
+}
+
+// VmDiskModel describes a disk attached to the vm.
+type VmDiskModel struct {
+	Slot       types.String `tfsdk:"slot"`
+	Storage    types.String `tfsdk:"storage"`
+	Size       types.Int64  `tfsdk:"size"`
+	ImportFrom types.String `tfsdk:"import_from"`
+}
+
+// VmNetworkModel describes a nic of the vm, the list index maps to netX.
+type VmNetworkModel struct {
+	Model    types.String `tfsdk:"model"`
+	Bridge   types.String `tfsdk:"bridge"`
+	VlanTag  types.Int64  `tfsdk:"vlan_tag"`
+	Firewall types.Bool   `tfsdk:"firewall"`
+}
+
+// VmCloudInitModel describes the cloud-init drive and its settings.
+type VmCloudInitModel struct {
+	Storage  types.String `tfsdk:"storage"`
+	User     types.String `tfsdk:"user"`
+	SshKeys  []string     `tfsdk:"ssh_keys"`
+	IpConfig types.String `tfsdk:"ip_config"`
+	UserData types.String `tfsdk:"user_data"`
+}
+
+// slot the cloud-init drive gets attached to
+const vmCloudInitSlot = "ide2"
+
+var vmDiskSlotRe = regexp.MustCompile(`^(scsi|virtio|sata|ide)\d+$`)
+var vmNetRe = regexp.MustCompile(`^net\d+$`)
+
+func (r *VmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm"
+}
+
+func (r *VmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a qemu virtual machine on the target_pve cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Unique vmid of the virtual machine.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the vm runs on. Changing it migrates the vm, running vms are live migrated. Migrations done outside of terraform, e.g. by ha, show up as drift.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the vm, has to be a valid dns name.",
+			},
+			"cores": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Number of cpu cores.",
+			},
+			"memory": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(512),
+				MarkdownDescription: "Memory in MiB.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Waits for the create, power and destroy tasks to finish and fails if they exit with an error.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				MarkdownDescription: "Seconds to wait for a task to finish.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"started": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the vm should be running.",
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Proxmox tags of the vm.",
+			},
+			"disks": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Disks of the vm. The first disk is used as boot disk.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slot": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Bus and index of the disk, e.g. `scsi0` or `virtio1`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(vmDiskSlotRe, "must be a scsi, virtio, sata or ide slot"),
+							},
+						},
+						"storage": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Proxmox storage the disk is allocated on. Can not be changed in place.",
+						},
+						"size": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "Size of the disk in GiB. Disks can only grow.",
+						},
+						"import_from": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Volume to import the disk from when it is created, e.g. `local:import/debian-12.qcow2`. The size has to be at least the size of the image.",
+						},
+					},
+				},
+			},
+			"networks": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Network interfaces of the vm, the list index maps to net0, net1, ...",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"model": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("virtio"),
+							MarkdownDescription: "Nic model.",
+						},
+						"bridge": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Bridge the nic is attached to.",
+						},
+						"vlan_tag": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Vlan tag of the nic.",
+						},
+						"firewall": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Enable the proxmox firewall on the nic.",
+						},
+					},
+				},
+			},
+			"cloud_init": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Attaches a cloud-init drive with the given settings.",
+				Attributes:          vmCloudInitAttributes(),
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+// vmCloudInitAttributes returns the cloud-init settings of vms, shared with vm templates.
+func vmCloudInitAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"storage": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Storage the cloud-init drive is created on.",
+		},
+		"user": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Default user to create.",
+		},
+		"ssh_keys": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Public ssh keys authorized for the user.",
+		},
+		"ip_config": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Ip config of the first nic, e.g. `ip=dhcp` or `ip=10.0.0.10/24,gw=10.0.0.1`.",
+		},
+		"user_data": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Snippet volume holding custom cloud-init user data, e.g. `local:snippets/user-data.yaml`.",
+		},
+	}
+}
+
+func (r *VmResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"target_pve": targetPveIdentityAttribute,
+			"node": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Proxmox node the vm runs on.",
+			},
+			"vmid": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Vmid of the vm.",
+			},
+		},
+	}
+}
+
+func (r *VmResource) identity(data VmResourceModel) VmIdentityModel {
+	return VmIdentityModel{
+		TargetPve: types.StringValue(r.cloudInventory.TargetPve),
+		Node:      data.Node,
+		VmId:      data.VmId,
+	}
+}
+
+func (r *VmResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (data *VmResourceModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/qemu/%d", data.Node.ValueString(), data.VmId.ValueInt64())
+}
+
+// netSpec builds the netX property string, mac is kept if known so proxmox doesn't regenerate it.
+func (nic VmNetworkModel) netSpec(mac string) string {
+	spec := nic.Model.ValueString()
+	if mac != "" {
+		spec += "=" + mac
+	}
+	spec += ",bridge=" + nic.Bridge.ValueString()
+	if !nic.VlanTag.IsNull() {
+		spec += fmt.Sprintf(",tag=%d", nic.VlanTag.ValueInt64())
+	}
+	if nic.Firewall.ValueBool() {
+		spec += ",firewall=1"
+	}
+	return spec
+}
+
+// diskSpec builds the property string allocating a new disk.
+func (disk VmDiskModel) diskSpec() string {
+	if disk.ImportFrom.IsNull() {
+		return fmt.Sprintf("%s:%d", disk.Storage.ValueString(), disk.Size.ValueInt64())
+	}
+	// size is ignored on import, the disk gets resized afterwards
+	return fmt.Sprintf("%s:0,import-from=%s", disk.Storage.ValueString(), disk.ImportFrom.ValueString())
+}
+
+// cloudInitArgs adds the cloud-init settings to args and returns the keys that have to be deleted.
+func (ci *VmCloudInitModel) cloudInitArgs(args map[string]string) []string {
+	deletes := []string{}
+	setOrDelete := func(key string, value types.String) {
+		if value.IsNull() {
+			deletes = append(deletes, key)
+			return
+		}
+		args["--"+key] = value.ValueString()
+	}
+
+	setOrDelete("ciuser", ci.User)
+	setOrDelete("ipconfig0", ci.IpConfig)
+	if ci.UserData.IsNull() {
+		deletes = append(deletes, "cicustom")
+	} else {
+		args["--cicustom"] = "user=" + ci.UserData.ValueString()
+	}
+	if len(ci.SshKeys) == 0 {
+		deletes = append(deletes, "sshkeys")
+	} else {
+		// proxmox expects the keys url encoded
+		args["--sshkeys"] = strings.ReplaceAll(url.QueryEscape(strings.Join(ci.SshKeys, "\n")), "+", "%20")
+	}
+
+	return deletes
+}
+
+// setPower starts or stops the vm.
+func (r *VmResource) setPower(ctx context.Context, client pb.CloudServiceClient, data *VmResourceModel, started bool) error {
+	action := "stop"
+	if started {
+		action = "start"
+	}
+	return pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("%s/status/%s", data.apiPath(), action), map[string]string{}, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+}
+
+// isRunning checks the current power state of the vm.
+func (r *VmResource) isRunning(ctx context.Context, client pb.CloudServiceClient, data *VmResourceModel) (bool, error) {
+	var status struct {
+		Status string `json:"status"`
+	}
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/status/current", data.apiPath()), &status)
+	if err != nil {
+		return false, err
+	}
+	return status.Status == "running", nil
+}
+
+// migrate moves the vm to the target node, running vms are migrated online. The task is
+// always awaited since the following calls go to the target node.
+func (r *VmResource) migrate(ctx context.Context, client pb.CloudServiceClient, data *VmResourceModel, target string) error {
+	running, err := r.isRunning(ctx, client, data)
+	if err != nil {
+		return err
+	}
+
+	// local disks are copied to the target, disks on shared storage are left alone
+	args := map[string]string{"--target": target, "--with-local-disks": "1"}
+	if running {
+		args["--online"] = "1"
+	}
+	return pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("%s/migrate", data.apiPath()), args, true, data.Timeout.ValueInt64())
+}
+
+// resizeDisk grows a disk to the given size in GiB.
+func (r *VmResource) resizeDisk(ctx context.Context, client pb.CloudServiceClient, data *VmResourceModel, disk VmDiskModel) error {
+	return pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/resize", data.apiPath()), map[string]string{
+		"--disk": disk.Slot.ValueString(),
+		"--size": fmt.Sprintf("%dG", disk.Size.ValueInt64()),
+	})
+}
+
+func (r *VmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VmResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{
+		"--vmid":   strconv.FormatInt(data.VmId.ValueInt64(), 10),
+		"--name":   data.Name.ValueString(),
+		"--cores":  strconv.FormatInt(data.Cores.ValueInt64(), 10),
+		"--memory": strconv.FormatInt(data.Memory.ValueInt64(), 10),
+		"--scsihw": "virtio-scsi-single",
+	}
+	if len(data.Tags) > 0 {
+		createArgs["--tags"] = strings.Join(data.Tags, ";")
+	}
+	for _, disk := range data.Disks {
+		createArgs["--"+disk.Slot.ValueString()] = disk.diskSpec()
+	}
+	if len(data.Disks) > 0 {
+		createArgs["--boot"] = "order=" + data.Disks[0].Slot.ValueString()
+	}
+	for i, nic := range data.Networks {
+		createArgs[fmt.Sprintf("--net%d", i)] = nic.netSpec("")
+	}
+	if data.CloudInit != nil {
+		createArgs["--"+vmCloudInitSlot] = data.CloudInit.Storage.ValueString() + ":cloudinit"
+		data.CloudInit.cloudInitArgs(createArgs)
+	}
+
+	// perform the request
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/qemu", data.Node.ValueString()), createArgs, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to create vm, got error: %s", err))
+		return
+	}
+
+	for _, disk := range data.Disks {
+		if disk.ImportFrom.IsNull() {
+			continue
+		}
+		err = r.resizeDisk(ctx, client, &data, disk)
+		if err != nil {
+			resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to resize imported disk %s, got error: %s", disk.Slot.ValueString(), err))
+			return
+		}
+	}
+
+	if data.Started.ValueBool() {
+		err = r.setPower(ctx, client, &data, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to start vm, got error: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(data))...)
+	}
+}
+
+func (r *VmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VmResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// the vm might have been migrated, resolve its current node
+	guest, err := findPveGuest(ctx, client, r.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if removeIfMissing(ctx, guest != nil && guest.Type == "qemu", err, "vm", resp) {
+		return
+	}
+	data.Node = types.StringValue(guest.Node)
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vm config, got error: %s", err))
+		return
+	}
+
+	running, err := r.isRunning(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vm status, got error: %s", err))
+		return
+	}
+
+	data.readConfig(config)
+	data.Started = types.BoolValue(running)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// identities must not change, a migrated vm keeps the node it was created on
+	if resp.Identity != nil && resp.Identity.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, r.identity(data))...)
+	}
+}
+
+// readConfig refreshes the model from a pvesh get /nodes/{node}/qemu/{vmid}/config response.
+func (data *VmResourceModel) readConfig(config map[string]interface{}) {
+	if name, ok := pveConfigString(config, "name"); ok {
+		data.Name = types.StringValue(name)
+	}
+	if cores, ok := pveConfigInt(config, "cores"); ok {
+		data.Cores = types.Int64Value(cores)
+	} else {
+		data.Cores = types.Int64Value(1)
+	}
+	if memory, ok := pveConfigInt(config, "memory"); ok {
+		data.Memory = types.Int64Value(memory)
+	} else {
+		data.Memory = types.Int64Value(512)
+	}
+
+	data.Tags = nil
+	if tags, ok := pveConfigString(config, "tags"); ok && tags != "" {
+		data.Tags = strings.Split(tags, ";")
+	}
+
+	// disks, keeping the order of the prior state
+	disks := map[string]VmDiskModel{}
+	var cloudInitStorage string
+	for key, value := range config {
+		if !vmDiskSlotRe.MatchString(key) {
+			continue
+		}
+		volume, props := parsePveProps(fmt.Sprint(value))
+		storage, _, _ := strings.Cut(volume, ":")
+		if strings.Contains(volume, "cloudinit") {
+			cloudInitStorage = storage
+			continue
+		}
+		if props["media"] == "cdrom" {
+			continue
+		}
+		disks[key] = VmDiskModel{
+			Slot:       types.StringValue(key),
+			Storage:    types.StringValue(storage),
+			Size:       types.Int64Value(parsePveSizeGiB(props["size"])),
+			ImportFrom: types.StringNull(),
+		}
+	}
+	var readDisks []VmDiskModel
+	for _, disk := range data.Disks {
+		if current, ok := disks[disk.Slot.ValueString()]; ok {
+			current.ImportFrom = disk.ImportFrom
+			readDisks = append(readDisks, current)
+			delete(disks, disk.Slot.ValueString())
+		}
+	}
+	extraSlots := []string{}
+	for slot := range disks {
+		extraSlots = append(extraSlots, slot)
+	}
+	sort.Strings(extraSlots)
+	for _, slot := range extraSlots {
+		readDisks = append(readDisks, disks[slot])
+	}
+	data.Disks = readDisks
+
+	// nics, the list index maps to netX
+	netKeys := []string{}
+	for key := range config {
+		if vmNetRe.MatchString(key) {
+			netKeys = append(netKeys, key)
+		}
+	}
+	sort.Slice(netKeys, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.TrimPrefix(netKeys[i], "net"))
+		b, _ := strconv.Atoi(strings.TrimPrefix(netKeys[j], "net"))
+		return a < b
+	})
+	var nics []VmNetworkModel
+	for _, key := range netKeys {
+		nics = append(nics, parseVmNetSpec(fmt.Sprint(config[key])))
+	}
+	data.Networks = nics
+
+	// cloud-init
+	if cloudInitStorage == "" {
+		data.CloudInit = nil
+		return
+	}
+	ci := &VmCloudInitModel{Storage: types.StringValue(cloudInitStorage), User: types.StringNull(), IpConfig: types.StringNull(), UserData: types.StringNull()}
+	if user, ok := pveConfigString(config, "ciuser"); ok {
+		ci.User = types.StringValue(user)
+	}
+	if ipConfig, ok := pveConfigString(config, "ipconfig0"); ok {
+		ci.IpConfig = types.StringValue(ipConfig)
+	}
+	if cicustom, ok := pveConfigString(config, "cicustom"); ok {
+		_, props := parsePveProps(cicustom)
+		if userData, ok := props["user"]; ok {
+			ci.UserData = types.StringValue(userData)
+		}
+	}
+	if sshKeys, ok := pveConfigString(config, "sshkeys"); ok {
+		decoded, err := url.QueryUnescape(sshKeys)
+		if err == nil {
+			for _, key := range strings.Split(decoded, "\n") {
+				if strings.TrimSpace(key) != "" {
+					ci.SshKeys = append(ci.SshKeys, key)
+				}
+			}
+		}
+	}
+	data.CloudInit = ci
+}
+
+// parseVmNetSpec parses a netX property string like `virtio=BC:24:11:00:00:01,bridge=vmbr0,tag=10`.
+func parseVmNetSpec(spec string) VmNetworkModel {
+	nic := VmNetworkModel{VlanTag: types.Int64Null()}
+	_, props := parsePveProps(spec)
+	first, _, _ := strings.Cut(spec, ",")
+	model, _, _ := strings.Cut(first, "=")
+	nic.Model = types.StringValue(model)
+	nic.Bridge = types.StringValue(props["bridge"])
+	if tag, err := strconv.ParseInt(props["tag"], 10, 64); err == nil {
+		nic.VlanTag = types.Int64Value(tag)
+	}
+	nic.Firewall = types.BoolValue(props["firewall"] == "1")
+	return nic
+}
+
+// vmNetMac extracts the mac address of a netX property string.
+func vmNetMac(spec string) string {
+	first, _, _ := strings.Cut(spec, ",")
+	_, mac, _ := strings.Cut(first, "=")
+	return mac
+}
+
+// parsePveSizeGiB converts proxmox sizes like 512M, 32G or 1T to GiB.
+func parsePveSizeGiB(size string) int64 {
+	if size == "" {
+		return 0
+	}
+	units := map[byte]float64{'K': 1.0 / (1024 * 1024), 'M': 1.0 / 1024, 'G': 1, 'T': 1024}
+	factor, ok := units[size[len(size)-1]]
+	number := size
+	if ok {
+		number = size[:len(size)-1]
+	} else {
+		// plain bytes
+		factor = 1.0 / (1024 * 1024 * 1024)
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(math.Ceil(value * factor))
+}
+
+func (r *VmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VmResourceModel
+	var state VmResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	if !data.Node.Equal(state.Node) {
+		err = r.migrate(ctx, client, &state, data.Node.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to migrate vm to %s, got error: %s", data.Node.ValueString(), err))
+			return
+		}
+	}
+
+	// current config to preserve nic mac addresses
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vm config, got error: %s", err))
+		return
+	}
+
+	setArgs := map[string]string{
+		"--name":   data.Name.ValueString(),
+		"--cores":  strconv.FormatInt(data.Cores.ValueInt64(), 10),
+		"--memory": strconv.FormatInt(data.Memory.ValueInt64(), 10),
+	}
+	deletes := []string{}
+
+	if len(data.Tags) > 0 {
+		setArgs["--tags"] = strings.Join(data.Tags, ";")
+	} else {
+		deletes = append(deletes, "tags")
+	}
+
+	// disks can be added, removed and grown
+	resizes := []VmDiskModel{}
+	for _, disk := range data.Disks {
+		idx := slices.IndexFunc(state.Disks, func(d VmDiskModel) bool { return d.Slot.Equal(disk.Slot) })
+		if idx == -1 {
+			setArgs["--"+disk.Slot.ValueString()] = disk.diskSpec()
+			if !disk.ImportFrom.IsNull() {
+				resizes = append(resizes, disk)
+			}
+			continue
+		}
+		current := state.Disks[idx]
+		if !current.Storage.Equal(disk.Storage) {
+			resp.Diagnostics.AddError("Unsupported Change", fmt.Sprintf("Moving disk %s to another storage is not supported.", disk.Slot.ValueString()))
+			return
+		}
+		if disk.Size.ValueInt64() < current.Size.ValueInt64() {
+			resp.Diagnostics.AddError("Unsupported Change", fmt.Sprintf("Shrinking disk %s is not supported.", disk.Slot.ValueString()))
+			return
+		}
+		if disk.Size.ValueInt64() > current.Size.ValueInt64() {
+			resizes = append(resizes, disk)
+		}
+	}
+	for _, disk := range state.Disks {
+		if !slices.ContainsFunc(data.Disks, func(d VmDiskModel) bool { return d.Slot.Equal(disk.Slot) }) {
+			deletes = append(deletes, disk.Slot.ValueString())
+		}
+	}
+
+	for i, nic := range data.Networks {
+		key := fmt.Sprintf("net%d", i)
+		current, _ := pveConfigString(config, key)
+		setArgs["--"+key] = nic.netSpec(vmNetMac(current))
+	}
+	for i := len(data.Networks); i < len(state.Networks); i++ {
+		deletes = append(deletes, fmt.Sprintf("net%d", i))
+	}
+
+	if data.CloudInit != nil {
+		if state.CloudInit == nil || !state.CloudInit.Storage.Equal(data.CloudInit.Storage) {
+			setArgs["--"+vmCloudInitSlot] = data.CloudInit.Storage.ValueString() + ":cloudinit"
+		}
+		deletes = append(deletes, data.CloudInit.cloudInitArgs(setArgs)...)
+	} else if state.CloudInit != nil {
+		deletes = append(deletes, vmCloudInitSlot, "ciuser", "sshkeys", "ipconfig0", "cicustom")
+	}
+
+	if len(deletes) > 0 {
+		setArgs["--delete"] = strings.Join(deletes, ",")
+	}
+
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), setArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to update vm config, got error: %s", err))
+		return
+	}
+
+	for _, disk := range resizes {
+		err = r.resizeDisk(ctx, client, &data, disk)
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to resize disk %s, got error: %s", disk.Slot.ValueString(), err))
+			return
+		}
+	}
+
+	if data.Started.ValueBool() != state.Started.ValueBool() {
+		err = r.setPower(ctx, client, &data, data.Started.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to change vm power state, got error: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VmResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// proxmox refuses to destroy running vms
+	running, err := r.isRunning(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vm status, got error: %s", err))
+		return
+	}
+	if running {
+		err = r.setPower(ctx, client, &data, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to stop vm, got error: %s", err))
+			return
+		}
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", data.apiPath(), nil, data.Wait.ValueBool(), data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to delete vm, got error: %s", err))
+		return
+	}
+}
+
+func (r *VmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity VmIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(checkIdentityTargetPve(identity.TargetPve, r.cloudInventory)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		req.ID = fmt.Sprintf("%s/%d", identity.Node.ValueString(), identity.VmId.ValueInt64())
+	}
+
+	node, vmId, found := strings.Cut(req.ID, "/")
+	parsedVmId, err := strconv.ParseInt(vmId, 10, 64)
+	if !found || err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format <node>/<vmid>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node"), node)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), parsedVmId)...)
+	// not part of the pve config, start with the defaults
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(600))...)
+}
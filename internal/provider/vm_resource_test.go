@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVmResourceReadResolvesNode(t *testing.T) {
+	config := `{"name": "web-1", "cores": 2, "memory": 2048}`
+
+	tests := []struct {
+		name        string
+		resources   string
+		wantRemoved bool
+		wantNode    string
+	}{
+		{
+			name:      "on its node",
+			resources: `[{"type": "qemu", "vmid": 100, "node": "pve1", "status": "running"}]`,
+			wantNode:  "pve1",
+		},
+		{
+			name:      "migrated",
+			resources: `[{"type": "qemu", "vmid": 101, "node": "pve1"}, {"type": "qemu", "vmid": 100, "node": "pve2", "status": "running"}]`,
+			wantNode:  "pve2",
+		},
+		{
+			name:        "missing",
+			resources:   `[{"type": "qemu", "vmid": 101, "node": "pve1"}]`,
+			wantRemoved: true,
+		},
+		{
+			name:        "vmid reused by a container",
+			resources:   `[{"type": "lxc", "vmid": 100, "node": "pve1"}]`,
+			wantRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &VmResource{cloudInventory: testInventory(fakePveApi(map[string]string{
+				"/cluster/resources":                                 tt.resources,
+				"/nodes/" + tt.wantNode + "/qemu/100/config":         config,
+				"/nodes/" + tt.wantNode + "/qemu/100/status/current": `{"status": "running"}`,
+			}))}
+
+			state := readResource(t, r, map[string]tftypes.Value{
+				"vmid": tftypes.NewValue(tftypes.Number, 100),
+				"node": tftypes.NewValue(tftypes.String, "pve1"),
+				"name": tftypes.NewValue(tftypes.String, "web-1"),
+			})
+			if removed := state.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Fatalf("removed = %t, want %t", removed, tt.wantRemoved)
+			}
+			if tt.wantRemoved {
+				return
+			}
+
+			var data VmResourceModel
+			if diags := state.Get(context.Background(), &data); diags.HasError() {
+				t.Fatalf("state.Get() diagnostics: %v", diags)
+			}
+			if data.Node.ValueString() != tt.wantNode {
+				t.Errorf("node = %s, want %s", data.Node, tt.wantNode)
+			}
+			if data.Cores.ValueInt64() != 2 || data.Memory.ValueInt64() != 2048 {
+				t.Errorf("config read from the wrong node, cores = %s, memory = %s", data.Cores, data.Memory)
+			}
+		})
+	}
+}
+
+func TestVmResourceMigrate(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		wantArgs map[string]string
+	}{
+		{name: "running", status: "running", wantArgs: map[string]string{"--target": "pve2", "--with-local-disks": "1", "--online": "1"}},
+		{name: "stopped", status: "stopped", wantArgs: map[string]string{"--target": "pve2", "--with-local-disks": "1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := fakePveApi(map[string]string{"/nodes/pve1/qemu/100/status/current": `{"status": "` + tt.status + `"}`})
+			conn.handlers["CreateProxmoxApi"] = func(req proto.Message) (proto.Message, error) {
+				return &pb.CreateProxmoxApiResponse{Success: true, Output: "UPID:pve1:000A1B2C:0F1E2D3C:67000000:qmigrate:100:root@pam:"}, nil
+			}
+			conn.handlers["WaitForTask"] = func(req proto.Message) (proto.Message, error) {
+				return &pb.WaitForTaskResponse{Finished: true, ExitStatus: "OK"}, nil
+			}
+			r := &VmResource{cloudInventory: testInventory(conn)}
+			client, _ := GetCloudRpcService(context.Background(), r.cloudInventory)
+
+			// waits even without wait_for_completion, the update continues on the target node
+			data := VmResourceModel{VmId: types.Int64Value(100), Node: types.StringValue("pve1"), Wait: types.BoolValue(false), Timeout: types.Int64Value(600)}
+			if err := r.migrate(context.Background(), client, &data, "pve2"); err != nil {
+				t.Fatalf("migrate() error = %s", err)
+			}
+
+			calls := conn.called("CreateProxmoxApi")
+			if len(calls) != 1 {
+				t.Fatalf("got %d create calls, want 1", len(calls))
+			}
+			creq := calls[0].(*pb.CreateProxmoxApiRequest)
+			if creq.ApiPath != "/nodes/pve1/qemu/100/migrate" {
+				t.Errorf("api path = %s", creq.ApiPath)
+			}
+			if !maps.Equal(creq.CreateArgs, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", creq.CreateArgs, tt.wantArgs)
+			}
+			if len(conn.called("WaitForTask")) != 1 {
+				t.Error("migration task wasn't awaited")
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmSnapshotResource{}
+var _ resource.ResourceWithImportState = &VmSnapshotResource{}
+
+// proxmox snapshot names, "current" is reserved for the live state
+var pveSnapshotNameRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_\-]{1,39}$`)
+
+func NewVmSnapshotResource() resource.Resource {
+	return &VmSnapshotResource{}
+}
+
+// VmSnapshotResource defines the resource implementation.
+type VmSnapshotResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmSnapshotResourceModel describes the resource data model.
+type VmSnapshotResourceModel struct {
+	VmId        types.Int64  `tfsdk:"vmid"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IncludeRam  types.Bool   `tfsdk:"include_ram"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+	Node        types.String `tfsdk:"node"`
+	SnapTime    types.Int64  `tfsdk:"snaptime"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// PveSnapshot is an entry of pvesh get /nodes/{node}/{type}/{vmid}/snapshot.
+type PveSnapshot struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	SnapTime    int64   `json:"snaptime"`
+	VmState     pveBool `json:"vmstate"`
+}
+
+func (r *VmSnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_snapshot"
+}
+
+func (r *VmSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Takes a snapshot of a vm or lxc container, e.g. before an upgrade. Destroying the resource deletes the snapshot, use the pxc_vm_snapshot_rollback action to roll back. Import with `<vmid>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the guest, the node is looked up in the cluster resources.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the snapshot.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pveSnapshotNameRe, "must start with a letter and contain 2 to 40 letters, digits, _ or -"),
+					stringvalidator.NoneOf("current"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the snapshot, changes are applied in place.",
+			},
+			"include_ram": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Saves the ram of a running qemu vm with the snapshot, a rollback then resumes the vm.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				MarkdownDescription: "Seconds to wait for the snapshot tasks to finish.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"node": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Node the guest ran on when the snapshot was taken.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snaptime": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unix timestamp of the snapshot.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *VmSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// snapshotPath resolves the current node of the guest and returns its snapshot api path.
+func (r *VmSnapshotResource) snapshotPath(ctx context.Context, client pb.CloudServiceClient, data *VmSnapshotResourceModel) (string, error) {
+	guest, err := findPveGuest(ctx, client, r.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if err != nil {
+		return "", err
+	}
+	if guest == nil {
+		return "", fmt.Errorf("guest %d doesn't exist", data.VmId.ValueInt64())
+	}
+	data.Node = types.StringValue(guest.Node)
+
+	return fmt.Sprintf("/nodes/%s/%s/%d/snapshot", guest.Node, guest.Type, guest.VmId), nil
+}
+
+// findSnapshot looks up a snapshot of the guest, nil if it doesn't exist.
+func (r *VmSnapshotResource) findSnapshot(ctx context.Context, client pb.CloudServiceClient, snapshotPath string, name string) (*PveSnapshot, error) {
+	var snapshots []PveSnapshot
+	err := getPveApiJson(ctx, client, r.cloudInventory.TargetPve, snapshotPath, &snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Name == name {
+			return &snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *VmSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VmSnapshotResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	snapshotPath, err := r.snapshotPath(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve guest, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{"--snapname": data.Name.ValueString()}
+	if !data.Description.IsNull() {
+		createArgs["--description"] = data.Description.ValueString()
+	}
+	// lxc containers don't support ram snapshots
+	if data.IncludeRam.ValueBool() {
+		createArgs["--vmstate"] = "1"
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", snapshotPath, createArgs, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to create snapshot, got error: %s", err))
+		return
+	}
+
+	snapshot, err := r.findSnapshot(ctx, client, snapshotPath, data.Name.ValueString())
+	if err != nil || snapshot == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created snapshot, got error: %v", err))
+		return
+	}
+	data.SnapTime = types.Int64Value(snapshot.SnapTime)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VmSnapshotResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	guest, err := findPveGuest(ctx, client, r.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if removeIfMissing(ctx, guest != nil, err, "guest", resp) {
+		return
+	}
+	data.Node = types.StringValue(guest.Node)
+
+	snapshot, err := r.findSnapshot(ctx, client, fmt.Sprintf("/nodes/%s/%s/%d/snapshot", guest.Node, guest.Type, guest.VmId), data.Name.ValueString())
+	if removeIfMissing(ctx, snapshot != nil, err, "snapshot", resp) {
+		return
+	}
+
+	// proxmox appends a newline to descriptions
+	description := strings.TrimSuffix(snapshot.Description, "\n")
+	if description != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(description)
+	}
+	data.IncludeRam = types.BoolValue(bool(snapshot.VmState))
+	data.SnapTime = types.Int64Value(snapshot.SnapTime)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VmSnapshotResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	snapshotPath, err := r.snapshotPath(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve guest, got error: %s", err))
+		return
+	}
+
+	// only the description can change in place
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/%s/config", snapshotPath, data.Name.ValueString()), map[string]string{"--description": data.Description.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to update snapshot description, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VmSnapshotResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	snapshotPath, err := r.snapshotPath(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve guest, got error: %s", err))
+		return
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", fmt.Sprintf("%s/%s", snapshotPath, data.Name.ValueString()), nil, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to delete snapshot, got error: %s", err))
+		return
+	}
+}
+
+func (r *VmSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmidStr, name, found := strings.Cut(req.ID, "/")
+	vmid, err := strconv.ParseInt(vmidStr, 10, 64)
+	if !found || err != nil || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format <vmid>/<name>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	// not part of the pve config, start with the default
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(600))...)
+}
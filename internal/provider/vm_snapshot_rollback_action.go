@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &VmSnapshotRollbackAction{}
+var _ action.ActionWithConfigure = &VmSnapshotRollbackAction{}
+
+func NewVmSnapshotRollbackAction() action.Action {
+	return &VmSnapshotRollbackAction{}
+}
+
+// VmSnapshotRollbackAction defines the action implementation.
+type VmSnapshotRollbackAction struct {
+	cloudInventory CloudInventory
+}
+
+// VmSnapshotRollbackActionModel describes the action data model.
+type VmSnapshotRollbackActionModel struct {
+	VmId     types.Int64  `tfsdk:"vmid"`
+	Snapshot types.String `tfsdk:"snapshot"`
+	Start    types.Bool   `tfsdk:"start"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *VmSnapshotRollbackAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_snapshot_rollback"
+}
+
+func (a *VmSnapshotRollbackAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rolls a vm or lxc container back to a snapshot and waits for the rollback task. All changes since the snapshot are lost.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the guest, the node is looked up in the cluster resources.",
+			},
+			"snapshot": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the snapshot to roll back to.",
+			},
+			"start": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Starts the guest after the rollback, snapshots including ram are always resumed. Defaults to false.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Seconds to wait for the rollback to finish, defaults to 600.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *VmSnapshotRollbackAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.cloudInventory = cloudInv
+}
+
+func (a *VmSnapshotRollbackAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data VmSnapshotRollbackActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := GetCloudRpcService(ctx, a.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	guest, err := findPveGuest(ctx, client, a.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list guests, got error: %s", err))
+		return
+	}
+	if guest == nil {
+		resp.Diagnostics.AddError("Guest not found", fmt.Sprintf("No vm or lxc container with vmid %d exists.", data.VmId.ValueInt64()))
+		return
+	}
+
+	args := map[string]string{}
+	if data.Start.ValueBool() {
+		args["--start"] = "1"
+	}
+
+	apiPath := fmt.Sprintf("/nodes/%s/%s/%d/snapshot/%s/rollback", guest.Node, guest.Type, guest.VmId, data.Snapshot.ValueString())
+	output, err := pveApiCall(ctx, client, a.cloudInventory.TargetPve, "POST", apiPath, args)
+	if err != nil {
+		resp.Diagnostics.AddError("Api Call Error", fmt.Sprintf("Unable to roll back guest %d, got error: %s", guest.VmId, err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Started task %s", output)})
+
+	timeout := int64(600)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+	err = pveWaitForTask(ctx, client, a.cloudInventory.TargetPve, output, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Task Error", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Guest %d rolled back to %s", guest.VmId, data.Snapshot.ValueString())})
+}
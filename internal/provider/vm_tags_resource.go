@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmTagsResource{}
+var _ resource.ResourceWithImportState = &VmTagsResource{}
+
+// characters proxmox accepts in tags
+var pveTagRe = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_\-+.]*$`)
+
+func NewVmTagsResource() resource.Resource {
+	return &VmTagsResource{}
+}
+
+// VmTagsResource defines the resource implementation.
+type VmTagsResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmTagsResourceModel describes the resource data model.
+type VmTagsResourceModel struct {
+	VmId types.Int64  `tfsdk:"vmid"`
+	Tags []string     `tfsdk:"tags"`
+	Mode types.String `tfsdk:"mode"`
+	Node types.String `tfsdk:"node"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *VmTagsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_tags"
+}
+
+func (r *VmTagsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the tags of an existing vm or lxc container. The cloud managed `<blake_id>-blake` tag is always preserved. Import with `<vmid>` (merge mode) or `<vmid>/authoritative`.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Id of the guest.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Tags to set on the guest.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(pveTagRe, "must be a valid proxmox tag")),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("merge"),
+				MarkdownDescription: "`merge` only adds and removes the tags of this resource and leaves other tags alone. `authoritative` removes all tags that are not part of tags (except the blake tag).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("merge", "authoritative"),
+				},
+			},
+			"node": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Node the guest currently runs on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *VmTagsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// splitTags splits the semicolon separated tags of a guest.
+func splitTags(tags string) []string {
+	if tags == "" {
+		return []string{}
+	}
+	return strings.Split(tags, ";")
+}
+
+func isBlakeTag(tag string) bool {
+	return strings.HasSuffix(tag, "-blake")
+}
+
+// desiredTags computes the new tag list of the guest from its current tags,
+// the tags previously managed by the resource and the planned ones.
+func desiredTags(mode string, current []string, previous []string, planned []string) []string {
+	tags := []string{}
+	for _, tag := range current {
+		keep := isBlakeTag(tag) || (mode == "merge" && !slices.Contains(previous, tag))
+		if keep || slices.Contains(planned, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	for _, tag := range planned {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// applyTags resolves the guest and replaces its tags with the desired ones.
+func (r *VmTagsResource) applyTags(ctx context.Context, data *VmTagsResourceModel, previous []string, planned []string) error {
+	for _, tag := range planned {
+		if isBlakeTag(tag) {
+			return fmt.Errorf("tag %s is managed by the cloud and can't be set", tag)
+		}
+	}
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return err
+	}
+
+	guest, err := findPveGuest(ctx, client, r.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if err != nil {
+		return err
+	}
+	if guest == nil {
+		return fmt.Errorf("guest %d doesn't exist", data.VmId.ValueInt64())
+	}
+	data.Node = types.StringValue(guest.Node)
+
+	tags := desiredTags(data.Mode.ValueString(), splitTags(guest.Tags), previous, planned)
+	apiPath := fmt.Sprintf("/nodes/%s/%s/%d/config", guest.Node, guest.Type, guest.VmId)
+	if len(tags) == 0 {
+		return pveApiSet(ctx, client, r.cloudInventory.TargetPve, apiPath, map[string]string{"--delete": "tags"})
+	}
+	return pveApiSet(ctx, client, r.cloudInventory.TargetPve, apiPath, map[string]string{"--tags": strings.Join(tags, ";")})
+}
+
+func (r *VmTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VmTagsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	if err := r.applyTags(ctx, &data, nil, data.Tags); err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to set guest tags, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VmTagsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	guest, err := findPveGuest(ctx, client, r.cloudInventory.TargetPve, data.VmId.ValueInt64())
+	if removeIfMissing(ctx, guest != nil, err, "guest", resp) {
+		return
+	}
+	data.Node = types.StringValue(guest.Node)
+
+	// in merge mode foreign tags are ignored, removed managed tags show up as drift
+	tags := []string{}
+	for _, tag := range splitTags(guest.Tags) {
+		if isBlakeTag(tag) {
+			continue
+		}
+		if data.Mode.ValueString() == "authoritative" || slices.Contains(data.Tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	data.Tags = tags
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VmTagsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	if err := r.applyTags(ctx, &data, state.Tags, data.Tags); err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to set guest tags, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VmTagsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	// authoritative mode leaves only the blake tag behind
+	if err := r.applyTags(ctx, &data, data.Tags, nil); err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to remove guest tags, got error: %s", err))
+		return
+	}
+}
+
+func (r *VmTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmidStr, mode, found := strings.Cut(req.ID, "/")
+	if !found {
+		mode = "merge"
+	}
+
+	vmid, err := strconv.ParseInt(vmidStr, 10, 64)
+	if err != nil || (mode != "merge" && mode != "authoritative") {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import id in the format <vmid>[/<mode>], got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), mode)...)
+}
@@ -0,0 +1,504 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmTemplateResource{}
+
+// file names proxmox accepts for disk images in the import content of a storage
+var vmImageFilenameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-]*\.(qcow2|raw|vmdk)$`)
+
+func NewVmTemplateResource() resource.Resource {
+	return &VmTemplateResource{}
+}
+
+// VmTemplateResource defines the resource implementation.
+type VmTemplateResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmTemplateResourceModel describes the resource data model.
+type VmTemplateResourceModel struct {
+	VmId              types.Int64       `tfsdk:"vmid"`
+	Node              types.String      `tfsdk:"node"`
+	Name              types.String      `tfsdk:"name"`
+	ImageUrl          types.String      `tfsdk:"image_url"`
+	ImageFilename     types.String      `tfsdk:"image_filename"`
+	ImageStorage      types.String      `tfsdk:"image_storage"`
+	Checksum          types.String      `tfsdk:"checksum"`
+	ChecksumAlgorithm types.String      `tfsdk:"checksum_algorithm"`
+	KeepImage         types.Bool        `tfsdk:"keep_image"`
+	DiskStorage       types.String      `tfsdk:"disk_storage"`
+	DiskSize          types.Int64       `tfsdk:"disk_size"`
+	Cores             types.Int64       `tfsdk:"cores"`
+	Memory            types.Int64       `tfsdk:"memory"`
+	Bridge            types.String      `tfsdk:"bridge"`
+	Agent             types.Bool        `tfsdk:"agent"`
+	SerialConsole     types.Bool        `tfsdk:"serial_console"`
+	CloudInit         *VmCloudInitModel `tfsdk:"cloud_init"`
+	Timeout           types.Int64       `tfsdk:"timeout"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (data *VmTemplateResourceModel) apiPath() string {
+	return fmt.Sprintf("/nodes/%s/qemu/%d", data.Node.ValueString(), data.VmId.ValueInt64())
+}
+
+// imageFilename returns the file name the image is downloaded to, by default the last segment of the url.
+// Cloud images ending with .img, e.g. the ubuntu ones, are qcow2 images.
+func (data *VmTemplateResourceModel) imageFilename() (string, error) {
+	if !data.ImageFilename.IsNull() {
+		return data.ImageFilename.ValueString(), nil
+	}
+
+	imageUrl, err := url.Parse(data.ImageUrl.ValueString())
+	if err != nil {
+		return "", err
+	}
+	filename := path.Base(imageUrl.Path)
+	if strings.HasSuffix(filename, ".img") {
+		filename = strings.TrimSuffix(filename, ".img") + ".qcow2"
+	}
+	if !vmImageFilenameRe.MatchString(filename) {
+		return "", fmt.Errorf("can't derive an image file name from %s, set image_filename", data.ImageUrl.ValueString())
+	}
+	return filename, nil
+}
+
+func (r *VmTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_template"
+}
+
+func (r *VmTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Builds a qemu vm template from a cloud image: downloads the image to the import content of a storage, " +
+			"imports it as boot disk of a new vm with a cloud-init drive and converts the vm to a template. " +
+			"Clone it with the vmid of the template, changes to anything but the name rebuild the template.",
+
+		Attributes: map[string]schema.Attribute{
+			"vmid": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Vmid of the template, the next free vmid of the cluster if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Proxmox node the template is created on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the template, has to be a valid dns name.",
+			},
+			"image_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Url of the cloud image, e.g. `https://cloud.debian.org/images/cloud/bookworm/latest/debian-12-genericcloud-amd64.qcow2`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_filename": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "File name the image is stored as, with a .qcow2, .raw or .vmdk extension matching the image format. " +
+					"Defaults to the last segment of the url, images ending with .img are stored as .qcow2.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(vmImageFilenameRe, "must be a file name ending with .qcow2, .raw or .vmdk"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_storage": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "File based storage with the `import` content type the image is downloaded to. An image that already exists there is not downloaded again.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Expected checksum of the image, the download fails on a mismatch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sha256"),
+				MarkdownDescription: "Algorithm of the checksum.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(storageDownloadChecksumAlgorithms...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keep_image": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Keeps an image downloaded by this resource after the import instead of deleting it.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_storage": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Storage the boot disk of the template is imported to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Grows the imported boot disk to this size in GiB, the size of the image if not set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"cores": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Number of cpu cores.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"memory": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(512),
+				MarkdownDescription: "Memory in MiB.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"bridge": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("vmbr0"),
+				MarkdownDescription: "Bridge the virtio nic of the template is attached to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Enables the qemu guest agent, most cloud images ship it.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"serial_console": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Adds a serial port used as display, cloud images like the debian ones only log to the serial console.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_init": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Cloud-init defaults of the template, clones inherit them.",
+				Attributes:          vmCloudInitAttributes(),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1800),
+				MarkdownDescription: "Seconds to wait for the download, import and template tasks.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *VmTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// downloadImage downloads the image to the import content of the image storage unless it exists already.
+// It returns the volume id of the image and whether it was downloaded.
+func (r *VmTemplateResource) downloadImage(ctx context.Context, client pb.CloudServiceClient, data *VmTemplateResourceModel) (string, bool, error) {
+	filename, err := data.imageFilename()
+	if err != nil {
+		return "", false, err
+	}
+
+	download := storageDownload{
+		Node:               data.Node.ValueString(),
+		Storage:            data.ImageStorage.ValueString(),
+		Content:            "import",
+		Url:                data.ImageUrl.ValueString(),
+		Filename:           filename,
+		Checksum:           data.Checksum.ValueString(),
+		ChecksumAlgorithm:  data.ChecksumAlgorithm.ValueString(),
+		VerifyCertificates: true,
+	}
+
+	size, err := findStorageContent(ctx, client, r.cloudInventory.TargetPve, download.Node, download.Storage, download.Content, download.volumeId())
+	if err != nil || size != nil {
+		return download.volumeId(), false, err
+	}
+
+	err = downloadToStorage(ctx, client, r.cloudInventory.TargetPve, download, false, data.Timeout.ValueInt64())
+	return download.volumeId(), err == nil, err
+}
+
+func (r *VmTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VmTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	if data.VmId.IsUnknown() {
+		vmId, err := pveNextVmId(ctx, client, r.cloudInventory.TargetPve)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get the next free vmid, got error: %s", err))
+			return
+		}
+		data.VmId = types.Int64Value(vmId)
+	}
+
+	volume, downloaded, err := r.downloadImage(ctx, client, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to download image, got error: %s", err))
+		return
+	}
+
+	createArgs := map[string]string{
+		"--vmid":   strconv.FormatInt(data.VmId.ValueInt64(), 10),
+		"--name":   data.Name.ValueString(),
+		"--cores":  strconv.FormatInt(data.Cores.ValueInt64(), 10),
+		"--memory": strconv.FormatInt(data.Memory.ValueInt64(), 10),
+		"--scsihw": "virtio-scsi-single",
+		"--scsi0":  fmt.Sprintf("%s:0,import-from=%s", data.DiskStorage.ValueString(), volume),
+		"--boot":   "order=scsi0",
+		"--net0":   "virtio,bridge=" + data.Bridge.ValueString(),
+		"--agent":  boolToPve(data.Agent.ValueBool()),
+	}
+	if data.SerialConsole.ValueBool() {
+		createArgs["--serial0"] = "socket"
+		createArgs["--vga"] = "serial0"
+	}
+	createArgs["--"+vmCloudInitSlot] = data.CloudInit.Storage.ValueString() + ":cloudinit"
+	data.CloudInit.cloudInitArgs(createArgs)
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("/nodes/%s/qemu", data.Node.ValueString()), createArgs, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to create vm, got error: %s", err))
+		return
+	}
+
+	if !data.DiskSize.IsNull() {
+		err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/resize", data.apiPath()), map[string]string{
+			"--disk": "scsi0",
+			"--size": fmt.Sprintf("%dG", data.DiskSize.ValueInt64()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to resize imported disk, got error: %s", err))
+			return
+		}
+	}
+
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "POST", fmt.Sprintf("%s/template", data.apiPath()), nil, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to convert vm to template, got error: %s", err))
+		return
+	}
+
+	// images that existed before might be used by other templates
+	if downloaded && !data.KeepImage.ValueBool() {
+		err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", fmt.Sprintf("/nodes/%s/storage/%s/content/%s", data.Node.ValueString(), data.ImageStorage.ValueString(), volume), nil, true, data.Timeout.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to delete downloaded image, got error: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VmTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	exists, err := pveApiEntryExists(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("/nodes/%s/qemu", data.Node.ValueString()), "vmid", strconv.FormatInt(data.VmId.ValueInt64(), 10))
+	if removeIfMissing(ctx, exists, err, "vm template", resp) {
+		return
+	}
+
+	var config map[string]interface{}
+	err = getPveApiJson(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read template config, got error: %s", err))
+		return
+	}
+
+	// a vm that isn't a template anymore has to be rebuilt
+	if template, _ := pveConfigInt(config, "template"); template != 1 {
+		removeIfMissing(ctx, false, nil, "vm template", resp)
+		return
+	}
+
+	if name, ok := pveConfigString(config, "name"); ok {
+		data.Name = types.StringValue(name)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VmTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// everything but the name and the timeout requires a replacement
+	err = pveApiSet(ctx, client, r.cloudInventory.TargetPve, fmt.Sprintf("%s/config", data.apiPath()), map[string]string{"--name": data.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to rename template, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VmTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// fails while linked clones of the template exist
+	err = pveApiCallWait(ctx, client, r.cloudInventory.TargetPve, "DELETE", data.apiPath(), nil, true, data.Timeout.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Unable to delete template, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmVarsResource{}
+var _ resource.ResourceWithImportState = &VmVarsResource{}
+
+func NewVmVarsResource() resource.Resource {
+	return &VmVarsResource{}
+}
+
+// VmVarsResource defines the resource implementation.
+type VmVarsResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmVarsResourceModel describes the resource data model.
+type VmVarsResourceModel struct {
+	BlakeId types.String `tfsdk:"blake_id"`
+	Vars    types.String `tfsdk:"vars"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+func (r *VmVarsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm_vars"
+}
+
+func (r *VmVarsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the vm vars the cloud attaches to a guest via its blake id (tag `<blake_id>-blake`). The resource owns all vars of the blake id, vars set by other tooling are overwritten. Import with `<blake_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"blake_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Blake id of the guest, without the `-blake` tag suffix.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vars": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Vm vars as json object string, use jsonencode to pass your terraform object. Changes are applied in place.",
+				Validators: []validator.String{
+					jsonObjectValidator{},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *VmVarsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+func (r *VmVarsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VmVarsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	if err := r.setVars(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to set vm vars, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmVarsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VmVarsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.GetVmVarsBlake(ctx, &pb.GetVmVarsBlakeRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, BlakeIds: []string{data.BlakeId.ValueString()}})
+	var vars string
+	exists := false
+	if err == nil {
+		vars, exists = cresp.BlakeIdVars[data.BlakeId.ValueString()]
+	}
+	if removeIfMissing(ctx, exists, err, "vm vars", resp) {
+		return
+	}
+
+	// postgres stores the vars as jsonb, only take them over if they differ semantically
+	if !jsonEqual(data.Vars.ValueString(), vars) {
+		data.Vars = types.StringValue(vars)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmVarsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VmVarsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "update")
+	defer cancel()
+
+	if err := r.setVars(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Update Call Error", fmt.Sprintf("Unable to set vm vars, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmVarsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VmVarsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.DeleteVmVarsBlake(ctx, &pb.DeleteVmVarsBlakeRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, BlakeId: data.BlakeId.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable make grp delete vm vars request, got error: %s", err))
+		return
+	}
+
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error on server side deleting vm vars, got error: %s", cresp.ErrMessage))
+		return
+	}
+}
+
+// setVars creates or replaces the vars of the blake id.
+func (r *VmVarsResource) setVars(ctx context.Context, data VmVarsResourceModel) error {
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		return err
+	}
+
+	cresp, err := client.SetVmVarsBlake(ctx, &pb.SetVmVarsBlakeRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, BlakeId: data.BlakeId.ValueString(), Vars: data.Vars.ValueString()})
+	if err != nil {
+		return err
+	}
+
+	if !cresp.Success {
+		return fmt.Errorf("server side error: %s", cresp.ErrMessage)
+	}
+
+	return nil
+}
+
+func (r *VmVarsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("blake_id"), req, resp)
+}
+
+// jsonObjectValidator checks that a string attribute holds a json object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "must be a json encoded object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil || obj == nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON Object", "Expected a json encoded object, use jsonencode on a terraform object or map.")
+	}
+}
@@ -0,0 +1,395 @@
+// Copyright IBM Corp. 2021, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VmIdRangeResource{}
+var _ resource.ResourceWithImportState = &VmIdRangeResource{}
+
+// ranges are cloud secrets of the shared namespace, so all stacks see each other's reservations
+const (
+	vmIdRangeSecretType = "vmid_range"
+	vmIdRangeLockName   = "vmid-range-lock"
+	// the lock expires in case the holder dies, allocations only take a few calls
+	vmIdRangeLockTtl  = 2 * time.Minute
+	vmIdRangeLockPoll = 2 * time.Second
+	vmIdMax           = 999999999
+)
+
+func NewVmIdRangeResource() resource.Resource {
+	return &VmIdRangeResource{}
+}
+
+// VmIdRangeResource defines the resource implementation.
+type VmIdRangeResource struct {
+	cloudInventory CloudInventory
+}
+
+// VmIdRangeResourceModel describes the resource data model.
+type VmIdRangeResourceModel struct {
+	Stack types.String `tfsdk:"stack"`
+	Size  types.Int64  `tfsdk:"size"`
+	Min   types.Int64  `tfsdk:"min"`
+	Start types.Int64  `tfsdk:"start"`
+	End   types.Int64  `tfsdk:"end"`
+
+	Timeouts *TimeoutsModel `tfsdk:"timeouts"`
+}
+
+// vmIdRange is the secret data of a reserved range.
+type vmIdRange struct {
+	Stack string `json:"stack"`
+	Start int64  `json:"start"`
+	Count int64  `json:"count"`
+}
+
+func vmIdRangeSecretName(start int64) string {
+	return fmt.Sprintf("vmid-range-%d", start)
+}
+
+// freeVmIdRangeStart finds the lowest start >= min for count ids that overlap neither a
+// reserved range nor an existing guest.
+func freeVmIdRangeStart(ranges []vmIdRange, guests []PveClusterVm, min int64, count int64) (int64, bool) {
+	start := min
+	for start+count-1 <= vmIdMax {
+		// the next candidate starts behind the furthest conflict
+		next := int64(-1)
+		for _, reserved := range ranges {
+			if start < reserved.Start+reserved.Count && reserved.Start < start+count {
+				next = max(next, reserved.Start+reserved.Count)
+			}
+		}
+		for _, guest := range guests {
+			if guest.VmId >= start && guest.VmId < start+count {
+				next = max(next, guest.VmId+1)
+			}
+		}
+
+		if next < 0 {
+			return start, true
+		}
+		start = next
+	}
+	return 0, false
+}
+
+func (r *VmIdRangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vmid_range"
+}
+
+func (r *VmIdRangeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reserves a contiguous range of vmids for a stack, so stacks provisioning vms concurrently don't collide on `/cluster/nextid`. " +
+			"Reservations are stored as cloud secrets in the clouds patroni postgres and allocated under a lock, " +
+			"ranges never overlap other reservations or existing guests. Import with `<start>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"stack": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the stack the range is reserved for, informational.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Number of vmids to reserve. Changing it reserves a new range.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"min": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(100),
+				MarkdownDescription: "Lowest vmid the range may start at, e.g. to keep stacks out of manually managed ids.",
+				Validators: []validator.Int64{
+					int64validator.Between(100, vmIdMax),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "First vmid of the range.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"end": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Last vmid of the range, inclusive.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+func (r *VmIdRangeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	cloudInv, ok := req.ProviderData.(CloudInventory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *KubesprayInventory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.cloudInventory = cloudInv
+}
+
+// lock takes the allocation lock, creating the lock secret fails while another stack holds it.
+func (r *VmIdRangeResource) lock(ctx context.Context, client pb.CloudServiceClient) (func(), error) {
+	for {
+		cresp, err := client.CreateCloudSecret(ctx, &pb.CreateCloudSecretRequest{
+			CloudDomain: r.cloudInventory.CloudDomain,
+			TargetPve:   r.cloudInventory.TargetPve,
+			SecretName:  vmIdRangeLockName,
+			SecretType:  "lock",
+			SecretData:  "{}",
+			ExpiresAt:   time.Now().UTC().Add(vmIdRangeLockTtl).Format(time.RFC3339),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if cresp.Success {
+			return func() {
+				// released even if ctx timed out, otherwise others wait for the expiry
+				_, err := client.DeleteCloudSecret(context.WithoutCancel(ctx), &pb.DeleteCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: vmIdRangeLockName})
+				if err != nil {
+					tflog.Warn(ctx, fmt.Sprintf("Unable to release the vmid range lock, it expires in %s: %s", vmIdRangeLockTtl, err))
+				}
+			}, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for the vmid range lock held by another allocation")
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for the vmid range lock: %w", ctx.Err())
+		case <-time.After(vmIdRangeLockPoll):
+		}
+	}
+}
+
+// allocate reserves a free range while holding the lock and returns its start.
+func (r *VmIdRangeResource) allocate(ctx context.Context, client pb.CloudServiceClient, stack string, min int64, count int64) (int64, error) {
+	unlock, err := r.lock(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	sresp, err := client.GetCloudSecrets(ctx, &pb.GetCloudSecretsRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretType: vmIdRangeSecretType})
+	if err != nil {
+		return 0, err
+	}
+
+	var reserved map[string]vmIdRange
+	err = json.Unmarshal([]byte(sresp.Secrets), &reserved)
+	if err != nil {
+		return 0, fmt.Errorf("invalid vmid range secrets: %w", err)
+	}
+
+	var guests []PveClusterVm
+	err = getPveApiJsonArgs(ctx, client, r.cloudInventory.TargetPve, "/cluster/resources", map[string]string{"--type": "vm"}, &guests)
+	if err != nil {
+		return 0, err
+	}
+
+	ranges := make([]vmIdRange, 0, len(reserved))
+	for _, reservedRange := range reserved {
+		ranges = append(ranges, reservedRange)
+	}
+	start, ok := freeVmIdRangeStart(ranges, guests, min, count)
+	if !ok {
+		return 0, fmt.Errorf("no %d free vmids above %d", count, min)
+	}
+
+	secretData, err := json.Marshal(vmIdRange{Stack: stack, Start: start, Count: count})
+	if err != nil {
+		return 0, err
+	}
+
+	cresp, err := client.CreateCloudSecret(ctx, &pb.CreateCloudSecretRequest{
+		CloudDomain: r.cloudInventory.CloudDomain,
+		TargetPve:   r.cloudInventory.TargetPve,
+		SecretName:  vmIdRangeSecretName(start),
+		SecretType:  vmIdRangeSecretType,
+		SecretData:  string(secretData),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !cresp.Success {
+		return 0, fmt.Errorf("error on server side creating the range secret: %s", cresp.ErrMessage)
+	}
+
+	return start, nil
+}
+
+func (r *VmIdRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VmIdRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "create")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	start, err := r.allocate(ctx, client, data.Stack.ValueString(), data.Min.ValueInt64(), data.Size.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Create Call Error", fmt.Sprintf("Unable to reserve %d vmids, got error: %s", data.Size.ValueInt64(), err))
+		return
+	}
+
+	data.Start = types.Int64Value(start)
+	data.End = types.Int64Value(start + data.Size.ValueInt64() - 1)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmIdRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VmIdRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "read")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	cresp, err := client.GetCloudSecretByName(ctx, &pb.GetCloudSecretByNameRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: vmIdRangeSecretName(data.Start.ValueInt64())})
+	if removeIfMissing(ctx, err == nil && cresp.Found, err, "vmid range", resp) {
+		return
+	}
+
+	var reserved vmIdRange
+	err = json.Unmarshal([]byte(cresp.SecretData), &reserved)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse vmid range, got error: %s", err))
+		return
+	}
+
+	data.Stack = types.StringValue(reserved.Stack)
+	data.Size = types.Int64Value(reserved.Count)
+	data.End = types.Int64Value(reserved.Start + reserved.Count - 1)
+	if data.Min.IsNull() {
+		data.Min = types.Int64Value(100) // imported
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmIdRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VmIdRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the timeouts can change in place
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VmIdRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VmIdRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := timeoutContext(ctx, data.Timeouts, "delete")
+	defer cancel()
+
+	client, err := GetCloudRpcService(ctx, r.cloudInventory)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to init client, got error: %s", err))
+		return
+	}
+
+	// guests still using ids of the range keep them, they are skipped by later allocations
+	cresp, err := client.DeleteCloudSecret(ctx, &pb.DeleteCloudSecretRequest{CloudDomain: r.cloudInventory.CloudDomain, TargetPve: r.cloudInventory.TargetPve, SecretName: vmIdRangeSecretName(data.Start.ValueInt64())})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete vmid range, got error: %s", err))
+		return
+	}
+	if !cresp.Success {
+		resp.Diagnostics.AddError("Delete Call Error", fmt.Sprintf("Error on server side deleting vmid range, got error: %s", cresp.ErrMessage))
+		return
+	}
+}
+
+func (r *VmIdRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	start, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <start>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("start"), start)...)
+}
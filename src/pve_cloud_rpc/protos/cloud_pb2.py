@@ -24,7 +24,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0b\x63loud.proto\x12\x06protos\",\n\x16GetPveInventoryRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"B\n\x17GetPveInventoryResponse\x12\x11\n\tinventory\x18\x01 \x01(\t\x12\x14\n\x0c\x63loud_domain\x18\x02 \x01(\t\"+\n\x15GetProxmoxHostRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"*\n\x16GetProxmoxHostResponse\x12\x10\n\x08pve_host\x18\x01 \x01(\t\"\xa9\x01\n\x14GetProxmoxApiRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x10\n\x08\x61pi_path\x18\x02 \x01(\t\x12;\n\x08get_args\x18\x03 \x03(\x0b\x32).protos.GetProxmoxApiRequest.GetArgsEntry\x1a.\n\x0cGetArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"*\n\x15GetProxmoxApiResponse\x12\x11\n\tjson_resp\x18\x01 \x01(\t\"\xb8\x01\n\x17\x43reateProxmoxApiRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x10\n\x08\x61pi_path\x18\x02 \x01(\t\x12\x44\n\x0b\x63reate_args\x18\x03 \x03(\x0b\x32/.protos.CreateProxmoxApiRequest.CreateArgsEntry\x1a\x31\n\x0f\x43reateArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"@\n\x18\x43reateProxmoxApiResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"?\n\x17\x44\x65leteProxmoxApiRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x10\n\x08\x61pi_path\x18\x02 \x01(\t\"@\n\x18\x44\x65leteProxmoxApiResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"\x87\x01\n\x10GetSshKeyRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x32\n\x08key_type\x18\x02 \x01(\x0e\x32 .protos.GetSshKeyRequest.KeyType\"+\n\x07KeyType\x12\x0e\n\nAUTOMATION\x10\x00\x12\x10\n\x0cPVE_HOST_RSA\x10\x01\" \n\x11GetSshKeyResponse\x12\x0b\n\x03key\x18\x01 \x01(\t\"*\n\x14GetCephAccessRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"A\n\x15GetCephAccessResponse\x12\x11\n\tceph_conf\x18\x01 \x01(\t\x12\x15\n\radmin_keyring\x18\x02 \x01(\t\">\n\x14GetKubeconfigRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x12\n\nstack_name\x18\x02 \x01(\t\"\'\n\x15GetKubeconfigResponse\x12\x0e\n\x06\x63onfig\x18\x01 \x01(\t\"+\n\x15GetClusterVarsRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"&\n\x16GetClusterVarsResponse\x12\x0c\n\x04vars\x18\x01 \x01(\t\"T\n\x19GetCloudFileSecretRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x13\n\x0bsecret_name\x18\x02 \x01(\t\x12\x0e\n\x06rstrip\x18\x03 \x01(\x08\",\n\x1aGetCloudFileSecretResponse\x12\x0e\n\x06secret\x18\x01 \x01(\t\"\x83\x01\n\x18\x43reateCloudSecretRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\x12\x13\n\x0bsecret_data\x18\x04 \x01(\t\x12\x13\n\x0bsecret_type\x18\x05 \x01(\t\"A\n\x19\x43reateCloudSecretResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"Y\n\x18\x44\x65leteCloudSecretRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\"A\n\x19\x44\x65leteCloudSecretResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"V\n\x15GetCloudSecretRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\"(\n\x16GetCloudSecretResponse\x12\x0e\n\x06secret\x18\x01 \x01(\t\"W\n\x16GetCloudSecretsRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_type\x18\x03 \x01(\t\"*\n\x17GetCloudSecretsResponse\x12\x0f\n\x07secrets\x18\x01 \x01(\t\"T\n\x15GetVmVarsBlakeRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x14\n\x0c\x63loud_domain\x18\x02 \x01(\t\x12\x11\n\tblake_ids\x18\x03 \x03(\t\"\x94\x01\n\x16GetVmVarsBlakeResponse\x12\x46\n\rblake_id_vars\x18\x01 \x03(\x0b\x32/.protos.GetVmVarsBlakeResponse.BlakeIdVarsEntry\x1a\x32\n\x10\x42lakeIdVarsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"+\n\x15GetCloudDomainRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"(\n\x16GetCloudDomainResponse\x12\x0e\n\x06\x64omain\x18\x01 \x01(\t2\xbc\n\n\x0c\x43loudService\x12R\n\x13GetMasterKubeconfig\x12\x1c.protos.GetKubeconfigRequest\x1a\x1d.protos.GetKubeconfigResponse\x12O\n\x0eGetClusterVars\x12\x1d.protos.GetClusterVarsRequest\x1a\x1e.protos.GetClusterVarsResponse\x12[\n\x12GetCloudFileSecret\x12!.protos.GetCloudFileSecretRequest\x1a\".protos.GetCloudFileSecretResponse\x12X\n\x11\x43reateCloudSecret\x12 .protos.CreateCloudSecretRequest\x1a!.protos.CreateCloudSecretResponse\x12X\n\x11\x44\x65leteCloudSecret\x12 .protos.DeleteCloudSecretRequest\x1a!.protos.DeleteCloudSecretResponse\x12O\n\x0eGetCloudSecret\x12\x1d.protos.GetCloudSecretRequest\x1a\x1e.protos.GetCloudSecretResponse\x12R\n\x0fGetCloudSecrets\x12\x1e.protos.GetCloudSecretsRequest\x1a\x1f.protos.GetCloudSecretsResponse\x12L\n\rGetCephAccess\x12\x1c.protos.GetCephAccessRequest\x1a\x1d.protos.GetCephAccessResponse\x12@\n\tGetSshKey\x12\x18.protos.GetSshKeyRequest\x1a\x19.protos.GetSshKeyResponse\x12L\n\rGetProxmoxApi\x12\x1c.protos.GetProxmoxApiRequest\x1a\x1d.protos.GetProxmoxApiResponse\x12U\n\x10\x43reateProxmoxApi\x12\x1f.protos.CreateProxmoxApiRequest\x1a .protos.CreateProxmoxApiResponse\x12U\n\x10\x44\x65leteProxmoxApi\x12\x1f.protos.DeleteProxmoxApiRequest\x1a .protos.DeleteProxmoxApiResponse\x12O\n\x0eGetProxmoxHost\x12\x1d.protos.GetProxmoxHostRequest\x1a\x1e.protos.GetProxmoxHostResponse\x12R\n\x0fGetPveInventory\x12\x1e.protos.GetPveInventoryRequest\x1a\x1f.protos.GetPveInventoryResponse\x12O\n\x0eGetCloudDomain\x12\x1d.protos.GetCloudDomainRequest\x1a\x1e.protos.GetCloudDomainResponse\x12O\n\x0eGetVmVarsBlake\x12\x1d.protos.GetVmVarsBlakeRequest\x1a\x1e.protos.GetVmVarsBlakeResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0b\x63loud.proto\x12\x06protos\",\n\x16GetPveInventoryRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"B\n\x17GetPveInventoryResponse\x12\x11\n\tinventory\x18\x01 \x01(\t\x12\x14\n\x0c\x63loud_domain\x18\x02 \x01(\t\"+\n\x15GetProxmoxHostRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"*\n\x16GetProxmoxHostResponse\x12\x10\n\x08pve_host\x18\x01 \x01(\t\"\xa9\x01\n\x14GetProxmoxApiRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x10\n\x08\x61pi_path\x18\x02 \x01(\t\x12;\n\x08get_args\x18\x03 \x03(\x0b\x32).protos.GetProxmoxApiRequest.GetArgsEntry\x1a.\n\x0cGetArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"*\n\x15GetProxmoxApiResponse\x12\x11\n\tjson_resp\x18\x01 \x01(\t\"\xb8\x01\n\x17\x43reateProxmoxApiRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x10\n\x08\x61pi_path\x18\x02 \x01(\t\x12\x44\n\x0b\x63reate_args\x18\x03 \x03(\x0b\x32/.protos.CreateProxmoxApiRequest.CreateArgsEntry\x1a\x31\n\x0f\x43reateArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n\x18\x43reateProxmoxApiResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\x12\x0e\n\x06output\x18\x03 \x01(\t\"\xb8\x01\n\x17\x44\x65leteProxmoxApiRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x10\n\x08\x61pi_path\x18\x02 \x01(\t\x12\x44\n\x0b\x64\x65lete_args\x18\x03 \x03(\x0b\x32/.protos.DeleteProxmoxApiRequest.DeleteArgsEntry\x1a\x31\n\x0f\x44\x65leteArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n\x18\x44\x65leteProxmoxApiResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\x12\x0e\n\x06output\x18\x03 \x01(\t\"\xa9\x01\n\x14SetProxmoxApiRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x10\n\x08\x61pi_path\x18\x02 \x01(\t\x12;\n\x08set_args\x18\x03 \x03(\x0b\x32).protos.SetProxmoxApiRequest.SetArgsEntry\x1a.\n\x0cSetArgsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"M\n\x15SetProxmoxApiResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\x12\x0e\n\x06output\x18\x03 \x01(\t\"b\n\x12WaitForTaskRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x0c\n\x04upid\x18\x02 \x01(\t\x12\x17\n\x0ftimeout_seconds\x18\x03 \x01(\x03\x12\x11\n\tlog_lines\x18\x04 \x01(\x03\"N\n\x13WaitForTaskResponse\x12\x10\n\x08\x66inished\x18\x01 \x01(\x08\x12\x13\n\x0b\x65xit_status\x18\x02 \x01(\t\x12\x10\n\x08log_tail\x18\x03 \x01(\t\"\x87\x01\n\x10GetSshKeyRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x32\n\x08key_type\x18\x02 \x01(\x0e\x32 .protos.GetSshKeyRequest.KeyType\"+\n\x07KeyType\x12\x0e\n\nAUTOMATION\x10\x00\x12\x10\n\x0cPVE_HOST_RSA\x10\x01\" \n\x11GetSshKeyResponse\x12\x0b\n\x03key\x18\x01 \x01(\t\"*\n\x14GetCephAccessRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"A\n\x15GetCephAccessResponse\x12\x11\n\tceph_conf\x18\x01 \x01(\t\x12\x15\n\radmin_keyring\x18\x02 \x01(\t\">\n\x14GetKubeconfigRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x12\n\nstack_name\x18\x02 \x01(\t\"\'\n\x15GetKubeconfigResponse\x12\x0e\n\x06\x63onfig\x18\x01 \x01(\t\"+\n\x15GetClusterVarsRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"&\n\x16GetClusterVarsResponse\x12\x0c\n\x04vars\x18\x01 \x01(\t\"T\n\x19GetCloudFileSecretRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x13\n\x0bsecret_name\x18\x02 \x01(\t\x12\x0e\n\x06rstrip\x18\x03 \x01(\x08\"9\n\x1aGetCloudFileSecretResponse\x12\x0e\n\x06secret\x18\x01 \x01(\t\x12\x0b\n\x03raw\x18\x02 \x01(\x0c\"\xaa\x01\n\x18\x43reateCloudSecretRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\x12\x13\n\x0bsecret_data\x18\x04 \x01(\t\x12\x13\n\x0bsecret_type\x18\x05 \x01(\t\x12\x11\n\tnamespace\x18\x06 \x01(\t\x12\x12\n\nexpires_at\x18\x07 \x01(\t\"A\n\x19\x43reateCloudSecretResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"l\n\x18\x44\x65leteCloudSecretRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\"A\n\x19\x44\x65leteCloudSecretResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"\xaa\x01\n\x18UpdateCloudSecretRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\x12\x13\n\x0bsecret_data\x18\x04 \x01(\t\x12\x13\n\x0bsecret_type\x18\x05 \x01(\t\x12\x11\n\tnamespace\x18\x06 \x01(\t\x12\x12\n\nexpires_at\x18\x07 \x01(\t\"A\n\x19UpdateCloudSecretResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"i\n\x15GetCloudSecretRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\"<\n\x16GetCloudSecretResponse\x12\x0e\n\x06secret\x18\x01 \x01(\t\x12\x12\n\nexpires_at\x18\x02 \x01(\t\"j\n\x16GetCloudSecretsRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_type\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\"*\n\x17GetCloudSecretsResponse\x12\x0f\n\x07secrets\x18\x01 \x01(\t\"o\n\x1bGetCloudSecretByNameRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_name\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\"k\n\x1cGetCloudSecretByNameResponse\x12\r\n\x05\x66ound\x18\x01 \x01(\x08\x12\x13\n\x0bsecret_data\x18\x02 \x01(\t\x12\x13\n\x0bsecret_type\x18\x03 \x01(\t\x12\x12\n\nexpires_at\x18\x04 \x01(\t\"n\n\x1aGetCloudSecretNamesRequest\x12\x14\n\x0c\x63loud_domain\x18\x01 \x01(\t\x12\x12\n\ntarget_pve\x18\x02 \x01(\t\x12\x13\n\x0bsecret_type\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\"w\n\x0f\x43loudSecretMeta\x12\x13\n\x0bsecret_name\x18\x01 \x01(\t\x12\x13\n\x0bsecret_type\x18\x02 \x01(\t\x12\x12\n\ncreated_at\x18\x03 \x01(\t\x12\x12\n\nupdated_at\x18\x04 \x01(\t\x12\x12\n\nexpires_at\x18\x05 \x01(\t\"G\n\x1bGetCloudSecretNamesResponse\x12(\n\x07secrets\x18\x01 \x03(\x0b\x32\x17.protos.CloudSecretMeta\"T\n\x15GetVmVarsBlakeRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x14\n\x0c\x63loud_domain\x18\x02 \x01(\t\x12\x11\n\tblake_ids\x18\x03 \x03(\t\"\x94\x01\n\x16GetVmVarsBlakeResponse\x12\x46\n\rblake_id_vars\x18\x01 \x03(\x0b\x32/.protos.GetVmVarsBlakeResponse.BlakeIdVarsEntry\x1a\x32\n\x10\x42lakeIdVarsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"2\n\x10VmVarsBlakeEntry\x12\x10\n\x08\x62lake_id\x18\x01 \x01(\t\x12\x0c\n\x04vars\x18\x02 \x01(\t\"a\n\x15SetVmVarsBlakeRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x14\n\x0c\x63loud_domain\x18\x02 \x01(\t\x12\x10\n\x08\x62lake_id\x18\x03 \x01(\t\x12\x0c\n\x04vars\x18\x04 \x01(\t\">\n\x16SetVmVarsBlakeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"V\n\x18\x44\x65leteVmVarsBlakeRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x14\n\x0c\x63loud_domain\x18\x02 \x01(\t\x12\x10\n\x08\x62lake_id\x18\x03 \x01(\t\"A\n\x19\x44\x65leteVmVarsBlakeResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"+\n\x15GetCloudDomainRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"(\n\x16GetCloudDomainResponse\x12\x0e\n\x06\x64omain\x18\x01 \x01(\t\"=\n\x19GetNodeProxyConfigRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x0c\n\x04node\x18\x02 \x01(\t\"\x8b\x01\n\x1aGetNodeProxyConfigResponse\x12>\n\x06\x63onfig\x18\x01 \x03(\x0b\x32..protos.GetNodeProxyConfigResponse.ConfigEntry\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xab\x01\n\x19SetNodeProxyConfigRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x0c\n\x04node\x18\x02 \x01(\t\x12=\n\x06\x63onfig\x18\x03 \x03(\x0b\x32-.protos.SetNodeProxyConfigRequest.ConfigEntry\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"B\n\x1aSetNodeProxyConfigResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x13\n\x0b\x65rr_message\x18\x02 \x01(\t\"\x80\x01\n\x12\x43\x65phCommandRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x02 \x03(\t\x12-\n\x04tool\x18\x03 \x01(\x0e\x32\x1f.protos.CephCommandRequest.Tool\"\x19\n\x04Tool\x12\x08\n\x04\x43\x45PH\x10\x00\x12\x07\n\x03RBD\x10\x01\"(\n\x13\x43\x65phCommandResponse\x12\x11\n\tjson_resp\x18\x01 \x01(\t\"s\n\x17WriteStorageFileRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x0c\n\x04node\x18\x02 \x01(\t\x12\x11\n\tvolume_id\x18\x03 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x04 \x01(\x0c\x12\x12\n\nexecutable\x18\x05 \x01(\x08\"\x1a\n\x18WriteStorageFileResponse\"M\n\x16HashStorageFileRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x0c\n\x04node\x18\x02 \x01(\t\x12\x11\n\tvolume_id\x18\x03 \x01(\t\")\n\x17HashStorageFileResponse\x12\x0e\n\x06sha256\x18\x01 \x01(\t\"O\n\x18\x44\x65leteStorageFileRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\x12\x0c\n\x04node\x18\x02 \x01(\t\x12\x11\n\tvolume_id\x18\x03 \x01(\t\"\x1b\n\x19\x44\x65leteStorageFileResponse*_\n\tErrorCode\x12\x1a\n\x16\x45RROR_CODE_UNSPECIFIED\x10\x00\x12\r\n\tNOT_FOUND\x10\x01\x12\x0c\n\x08\x43ONFLICT\x10\x02\x12\x0f\n\x0bUNREACHABLE\x10\x03\x12\x08\n\x04\x41UTH\x10\x04\x32\xf1\x13\n\x0c\x43loudService\x12R\n\x13GetMasterKubeconfig\x12\x1c.protos.GetKubeconfigRequest\x1a\x1d.protos.GetKubeconfigResponse\x12O\n\x0eGetClusterVars\x12\x1d.protos.GetClusterVarsRequest\x1a\x1e.protos.GetClusterVarsResponse\x12[\n\x12GetCloudFileSecret\x12!.protos.GetCloudFileSecretRequest\x1a\".protos.GetCloudFileSecretResponse\x12X\n\x11\x43reateCloudSecret\x12 .protos.CreateCloudSecretRequest\x1a!.protos.CreateCloudSecretResponse\x12X\n\x11\x44\x65leteCloudSecret\x12 .protos.DeleteCloudSecretRequest\x1a!.protos.DeleteCloudSecretResponse\x12X\n\x11UpdateCloudSecret\x12 .protos.UpdateCloudSecretRequest\x1a!.protos.UpdateCloudSecretResponse\x12O\n\x0eGetCloudSecret\x12\x1d.protos.GetCloudSecretRequest\x1a\x1e.protos.GetCloudSecretResponse\x12R\n\x0fGetCloudSecrets\x12\x1e.protos.GetCloudSecretsRequest\x1a\x1f.protos.GetCloudSecretsResponse\x12\x61\n\x14GetCloudSecretByName\x12#.protos.GetCloudSecretByNameRequest\x1a$.protos.GetCloudSecretByNameResponse\x12^\n\x13GetCloudSecretNames\x12\".protos.GetCloudSecretNamesRequest\x1a#.protos.GetCloudSecretNamesResponse\x12L\n\rGetCephAccess\x12\x1c.protos.GetCephAccessRequest\x1a\x1d.protos.GetCephAccessResponse\x12@\n\tGetSshKey\x12\x18.protos.GetSshKeyRequest\x1a\x19.protos.GetSshKeyResponse\x12L\n\rGetProxmoxApi\x12\x1c.protos.GetProxmoxApiRequest\x1a\x1d.protos.GetProxmoxApiResponse\x12U\n\x10\x43reateProxmoxApi\x12\x1f.protos.CreateProxmoxApiRequest\x1a .protos.CreateProxmoxApiResponse\x12U\n\x10\x44\x65leteProxmoxApi\x12\x1f.protos.DeleteProxmoxApiRequest\x1a .protos.DeleteProxmoxApiResponse\x12L\n\rSetProxmoxApi\x12\x1c.protos.SetProxmoxApiRequest\x1a\x1d.protos.SetProxmoxApiResponse\x12\x46\n\x0bWaitForTask\x12\x1a.protos.WaitForTaskRequest\x1a\x1b.protos.WaitForTaskResponse\x12O\n\x0eGetProxmoxHost\x12\x1d.protos.GetProxmoxHostRequest\x1a\x1e.protos.GetProxmoxHostResponse\x12R\n\x0fGetPveInventory\x12\x1e.protos.GetPveInventoryRequest\x1a\x1f.protos.GetPveInventoryResponse\x12O\n\x0eGetCloudDomain\x12\x1d.protos.GetCloudDomainRequest\x1a\x1e.protos.GetCloudDomainResponse\x12O\n\x0eGetVmVarsBlake\x12\x1d.protos.GetVmVarsBlakeRequest\x1a\x1e.protos.GetVmVarsBlakeResponse\x12N\n\x11StreamVmVarsBlake\x12\x1d.protos.GetVmVarsBlakeRequest\x1a\x18.protos.VmVarsBlakeEntry0\x01\x12O\n\x0eSetVmVarsBlake\x12\x1d.protos.SetVmVarsBlakeRequest\x1a\x1e.protos.SetVmVarsBlakeResponse\x12X\n\x11\x44\x65leteVmVarsBlake\x12 .protos.DeleteVmVarsBlakeRequest\x1a!.protos.DeleteVmVarsBlakeResponse\x12[\n\x12GetNodeProxyConfig\x12!.protos.GetNodeProxyConfigRequest\x1a\".protos.GetNodeProxyConfigResponse\x12[\n\x12SetNodeProxyConfig\x12!.protos.SetNodeProxyConfigRequest\x1a\".protos.SetNodeProxyConfigResponse\x12\x46\n\x0b\x43\x65phCommand\x12\x1a.protos.CephCommandRequest\x1a\x1b.protos.CephCommandResponse\x12U\n\x10WriteStorageFile\x12\x1f.protos.WriteStorageFileRequest\x1a .protos.WriteStorageFileResponse\x12R\n\x0fHashStorageFile\x12\x1e.protos.HashStorageFileRequest\x1a\x1f.protos.HashStorageFileResponse\x12X\n\x11\x44\x65leteStorageFile\x12 .protos.DeleteStorageFileRequest\x1a!.protos.DeleteStorageFileResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -36,8 +36,18 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_GETPROXMOXAPIREQUEST_GETARGSENTRY']._serialized_options = b'8\001'
   _globals['_CREATEPROXMOXAPIREQUEST_CREATEARGSENTRY']._loaded_options = None
   _globals['_CREATEPROXMOXAPIREQUEST_CREATEARGSENTRY']._serialized_options = b'8\001'
+  _globals['_DELETEPROXMOXAPIREQUEST_DELETEARGSENTRY']._loaded_options = None
+  _globals['_DELETEPROXMOXAPIREQUEST_DELETEARGSENTRY']._serialized_options = b'8\001'
+  _globals['_SETPROXMOXAPIREQUEST_SETARGSENTRY']._loaded_options = None
+  _globals['_SETPROXMOXAPIREQUEST_SETARGSENTRY']._serialized_options = b'8\001'
   _globals['_GETVMVARSBLAKERESPONSE_BLAKEIDVARSENTRY']._loaded_options = None
   _globals['_GETVMVARSBLAKERESPONSE_BLAKEIDVARSENTRY']._serialized_options = b'8\001'
+  _globals['_GETNODEPROXYCONFIGRESPONSE_CONFIGENTRY']._loaded_options = None
+  _globals['_GETNODEPROXYCONFIGRESPONSE_CONFIGENTRY']._serialized_options = b'8\001'
+  _globals['_SETNODEPROXYCONFIGREQUEST_CONFIGENTRY']._loaded_options = None
+  _globals['_SETNODEPROXYCONFIGREQUEST_CONFIGENTRY']._serialized_options = b'8\001'
+  _globals['_ERRORCODE']._serialized_start=5226
+  _globals['_ERRORCODE']._serialized_end=5321
   _globals['_GETPVEINVENTORYREQUEST']._serialized_start=23
   _globals['_GETPVEINVENTORYREQUEST']._serialized_end=67
   _globals['_GETPVEINVENTORYRESPONSE']._serialized_start=69
@@ -57,59 +67,125 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_CREATEPROXMOXAPIREQUEST_CREATEARGSENTRY']._serialized_start=578
   _globals['_CREATEPROXMOXAPIREQUEST_CREATEARGSENTRY']._serialized_end=627
   _globals['_CREATEPROXMOXAPIRESPONSE']._serialized_start=629
-  _globals['_CREATEPROXMOXAPIRESPONSE']._serialized_end=693
-  _globals['_DELETEPROXMOXAPIREQUEST']._serialized_start=695
-  _globals['_DELETEPROXMOXAPIREQUEST']._serialized_end=758
-  _globals['_DELETEPROXMOXAPIRESPONSE']._serialized_start=760
-  _globals['_DELETEPROXMOXAPIRESPONSE']._serialized_end=824
-  _globals['_GETSSHKEYREQUEST']._serialized_start=827
-  _globals['_GETSSHKEYREQUEST']._serialized_end=962
-  _globals['_GETSSHKEYREQUEST_KEYTYPE']._serialized_start=919
-  _globals['_GETSSHKEYREQUEST_KEYTYPE']._serialized_end=962
-  _globals['_GETSSHKEYRESPONSE']._serialized_start=964
-  _globals['_GETSSHKEYRESPONSE']._serialized_end=996
-  _globals['_GETCEPHACCESSREQUEST']._serialized_start=998
-  _globals['_GETCEPHACCESSREQUEST']._serialized_end=1040
-  _globals['_GETCEPHACCESSRESPONSE']._serialized_start=1042
-  _globals['_GETCEPHACCESSRESPONSE']._serialized_end=1107
-  _globals['_GETKUBECONFIGREQUEST']._serialized_start=1109
-  _globals['_GETKUBECONFIGREQUEST']._serialized_end=1171
-  _globals['_GETKUBECONFIGRESPONSE']._serialized_start=1173
-  _globals['_GETKUBECONFIGRESPONSE']._serialized_end=1212
-  _globals['_GETCLUSTERVARSREQUEST']._serialized_start=1214
-  _globals['_GETCLUSTERVARSREQUEST']._serialized_end=1257
-  _globals['_GETCLUSTERVARSRESPONSE']._serialized_start=1259
-  _globals['_GETCLUSTERVARSRESPONSE']._serialized_end=1297
-  _globals['_GETCLOUDFILESECRETREQUEST']._serialized_start=1299
-  _globals['_GETCLOUDFILESECRETREQUEST']._serialized_end=1383
-  _globals['_GETCLOUDFILESECRETRESPONSE']._serialized_start=1385
-  _globals['_GETCLOUDFILESECRETRESPONSE']._serialized_end=1429
-  _globals['_CREATECLOUDSECRETREQUEST']._serialized_start=1432
-  _globals['_CREATECLOUDSECRETREQUEST']._serialized_end=1563
-  _globals['_CREATECLOUDSECRETRESPONSE']._serialized_start=1565
-  _globals['_CREATECLOUDSECRETRESPONSE']._serialized_end=1630
-  _globals['_DELETECLOUDSECRETREQUEST']._serialized_start=1632
-  _globals['_DELETECLOUDSECRETREQUEST']._serialized_end=1721
-  _globals['_DELETECLOUDSECRETRESPONSE']._serialized_start=1723
-  _globals['_DELETECLOUDSECRETRESPONSE']._serialized_end=1788
-  _globals['_GETCLOUDSECRETREQUEST']._serialized_start=1790
-  _globals['_GETCLOUDSECRETREQUEST']._serialized_end=1876
-  _globals['_GETCLOUDSECRETRESPONSE']._serialized_start=1878
-  _globals['_GETCLOUDSECRETRESPONSE']._serialized_end=1918
-  _globals['_GETCLOUDSECRETSREQUEST']._serialized_start=1920
-  _globals['_GETCLOUDSECRETSREQUEST']._serialized_end=2007
-  _globals['_GETCLOUDSECRETSRESPONSE']._serialized_start=2009
-  _globals['_GETCLOUDSECRETSRESPONSE']._serialized_end=2051
-  _globals['_GETVMVARSBLAKEREQUEST']._serialized_start=2053
-  _globals['_GETVMVARSBLAKEREQUEST']._serialized_end=2137
-  _globals['_GETVMVARSBLAKERESPONSE']._serialized_start=2140
-  _globals['_GETVMVARSBLAKERESPONSE']._serialized_end=2288
-  _globals['_GETVMVARSBLAKERESPONSE_BLAKEIDVARSENTRY']._serialized_start=2238
-  _globals['_GETVMVARSBLAKERESPONSE_BLAKEIDVARSENTRY']._serialized_end=2288
-  _globals['_GETCLOUDDOMAINREQUEST']._serialized_start=2290
-  _globals['_GETCLOUDDOMAINREQUEST']._serialized_end=2333
-  _globals['_GETCLOUDDOMAINRESPONSE']._serialized_start=2335
-  _globals['_GETCLOUDDOMAINRESPONSE']._serialized_end=2375
-  _globals['_CLOUDSERVICE']._serialized_start=2378
-  _globals['_CLOUDSERVICE']._serialized_end=3718
+  _globals['_CREATEPROXMOXAPIRESPONSE']._serialized_end=709
+  _globals['_DELETEPROXMOXAPIREQUEST']._serialized_start=712
+  _globals['_DELETEPROXMOXAPIREQUEST']._serialized_end=896
+  _globals['_DELETEPROXMOXAPIREQUEST_DELETEARGSENTRY']._serialized_start=847
+  _globals['_DELETEPROXMOXAPIREQUEST_DELETEARGSENTRY']._serialized_end=896
+  _globals['_DELETEPROXMOXAPIRESPONSE']._serialized_start=898
+  _globals['_DELETEPROXMOXAPIRESPONSE']._serialized_end=978
+  _globals['_SETPROXMOXAPIREQUEST']._serialized_start=981
+  _globals['_SETPROXMOXAPIREQUEST']._serialized_end=1150
+  _globals['_SETPROXMOXAPIREQUEST_SETARGSENTRY']._serialized_start=1104
+  _globals['_SETPROXMOXAPIREQUEST_SETARGSENTRY']._serialized_end=1150
+  _globals['_SETPROXMOXAPIRESPONSE']._serialized_start=1152
+  _globals['_SETPROXMOXAPIRESPONSE']._serialized_end=1229
+  _globals['_WAITFORTASKREQUEST']._serialized_start=1231
+  _globals['_WAITFORTASKREQUEST']._serialized_end=1329
+  _globals['_WAITFORTASKRESPONSE']._serialized_start=1331
+  _globals['_WAITFORTASKRESPONSE']._serialized_end=1409
+  _globals['_GETSSHKEYREQUEST']._serialized_start=1412
+  _globals['_GETSSHKEYREQUEST']._serialized_end=1547
+  _globals['_GETSSHKEYREQUEST_KEYTYPE']._serialized_start=1504
+  _globals['_GETSSHKEYREQUEST_KEYTYPE']._serialized_end=1547
+  _globals['_GETSSHKEYRESPONSE']._serialized_start=1549
+  _globals['_GETSSHKEYRESPONSE']._serialized_end=1581
+  _globals['_GETCEPHACCESSREQUEST']._serialized_start=1583
+  _globals['_GETCEPHACCESSREQUEST']._serialized_end=1625
+  _globals['_GETCEPHACCESSRESPONSE']._serialized_start=1627
+  _globals['_GETCEPHACCESSRESPONSE']._serialized_end=1692
+  _globals['_GETKUBECONFIGREQUEST']._serialized_start=1694
+  _globals['_GETKUBECONFIGREQUEST']._serialized_end=1756
+  _globals['_GETKUBECONFIGRESPONSE']._serialized_start=1758
+  _globals['_GETKUBECONFIGRESPONSE']._serialized_end=1797
+  _globals['_GETCLUSTERVARSREQUEST']._serialized_start=1799
+  _globals['_GETCLUSTERVARSREQUEST']._serialized_end=1842
+  _globals['_GETCLUSTERVARSRESPONSE']._serialized_start=1844
+  _globals['_GETCLUSTERVARSRESPONSE']._serialized_end=1882
+  _globals['_GETCLOUDFILESECRETREQUEST']._serialized_start=1884
+  _globals['_GETCLOUDFILESECRETREQUEST']._serialized_end=1968
+  _globals['_GETCLOUDFILESECRETRESPONSE']._serialized_start=1970
+  _globals['_GETCLOUDFILESECRETRESPONSE']._serialized_end=2027
+  _globals['_CREATECLOUDSECRETREQUEST']._serialized_start=2030
+  _globals['_CREATECLOUDSECRETREQUEST']._serialized_end=2200
+  _globals['_CREATECLOUDSECRETRESPONSE']._serialized_start=2202
+  _globals['_CREATECLOUDSECRETRESPONSE']._serialized_end=2267
+  _globals['_DELETECLOUDSECRETREQUEST']._serialized_start=2269
+  _globals['_DELETECLOUDSECRETREQUEST']._serialized_end=2377
+  _globals['_DELETECLOUDSECRETRESPONSE']._serialized_start=2379
+  _globals['_DELETECLOUDSECRETRESPONSE']._serialized_end=2444
+  _globals['_UPDATECLOUDSECRETREQUEST']._serialized_start=2447
+  _globals['_UPDATECLOUDSECRETREQUEST']._serialized_end=2617
+  _globals['_UPDATECLOUDSECRETRESPONSE']._serialized_start=2619
+  _globals['_UPDATECLOUDSECRETRESPONSE']._serialized_end=2684
+  _globals['_GETCLOUDSECRETREQUEST']._serialized_start=2686
+  _globals['_GETCLOUDSECRETREQUEST']._serialized_end=2791
+  _globals['_GETCLOUDSECRETRESPONSE']._serialized_start=2793
+  _globals['_GETCLOUDSECRETRESPONSE']._serialized_end=2853
+  _globals['_GETCLOUDSECRETSREQUEST']._serialized_start=2855
+  _globals['_GETCLOUDSECRETSREQUEST']._serialized_end=2961
+  _globals['_GETCLOUDSECRETSRESPONSE']._serialized_start=2963
+  _globals['_GETCLOUDSECRETSRESPONSE']._serialized_end=3005
+  _globals['_GETCLOUDSECRETBYNAMEREQUEST']._serialized_start=3007
+  _globals['_GETCLOUDSECRETBYNAMEREQUEST']._serialized_end=3118
+  _globals['_GETCLOUDSECRETBYNAMERESPONSE']._serialized_start=3120
+  _globals['_GETCLOUDSECRETBYNAMERESPONSE']._serialized_end=3227
+  _globals['_GETCLOUDSECRETNAMESREQUEST']._serialized_start=3229
+  _globals['_GETCLOUDSECRETNAMESREQUEST']._serialized_end=3339
+  _globals['_CLOUDSECRETMETA']._serialized_start=3341
+  _globals['_CLOUDSECRETMETA']._serialized_end=3460
+  _globals['_GETCLOUDSECRETNAMESRESPONSE']._serialized_start=3462
+  _globals['_GETCLOUDSECRETNAMESRESPONSE']._serialized_end=3533
+  _globals['_GETVMVARSBLAKEREQUEST']._serialized_start=3535
+  _globals['_GETVMVARSBLAKEREQUEST']._serialized_end=3619
+  _globals['_GETVMVARSBLAKERESPONSE']._serialized_start=3622
+  _globals['_GETVMVARSBLAKERESPONSE']._serialized_end=3770
+  _globals['_GETVMVARSBLAKERESPONSE_BLAKEIDVARSENTRY']._serialized_start=3720
+  _globals['_GETVMVARSBLAKERESPONSE_BLAKEIDVARSENTRY']._serialized_end=3770
+  _globals['_VMVARSBLAKEENTRY']._serialized_start=3772
+  _globals['_VMVARSBLAKEENTRY']._serialized_end=3822
+  _globals['_SETVMVARSBLAKEREQUEST']._serialized_start=3824
+  _globals['_SETVMVARSBLAKEREQUEST']._serialized_end=3921
+  _globals['_SETVMVARSBLAKERESPONSE']._serialized_start=3923
+  _globals['_SETVMVARSBLAKERESPONSE']._serialized_end=3985
+  _globals['_DELETEVMVARSBLAKEREQUEST']._serialized_start=3987
+  _globals['_DELETEVMVARSBLAKEREQUEST']._serialized_end=4073
+  _globals['_DELETEVMVARSBLAKERESPONSE']._serialized_start=4075
+  _globals['_DELETEVMVARSBLAKERESPONSE']._serialized_end=4140
+  _globals['_GETCLOUDDOMAINREQUEST']._serialized_start=4142
+  _globals['_GETCLOUDDOMAINREQUEST']._serialized_end=4185
+  _globals['_GETCLOUDDOMAINRESPONSE']._serialized_start=4187
+  _globals['_GETCLOUDDOMAINRESPONSE']._serialized_end=4227
+  _globals['_GETNODEPROXYCONFIGREQUEST']._serialized_start=4229
+  _globals['_GETNODEPROXYCONFIGREQUEST']._serialized_end=4290
+  _globals['_GETNODEPROXYCONFIGRESPONSE']._serialized_start=4293
+  _globals['_GETNODEPROXYCONFIGRESPONSE']._serialized_end=4432
+  _globals['_GETNODEPROXYCONFIGRESPONSE_CONFIGENTRY']._serialized_start=4387
+  _globals['_GETNODEPROXYCONFIGRESPONSE_CONFIGENTRY']._serialized_end=4432
+  _globals['_SETNODEPROXYCONFIGREQUEST']._serialized_start=4435
+  _globals['_SETNODEPROXYCONFIGREQUEST']._serialized_end=4606
+  _globals['_SETNODEPROXYCONFIGREQUEST_CONFIGENTRY']._serialized_start=4387
+  _globals['_SETNODEPROXYCONFIGREQUEST_CONFIGENTRY']._serialized_end=4432
+  _globals['_SETNODEPROXYCONFIGRESPONSE']._serialized_start=4608
+  _globals['_SETNODEPROXYCONFIGRESPONSE']._serialized_end=4674
+  _globals['_CEPHCOMMANDREQUEST']._serialized_start=4677
+  _globals['_CEPHCOMMANDREQUEST']._serialized_end=4805
+  _globals['_CEPHCOMMANDREQUEST_TOOL']._serialized_start=4780
+  _globals['_CEPHCOMMANDREQUEST_TOOL']._serialized_end=4805
+  _globals['_CEPHCOMMANDRESPONSE']._serialized_start=4807
+  _globals['_CEPHCOMMANDRESPONSE']._serialized_end=4847
+  _globals['_WRITESTORAGEFILEREQUEST']._serialized_start=4849
+  _globals['_WRITESTORAGEFILEREQUEST']._serialized_end=4964
+  _globals['_WRITESTORAGEFILERESPONSE']._serialized_start=4966
+  _globals['_WRITESTORAGEFILERESPONSE']._serialized_end=4992
+  _globals['_HASHSTORAGEFILEREQUEST']._serialized_start=4994
+  _globals['_HASHSTORAGEFILEREQUEST']._serialized_end=5071
+  _globals['_HASHSTORAGEFILERESPONSE']._serialized_start=5073
+  _globals['_HASHSTORAGEFILERESPONSE']._serialized_end=5114
+  _globals['_DELETESTORAGEFILEREQUEST']._serialized_start=5116
+  _globals['_DELETESTORAGEFILEREQUEST']._serialized_end=5195
+  _globals['_DELETESTORAGEFILERESPONSE']._serialized_start=5197
+  _globals['_DELETESTORAGEFILERESPONSE']._serialized_end=5224
+  _globals['_CLOUDSERVICE']._serialized_start=5324
+  _globals['_CLOUDSERVICE']._serialized_end=7869
 # @@protoc_insertion_point(module_scope)
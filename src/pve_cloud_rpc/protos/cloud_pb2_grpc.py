@@ -59,6 +59,11 @@ class CloudServiceStub(object):
                 request_serializer=cloud__pb2.DeleteCloudSecretRequest.SerializeToString,
                 response_deserializer=cloud__pb2.DeleteCloudSecretResponse.FromString,
                 _registered_method=True)
+        self.UpdateCloudSecret = channel.unary_unary(
+                '/protos.CloudService/UpdateCloudSecret',
+                request_serializer=cloud__pb2.UpdateCloudSecretRequest.SerializeToString,
+                response_deserializer=cloud__pb2.UpdateCloudSecretResponse.FromString,
+                _registered_method=True)
         self.GetCloudSecret = channel.unary_unary(
                 '/protos.CloudService/GetCloudSecret',
                 request_serializer=cloud__pb2.GetCloudSecretRequest.SerializeToString,
@@ -69,6 +74,16 @@ class CloudServiceStub(object):
                 request_serializer=cloud__pb2.GetCloudSecretsRequest.SerializeToString,
                 response_deserializer=cloud__pb2.GetCloudSecretsResponse.FromString,
                 _registered_method=True)
+        self.GetCloudSecretByName = channel.unary_unary(
+                '/protos.CloudService/GetCloudSecretByName',
+                request_serializer=cloud__pb2.GetCloudSecretByNameRequest.SerializeToString,
+                response_deserializer=cloud__pb2.GetCloudSecretByNameResponse.FromString,
+                _registered_method=True)
+        self.GetCloudSecretNames = channel.unary_unary(
+                '/protos.CloudService/GetCloudSecretNames',
+                request_serializer=cloud__pb2.GetCloudSecretNamesRequest.SerializeToString,
+                response_deserializer=cloud__pb2.GetCloudSecretNamesResponse.FromString,
+                _registered_method=True)
         self.GetCephAccess = channel.unary_unary(
                 '/protos.CloudService/GetCephAccess',
                 request_serializer=cloud__pb2.GetCephAccessRequest.SerializeToString,
@@ -94,6 +109,16 @@ class CloudServiceStub(object):
                 request_serializer=cloud__pb2.DeleteProxmoxApiRequest.SerializeToString,
                 response_deserializer=cloud__pb2.DeleteProxmoxApiResponse.FromString,
                 _registered_method=True)
+        self.SetProxmoxApi = channel.unary_unary(
+                '/protos.CloudService/SetProxmoxApi',
+                request_serializer=cloud__pb2.SetProxmoxApiRequest.SerializeToString,
+                response_deserializer=cloud__pb2.SetProxmoxApiResponse.FromString,
+                _registered_method=True)
+        self.WaitForTask = channel.unary_unary(
+                '/protos.CloudService/WaitForTask',
+                request_serializer=cloud__pb2.WaitForTaskRequest.SerializeToString,
+                response_deserializer=cloud__pb2.WaitForTaskResponse.FromString,
+                _registered_method=True)
         self.GetProxmoxHost = channel.unary_unary(
                 '/protos.CloudService/GetProxmoxHost',
                 request_serializer=cloud__pb2.GetProxmoxHostRequest.SerializeToString,
@@ -114,6 +139,51 @@ class CloudServiceStub(object):
                 request_serializer=cloud__pb2.GetVmVarsBlakeRequest.SerializeToString,
                 response_deserializer=cloud__pb2.GetVmVarsBlakeResponse.FromString,
                 _registered_method=True)
+        self.StreamVmVarsBlake = channel.unary_stream(
+                '/protos.CloudService/StreamVmVarsBlake',
+                request_serializer=cloud__pb2.GetVmVarsBlakeRequest.SerializeToString,
+                response_deserializer=cloud__pb2.VmVarsBlakeEntry.FromString,
+                _registered_method=True)
+        self.SetVmVarsBlake = channel.unary_unary(
+                '/protos.CloudService/SetVmVarsBlake',
+                request_serializer=cloud__pb2.SetVmVarsBlakeRequest.SerializeToString,
+                response_deserializer=cloud__pb2.SetVmVarsBlakeResponse.FromString,
+                _registered_method=True)
+        self.DeleteVmVarsBlake = channel.unary_unary(
+                '/protos.CloudService/DeleteVmVarsBlake',
+                request_serializer=cloud__pb2.DeleteVmVarsBlakeRequest.SerializeToString,
+                response_deserializer=cloud__pb2.DeleteVmVarsBlakeResponse.FromString,
+                _registered_method=True)
+        self.GetNodeProxyConfig = channel.unary_unary(
+                '/protos.CloudService/GetNodeProxyConfig',
+                request_serializer=cloud__pb2.GetNodeProxyConfigRequest.SerializeToString,
+                response_deserializer=cloud__pb2.GetNodeProxyConfigResponse.FromString,
+                _registered_method=True)
+        self.SetNodeProxyConfig = channel.unary_unary(
+                '/protos.CloudService/SetNodeProxyConfig',
+                request_serializer=cloud__pb2.SetNodeProxyConfigRequest.SerializeToString,
+                response_deserializer=cloud__pb2.SetNodeProxyConfigResponse.FromString,
+                _registered_method=True)
+        self.CephCommand = channel.unary_unary(
+                '/protos.CloudService/CephCommand',
+                request_serializer=cloud__pb2.CephCommandRequest.SerializeToString,
+                response_deserializer=cloud__pb2.CephCommandResponse.FromString,
+                _registered_method=True)
+        self.WriteStorageFile = channel.unary_unary(
+                '/protos.CloudService/WriteStorageFile',
+                request_serializer=cloud__pb2.WriteStorageFileRequest.SerializeToString,
+                response_deserializer=cloud__pb2.WriteStorageFileResponse.FromString,
+                _registered_method=True)
+        self.HashStorageFile = channel.unary_unary(
+                '/protos.CloudService/HashStorageFile',
+                request_serializer=cloud__pb2.HashStorageFileRequest.SerializeToString,
+                response_deserializer=cloud__pb2.HashStorageFileResponse.FromString,
+                _registered_method=True)
+        self.DeleteStorageFile = channel.unary_unary(
+                '/protos.CloudService/DeleteStorageFile',
+                request_serializer=cloud__pb2.DeleteStorageFileRequest.SerializeToString,
+                response_deserializer=cloud__pb2.DeleteStorageFileResponse.FromString,
+                _registered_method=True)
 
 
 class CloudServiceServicer(object):
@@ -149,6 +219,12 @@ class CloudServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def UpdateCloudSecret(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetCloudSecret(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -161,6 +237,18 @@ class CloudServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetCloudSecretByName(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetCloudSecretNames(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetCephAccess(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -191,6 +279,18 @@ class CloudServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def SetProxmoxApi(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WaitForTask(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetProxmoxHost(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -215,6 +315,60 @@ class CloudServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def StreamVmVarsBlake(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetVmVarsBlake(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteVmVarsBlake(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetNodeProxyConfig(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetNodeProxyConfig(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CephCommand(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WriteStorageFile(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def HashStorageFile(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteStorageFile(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_CloudServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -243,6 +397,11 @@ def add_CloudServiceServicer_to_server(servicer, server):
                     request_deserializer=cloud__pb2.DeleteCloudSecretRequest.FromString,
                     response_serializer=cloud__pb2.DeleteCloudSecretResponse.SerializeToString,
             ),
+            'UpdateCloudSecret': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateCloudSecret,
+                    request_deserializer=cloud__pb2.UpdateCloudSecretRequest.FromString,
+                    response_serializer=cloud__pb2.UpdateCloudSecretResponse.SerializeToString,
+            ),
             'GetCloudSecret': grpc.unary_unary_rpc_method_handler(
                     servicer.GetCloudSecret,
                     request_deserializer=cloud__pb2.GetCloudSecretRequest.FromString,
@@ -253,6 +412,16 @@ def add_CloudServiceServicer_to_server(servicer, server):
                     request_deserializer=cloud__pb2.GetCloudSecretsRequest.FromString,
                     response_serializer=cloud__pb2.GetCloudSecretsResponse.SerializeToString,
             ),
+            'GetCloudSecretByName': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCloudSecretByName,
+                    request_deserializer=cloud__pb2.GetCloudSecretByNameRequest.FromString,
+                    response_serializer=cloud__pb2.GetCloudSecretByNameResponse.SerializeToString,
+            ),
+            'GetCloudSecretNames': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCloudSecretNames,
+                    request_deserializer=cloud__pb2.GetCloudSecretNamesRequest.FromString,
+                    response_serializer=cloud__pb2.GetCloudSecretNamesResponse.SerializeToString,
+            ),
             'GetCephAccess': grpc.unary_unary_rpc_method_handler(
                     servicer.GetCephAccess,
                     request_deserializer=cloud__pb2.GetCephAccessRequest.FromString,
@@ -278,6 +447,16 @@ def add_CloudServiceServicer_to_server(servicer, server):
                     request_deserializer=cloud__pb2.DeleteProxmoxApiRequest.FromString,
                     response_serializer=cloud__pb2.DeleteProxmoxApiResponse.SerializeToString,
             ),
+            'SetProxmoxApi': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetProxmoxApi,
+                    request_deserializer=cloud__pb2.SetProxmoxApiRequest.FromString,
+                    response_serializer=cloud__pb2.SetProxmoxApiResponse.SerializeToString,
+            ),
+            'WaitForTask': grpc.unary_unary_rpc_method_handler(
+                    servicer.WaitForTask,
+                    request_deserializer=cloud__pb2.WaitForTaskRequest.FromString,
+                    response_serializer=cloud__pb2.WaitForTaskResponse.SerializeToString,
+            ),
             'GetProxmoxHost': grpc.unary_unary_rpc_method_handler(
                     servicer.GetProxmoxHost,
                     request_deserializer=cloud__pb2.GetProxmoxHostRequest.FromString,
@@ -298,6 +477,51 @@ def add_CloudServiceServicer_to_server(servicer, server):
                     request_deserializer=cloud__pb2.GetVmVarsBlakeRequest.FromString,
                     response_serializer=cloud__pb2.GetVmVarsBlakeResponse.SerializeToString,
             ),
+            'StreamVmVarsBlake': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamVmVarsBlake,
+                    request_deserializer=cloud__pb2.GetVmVarsBlakeRequest.FromString,
+                    response_serializer=cloud__pb2.VmVarsBlakeEntry.SerializeToString,
+            ),
+            'SetVmVarsBlake': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetVmVarsBlake,
+                    request_deserializer=cloud__pb2.SetVmVarsBlakeRequest.FromString,
+                    response_serializer=cloud__pb2.SetVmVarsBlakeResponse.SerializeToString,
+            ),
+            'DeleteVmVarsBlake': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteVmVarsBlake,
+                    request_deserializer=cloud__pb2.DeleteVmVarsBlakeRequest.FromString,
+                    response_serializer=cloud__pb2.DeleteVmVarsBlakeResponse.SerializeToString,
+            ),
+            'GetNodeProxyConfig': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetNodeProxyConfig,
+                    request_deserializer=cloud__pb2.GetNodeProxyConfigRequest.FromString,
+                    response_serializer=cloud__pb2.GetNodeProxyConfigResponse.SerializeToString,
+            ),
+            'SetNodeProxyConfig': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetNodeProxyConfig,
+                    request_deserializer=cloud__pb2.SetNodeProxyConfigRequest.FromString,
+                    response_serializer=cloud__pb2.SetNodeProxyConfigResponse.SerializeToString,
+            ),
+            'CephCommand': grpc.unary_unary_rpc_method_handler(
+                    servicer.CephCommand,
+                    request_deserializer=cloud__pb2.CephCommandRequest.FromString,
+                    response_serializer=cloud__pb2.CephCommandResponse.SerializeToString,
+            ),
+            'WriteStorageFile': grpc.unary_unary_rpc_method_handler(
+                    servicer.WriteStorageFile,
+                    request_deserializer=cloud__pb2.WriteStorageFileRequest.FromString,
+                    response_serializer=cloud__pb2.WriteStorageFileResponse.SerializeToString,
+            ),
+            'HashStorageFile': grpc.unary_unary_rpc_method_handler(
+                    servicer.HashStorageFile,
+                    request_deserializer=cloud__pb2.HashStorageFileRequest.FromString,
+                    response_serializer=cloud__pb2.HashStorageFileResponse.SerializeToString,
+            ),
+            'DeleteStorageFile': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteStorageFile,
+                    request_deserializer=cloud__pb2.DeleteStorageFileRequest.FromString,
+                    response_serializer=cloud__pb2.DeleteStorageFileResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'protos.CloudService', rpc_method_handlers)
@@ -444,6 +668,33 @@ class CloudService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def UpdateCloudSecret(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/UpdateCloudSecret',
+            cloud__pb2.UpdateCloudSecretRequest.SerializeToString,
+            cloud__pb2.UpdateCloudSecretResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def GetCloudSecret(request,
             target,
@@ -498,6 +749,60 @@ class CloudService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def GetCloudSecretByName(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/GetCloudSecretByName',
+            cloud__pb2.GetCloudSecretByNameRequest.SerializeToString,
+            cloud__pb2.GetCloudSecretByNameResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetCloudSecretNames(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/GetCloudSecretNames',
+            cloud__pb2.GetCloudSecretNamesRequest.SerializeToString,
+            cloud__pb2.GetCloudSecretNamesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def GetCephAccess(request,
             target,
@@ -633,6 +938,60 @@ class CloudService(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def SetProxmoxApi(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/SetProxmoxApi',
+            cloud__pb2.SetProxmoxApiRequest.SerializeToString,
+            cloud__pb2.SetProxmoxApiResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def WaitForTask(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/WaitForTask',
+            cloud__pb2.WaitForTaskRequest.SerializeToString,
+            cloud__pb2.WaitForTaskResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def GetProxmoxHost(request,
             target,
@@ -740,3 +1099,246 @@ class CloudService(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def StreamVmVarsBlake(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/protos.CloudService/StreamVmVarsBlake',
+            cloud__pb2.GetVmVarsBlakeRequest.SerializeToString,
+            cloud__pb2.VmVarsBlakeEntry.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SetVmVarsBlake(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/SetVmVarsBlake',
+            cloud__pb2.SetVmVarsBlakeRequest.SerializeToString,
+            cloud__pb2.SetVmVarsBlakeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteVmVarsBlake(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/DeleteVmVarsBlake',
+            cloud__pb2.DeleteVmVarsBlakeRequest.SerializeToString,
+            cloud__pb2.DeleteVmVarsBlakeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def GetNodeProxyConfig(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/GetNodeProxyConfig',
+            cloud__pb2.GetNodeProxyConfigRequest.SerializeToString,
+            cloud__pb2.GetNodeProxyConfigResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SetNodeProxyConfig(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/SetNodeProxyConfig',
+            cloud__pb2.SetNodeProxyConfigRequest.SerializeToString,
+            cloud__pb2.SetNodeProxyConfigResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CephCommand(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/CephCommand',
+            cloud__pb2.CephCommandRequest.SerializeToString,
+            cloud__pb2.CephCommandResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def WriteStorageFile(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/WriteStorageFile',
+            cloud__pb2.WriteStorageFileRequest.SerializeToString,
+            cloud__pb2.WriteStorageFileResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def HashStorageFile(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/HashStorageFile',
+            cloud__pb2.HashStorageFileRequest.SerializeToString,
+            cloud__pb2.HashStorageFileResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteStorageFile(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.CloudService/DeleteStorageFile',
+            cloud__pb2.DeleteStorageFileRequest.SerializeToString,
+            cloud__pb2.DeleteStorageFileResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
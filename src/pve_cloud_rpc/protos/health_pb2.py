@@ -24,7 +24,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0chealth.proto\x12\x06protos\"(\n\x12HealthCheckRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"\x94\x01\n\x13HealthCheckResponse\x12\x39\n\x06status\x18\x01 \x01(\x0e\x32).protos.HealthCheckResponse.ServingStatus\x12\x15\n\rerror_message\x18\x02 \x01(\t\"+\n\rServingStatus\x12\x0b\n\x07SERVING\x10\x00\x12\r\n\tMISSMATCH\x10\x01\x32J\n\x06Health\x12@\n\x05\x43heck\x12\x1a.protos.HealthCheckRequest\x1a\x1b.protos.HealthCheckResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0chealth.proto\x12\x06protos\"(\n\x12HealthCheckRequest\x12\x12\n\ntarget_pve\x18\x01 \x01(\t\"\x94\x01\n\x13HealthCheckResponse\x12\x39\n\x06status\x18\x01 \x01(\x0e\x32).protos.HealthCheckResponse.ServingStatus\x12\x15\n\rerror_message\x18\x02 \x01(\t\"+\n\rServingStatus\x12\x0b\n\x07SERVING\x10\x00\x12\r\n\tMISSMATCH\x10\x01\"\x10\n\x0eVersionRequest\"\"\n\x0fVersionResponse\x12\x0f\n\x07version\x18\x01 \x01(\t2\x86\x01\n\x06Health\x12@\n\x05\x43heck\x12\x1a.protos.HealthCheckRequest\x1a\x1b.protos.HealthCheckResponse\x12:\n\x07Version\x12\x16.protos.VersionRequest\x1a\x17.protos.VersionResponseBQZOgithub.com/Proxmox-Cloud/terraform-provider-pxc/internal/provider/protos;protosb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -38,6 +38,10 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_HEALTHCHECKRESPONSE']._serialized_end=215
   _globals['_HEALTHCHECKRESPONSE_SERVINGSTATUS']._serialized_start=172
   _globals['_HEALTHCHECKRESPONSE_SERVINGSTATUS']._serialized_end=215
-  _globals['_HEALTH']._serialized_start=217
-  _globals['_HEALTH']._serialized_end=291
+  _globals['_VERSIONREQUEST']._serialized_start=217
+  _globals['_VERSIONREQUEST']._serialized_end=233
+  _globals['_VERSIONRESPONSE']._serialized_start=235
+  _globals['_VERSIONRESPONSE']._serialized_end=269
+  _globals['_HEALTH']._serialized_start=272
+  _globals['_HEALTH']._serialized_end=406
 # @@protoc_insertion_point(module_scope)
@@ -39,6 +39,11 @@ class HealthStub(object):
                 request_serializer=health__pb2.HealthCheckRequest.SerializeToString,
                 response_deserializer=health__pb2.HealthCheckResponse.FromString,
                 _registered_method=True)
+        self.Version = channel.unary_unary(
+                '/protos.Health/Version',
+                request_serializer=health__pb2.VersionRequest.SerializeToString,
+                response_deserializer=health__pb2.VersionResponse.FromString,
+                _registered_method=True)
 
 
 class HealthServicer(object):
@@ -50,6 +55,12 @@ class HealthServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Version(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_HealthServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -58,6 +69,11 @@ def add_HealthServicer_to_server(servicer, server):
                     request_deserializer=health__pb2.HealthCheckRequest.FromString,
                     response_serializer=health__pb2.HealthCheckResponse.SerializeToString,
             ),
+            'Version': grpc.unary_unary_rpc_method_handler(
+                    servicer.Version,
+                    request_deserializer=health__pb2.VersionRequest.FromString,
+                    response_serializer=health__pb2.VersionResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'protos.Health', rpc_method_handlers)
@@ -95,3 +111,30 @@ class Health(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def Version(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/protos.Health/Version',
+            health__pb2.VersionRequest.SerializeToString,
+            health__pb2.VersionResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
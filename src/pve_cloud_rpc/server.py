@@ -1,7 +1,12 @@
 import asyncio
+import hmac
 import json
+import re
+import shlex
 import socket
 import sys
+from datetime import datetime, timezone
+from importlib.metadata import PackageNotFoundError, version
 
 import asyncssh
 import grpc
@@ -9,8 +14,8 @@ import yaml
 from pve_cloud.cli.pvclu import get_cluster_vars, get_ssh_master_kubeconfig
 from pve_cloud.lib.inventory import *
 from pve_cloud.orm.alchemy import ProxmoxCloudSecrets, VirtualMachineVars
-from sqlalchemy import create_engine, delete, select
-from sqlalchemy.exc import IntegrityError
+from sqlalchemy import create_engine, delete, func, or_, select, update
+from sqlalchemy.exc import IntegrityError, OperationalError
 from sqlalchemy.orm import Session
 
 import pve_cloud_rpc.protos.cloud_pb2 as cloud_pb2
@@ -38,6 +43,15 @@ class HealthServicer(health_pb2_grpc.HealthServicer):
                 error_message=f"py-pve-cloud version check failed with: {e}",
             )  # go provider process will kill
 
+    # lets the provider refuse to run against a backend of another release
+    async def Version(self, request, context):
+        try:
+            installed = version("rpyc-pve-cloud")
+        except PackageNotFoundError:
+            installed = "dev"  # running from a source checkout
+
+        return health_pb2.VersionResponse(version=installed)
+
 
 async def get_engine(online_pve_host):
     async with asyncssh.connect(
@@ -90,22 +104,28 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         async with asyncssh.connect(
             online_pve_host, username="root", known_hosts=None
         ) as conn:
+            # read as bytes, decoding would corrupt binary secrets (keytabs, pkcs12)
             cmd = await conn.run(
-                f"cat /etc/pve/cloud/secrets/{secret_name}", check=True
+                f"cat /etc/pve/cloud/secrets/{secret_name}", check=True, encoding=None
             )
-            catted_secret = cmd.stdout
+            raw_secret = cmd.stdout
+            catted_secret = raw_secret.decode("utf-8", errors="replace")
 
             if (
                 request.rstrip
             ):  # defaults to true but in special cases user might want to keep newlines (e.g. certs)
                 catted_secret = catted_secret.rstrip()
 
-        return cloud_pb2.GetCloudFileSecretResponse(secret=catted_secret)
+        return cloud_pb2.GetCloudFileSecretResponse(
+            secret=catted_secret, raw=raw_secret
+        )
 
     # non file proxmox cloud secrets are stored in the patroni database
     async def CreateCloudSecret(self, request, context):
         target_pve = request.target_pve
         cloud_domain = request.cloud_domain
+        namespace = request.namespace
+        expires_at = parse_rfc3339(request.expires_at)
         secret_name = request.secret_name
         secret_data = json.loads(request.secret_data)
         secret_type = request.secret_type
@@ -114,10 +134,14 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         engine = await get_engine(online_pve_host)
 
         with Session(engine) as session:
+            purge_expired_secrets(session, cloud_domain)
+
             try:
                 session.add(
                     ProxmoxCloudSecrets(
                         cloud_domain=cloud_domain,
+                        namespace=namespace,
+                        expires_at=expires_at,
                         secret_name=secret_name,
                         secret_data=secret_data,
                         secret_type=secret_type,
@@ -137,13 +161,17 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         target_pve = request.target_pve
         secret_name = request.secret_name
         cloud_domain = request.cloud_domain
+        namespace = request.namespace
 
         online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
         engine = await get_engine(online_pve_host)
 
         with Session(engine) as session:
+            purge_expired_secrets(session, cloud_domain)
+
             stmt = delete(ProxmoxCloudSecrets).where(
                 ProxmoxCloudSecrets.cloud_domain == cloud_domain,
+                secret_namespace(namespace),
                 ProxmoxCloudSecrets.secret_name == secret_name,
             )
 
@@ -152,10 +180,52 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
 
         return cloud_pb2.DeleteCloudSecretResponse(success=True)
 
+    # single statement so data and type never change independently
+    async def UpdateCloudSecret(self, request, context):
+        target_pve = request.target_pve
+        cloud_domain = request.cloud_domain
+        namespace = request.namespace
+        expires_at = parse_rfc3339(request.expires_at)
+        secret_name = request.secret_name
+        secret_data = json.loads(request.secret_data)
+        secret_type = request.secret_type
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        engine = await get_engine(online_pve_host)
+
+        with Session(engine) as session:
+            purge_expired_secrets(session, cloud_domain)
+
+            stmt = (
+                update(ProxmoxCloudSecrets)
+                .where(
+                    ProxmoxCloudSecrets.cloud_domain == cloud_domain,
+                    secret_namespace(namespace),
+                    ProxmoxCloudSecrets.secret_name == secret_name,
+                )
+                .values(
+                    secret_data=secret_data,
+                    secret_type=secret_type,
+                    expires_at=expires_at,
+                    updated_at=func.now(),
+                )
+            )
+
+            result = session.execute(stmt)
+            session.commit()
+
+        if result.rowcount == 0:
+            return cloud_pb2.UpdateCloudSecretResponse(
+                success=False, err_message=f"secret {secret_name} does not exist"
+            )
+
+        return cloud_pb2.UpdateCloudSecretResponse(success=True)
+
     async def GetCloudSecret(self, request, context):
         target_pve = request.target_pve
         secret_name = request.secret_name
         cloud_domain = request.cloud_domain
+        namespace = request.namespace
 
         online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
         engine = await get_engine(online_pve_host)
@@ -163,6 +233,8 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         with Session(engine) as session:
             stmt = select(ProxmoxCloudSecrets).where(
                 ProxmoxCloudSecrets.cloud_domain == cloud_domain,
+                secret_namespace(namespace),
+                secret_not_expired(),
                 ProxmoxCloudSecrets.secret_name == secret_name,
             )
             record = session.scalars(stmt).first()
@@ -170,13 +242,46 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         if not record:
             return cloud_pb2.GetCloudSecretResponse()
 
-        return cloud_pb2.GetCloudSecretResponse(secret=json.dumps(record.secret_data))
+        return cloud_pb2.GetCloudSecretResponse(
+            secret=json.dumps(record.secret_data),
+            expires_at=rfc3339(record.expires_at),
+        )
+
+    # lets resources tell a missing secret apart from an empty one
+    async def GetCloudSecretByName(self, request, context):
+        target_pve = request.target_pve
+        secret_name = request.secret_name
+        cloud_domain = request.cloud_domain
+        namespace = request.namespace
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        engine = await get_engine(online_pve_host)
+
+        with Session(engine) as session:
+            stmt = select(ProxmoxCloudSecrets).where(
+                ProxmoxCloudSecrets.cloud_domain == cloud_domain,
+                secret_namespace(namespace),
+                secret_not_expired(),
+                ProxmoxCloudSecrets.secret_name == secret_name,
+            )
+            record = session.scalars(stmt).first()
+
+        if not record:
+            return cloud_pb2.GetCloudSecretByNameResponse(found=False)
+
+        return cloud_pb2.GetCloudSecretByNameResponse(
+            found=True,
+            secret_data=json.dumps(record.secret_data),
+            secret_type=record.secret_type or "",
+            expires_at=rfc3339(record.expires_at),
+        )
 
     # fetch by type
     async def GetCloudSecrets(self, request, context):
         target_pve = request.target_pve
         secret_type = request.secret_type
         cloud_domain = request.cloud_domain
+        namespace = request.namespace
 
         online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
         engine = await get_engine(online_pve_host)
@@ -184,6 +289,8 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         with Session(engine) as session:
             stmt = select(ProxmoxCloudSecrets).where(
                 ProxmoxCloudSecrets.cloud_domain == cloud_domain,
+                secret_namespace(namespace),
+                secret_not_expired(),
                 ProxmoxCloudSecrets.secret_type == secret_type,
             )
             records = session.scalars(stmt).all()
@@ -194,6 +301,49 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
             )
         )
 
+    # metadata only, the payloads never leave the database
+    async def GetCloudSecretNames(self, request, context):
+        target_pve = request.target_pve
+        secret_type = request.secret_type
+        cloud_domain = request.cloud_domain
+        namespace = request.namespace
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        engine = await get_engine(online_pve_host)
+
+        with Session(engine) as session:
+            stmt = (
+                select(
+                    ProxmoxCloudSecrets.secret_name,
+                    ProxmoxCloudSecrets.secret_type,
+                    ProxmoxCloudSecrets.created_at,
+                    ProxmoxCloudSecrets.updated_at,
+                    ProxmoxCloudSecrets.expires_at,
+                )
+                .where(
+                    ProxmoxCloudSecrets.cloud_domain == cloud_domain,
+                    secret_namespace(namespace),
+                    secret_not_expired(),
+                )
+                .order_by(ProxmoxCloudSecrets.secret_name)
+            )
+            if secret_type:  # empty lists all types
+                stmt = stmt.where(ProxmoxCloudSecrets.secret_type == secret_type)
+            rows = session.execute(stmt).all()
+
+        return cloud_pb2.GetCloudSecretNamesResponse(
+            secrets=[
+                cloud_pb2.CloudSecretMeta(
+                    secret_name=row.secret_name,
+                    secret_type=row.secret_type or "",
+                    created_at=rfc3339(row.created_at),
+                    updated_at=rfc3339(row.updated_at),
+                    expires_at=rfc3339(row.expires_at),
+                )
+                for row in rows
+            ]
+        )
+
     async def GetVmVarsBlake(self, request, context):
         blake_ids = request.blake_ids
         target_pve = request.target_pve
@@ -215,6 +365,78 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
             }
         )
 
+    async def StreamVmVarsBlake(self, request, context):
+        target_pve = request.target_pve
+        cloud_domain = request.cloud_domain
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        engine = await get_engine(online_pve_host)
+
+        with Session(engine) as session:
+            stmt = select(VirtualMachineVars).where(
+                VirtualMachineVars.blake_id.in_(request.blake_ids),
+                VirtualMachineVars.cloud_domain == cloud_domain,
+            )
+            # fetch in chunks instead of loading all records at once
+            for entry in session.scalars(stmt).yield_per(100):
+                yield cloud_pb2.VmVarsBlakeEntry(
+                    blake_id=entry.blake_id, vars=json.dumps(entry.vm_vars)
+                )
+
+    async def SetVmVarsBlake(self, request, context):
+        target_pve = request.target_pve
+        cloud_domain = request.cloud_domain
+        blake_id = request.blake_id
+        vm_vars = json.loads(request.vars)
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        engine = await get_engine(online_pve_host)
+
+        with Session(engine) as session:
+            stmt = select(VirtualMachineVars).where(
+                VirtualMachineVars.blake_id == blake_id,
+                VirtualMachineVars.cloud_domain == cloud_domain,
+            )
+            record = session.scalars(stmt).one_or_none()
+
+            try:
+                if record is None:
+                    session.add(
+                        VirtualMachineVars(
+                            blake_id=blake_id,
+                            cloud_domain=cloud_domain,
+                            vm_vars=vm_vars,
+                        )
+                    )
+                else:
+                    record.vm_vars = vm_vars  # replaces all vars, no merge
+                session.commit()
+
+            except IntegrityError as e:
+                session.rollback()
+                return cloud_pb2.SetVmVarsBlakeResponse(
+                    success=False, err_message=str(e)
+                )
+
+        return cloud_pb2.SetVmVarsBlakeResponse(success=True)
+
+    async def DeleteVmVarsBlake(self, request, context):
+        target_pve = request.target_pve
+        cloud_domain = request.cloud_domain
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        engine = await get_engine(online_pve_host)
+
+        with Session(engine) as session:
+            stmt = delete(VirtualMachineVars).where(
+                VirtualMachineVars.blake_id == request.blake_id,
+                VirtualMachineVars.cloud_domain == cloud_domain,
+            )
+            session.execute(stmt)
+            session.commit()
+
+        return cloud_pb2.DeleteVmVarsBlakeResponse(success=True)
+
     async def GetCephAccess(self, request, context):
         target_pve = request.target_pve
 
@@ -260,7 +482,7 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         async with asyncssh.connect(
             online_pve_host, username="root", known_hosts=None
         ) as conn:
-            args_string = None
+            args_string = ""
             if request.get_args:
                 args_string = " ".join(
                     f"{k} '{v}'" for k, v in request.get_args.items()
@@ -281,24 +503,43 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         async with asyncssh.connect(
             online_pve_host, username="root", known_hosts=None
         ) as conn:
-            args_string = None
-            if request.create_args:
-                args_string = " ".join(
-                    f"{k} '{v}'" for k, v in request.create_args.items()
-                )
+            args_string = pvesh_args(request.create_args)
             try:
                 print(f"pvesh create {request.api_path} {args_string}")
                 cmd = await conn.run(
                     f"pvesh create {request.api_path} {args_string}",
                     check=True,
                 )
-                print(cmd.stdout)
             except asyncssh.ProcessError as e:
                 return cloud_pb2.CreateProxmoxApiResponse(
                     success=False, err_message=f"Exit code {e.exit_status} - {e.stderr}"
                 )
 
-        return cloud_pb2.CreateProxmoxApiResponse(success=True)
+        # stdout isn't logged, it can contain secrets like api token values
+        return cloud_pb2.CreateProxmoxApiResponse(
+            success=True, output=cmd.stdout.strip()
+        )
+
+    async def SetProxmoxApi(self, request, context):
+        target_pve = request.target_pve
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            args_string = pvesh_args(request.set_args)
+            try:
+                cmd = await conn.run(
+                    f"pvesh set {request.api_path} {args_string}",
+                    check=True,
+                )
+            except asyncssh.ProcessError as e:
+                return cloud_pb2.SetProxmoxApiResponse(
+                    success=False, err_message=f"Exit code {e.exit_status} - {e.stderr}"
+                )
+
+        # stdout isn't logged, it can contain secrets
+        return cloud_pb2.SetProxmoxApiResponse(success=True, output=cmd.stdout.strip())
 
     async def DeleteProxmoxApi(self, request, context):
         target_pve = request.target_pve
@@ -307,18 +548,191 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         async with asyncssh.connect(
             online_pve_host, username="root", known_hosts=None
         ) as conn:
+            args_string = pvesh_args(request.delete_args)
             try:
                 cmd = await conn.run(
-                    f"pvesh delete {request.api_path}",
+                    f"pvesh delete {request.api_path} {args_string}",
                     check=True,
                 )
-                print(cmd.stdout)
             except asyncssh.ProcessError as e:
                 return cloud_pb2.DeleteProxmoxApiResponse(
                     success=False, err_message=f"Exit code {e.exit_status} - {e.stderr}"
                 )
 
-        return cloud_pb2.DeleteProxmoxApiResponse(success=True)
+        # deletes of guests return the upid of the destroy task
+        return cloud_pb2.DeleteProxmoxApiResponse(
+            success=True, output=cmd.stdout.strip()
+        )
+
+    # polls the task over a single connection, the provider only sees the final state
+    async def WaitForTask(self, request, context):
+        target_pve = request.target_pve
+        upid = request.upid
+
+        if not PVE_UPID_RE.match(upid):
+            await context.abort(
+                grpc.StatusCode.INVALID_ARGUMENT, f"{upid} is not a task UPID"
+            )
+        task_path = f"/nodes/{upid.split(':')[1]}/tasks/{shlex.quote(upid)}"
+        deadline = asyncio.get_running_loop().time() + request.timeout_seconds
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            while True:
+                cmd = await conn.run(
+                    f"pvesh get {task_path}/status --output-format json", check=True
+                )
+                task_status = json.loads(cmd.stdout)
+
+                if (
+                    task_status.get("status") == "stopped"
+                    or asyncio.get_running_loop().time() >= deadline
+                ):
+                    break
+
+                await asyncio.sleep(2)
+
+            log_tail = ""
+            if request.log_lines > 0:
+                # the api only returns the first 50 lines by default
+                cmd = await conn.run(
+                    f"pvesh get {task_path}/log --limit 1000000 --output-format json",
+                    check=True,
+                )
+                lines = json.loads(cmd.stdout)[-request.log_lines :]
+                log_tail = "\n".join(line.get("t", "") for line in lines)
+
+        return cloud_pb2.WaitForTaskResponse(
+            finished=task_status.get("status") == "stopped",
+            exit_status=task_status.get("exitstatus", ""),
+            log_tail=log_tail,
+        )
+
+    async def CephCommand(self, request, context):
+        target_pve = request.target_pve
+
+        online_pve_host = get_online_pve_host(target_pve, skip_py_cloud_check=True)
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            tool = cloud_pb2.CephCommandRequest.Tool.Name(request.tool).lower()
+
+            # stdout isn't logged, it can contain keyrings
+            cmd = await conn.run(
+                shlex.join([tool, *request.args, "--format", "json"]),
+                check=True,
+            )
+
+        return cloud_pb2.CephCommandResponse(json_resp=cmd.stdout)
+
+    async def WriteStorageFile(self, request, context):
+        online_pve_host = get_online_pve_host(
+            request.target_pve, skip_py_cloud_check=True
+        )
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            mode = "755" if request.executable else "644"
+
+            # written to a temporary file first so readers never see partial content
+            await conn.run(
+                storage_file_command(
+                    request.node,
+                    request.volume_id,
+                    'mkdir -p "$(dirname "$p")" && cat > "$p.tmp"'
+                    f' && chmod {mode} "$p.tmp" && mv "$p.tmp" "$p"',
+                ),
+                input=request.content,
+                encoding=None,
+                check=True,
+            )
+
+        return cloud_pb2.WriteStorageFileResponse()
+
+    async def HashStorageFile(self, request, context):
+        online_pve_host = get_online_pve_host(
+            request.target_pve, skip_py_cloud_check=True
+        )
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            cmd = await conn.run(
+                storage_file_command(
+                    request.node, request.volume_id, 'sha256sum "$p"'
+                ),
+                check=True,
+            )
+
+        return cloud_pb2.HashStorageFileResponse(sha256=cmd.stdout.split(" ")[0])
+
+    async def DeleteStorageFile(self, request, context):
+        online_pve_host = get_online_pve_host(
+            request.target_pve, skip_py_cloud_check=True
+        )
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            await conn.run(
+                storage_file_command(request.node, request.volume_id, 'rm -f "$p"'),
+                check=True,
+            )
+
+        return cloud_pb2.DeleteStorageFileResponse()
+
+    async def GetNodeProxyConfig(self, request, context):
+        online_pve_host = get_online_pve_host(
+            request.target_pve, skip_py_cloud_check=True
+        )
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            # the file only exists once options were set
+            cmd = await conn.run(
+                node_command(
+                    request.node, "cat /etc/default/pveproxy 2>/dev/null || true"
+                ),
+                check=True,
+            )
+
+        return cloud_pb2.GetNodeProxyConfigResponse(
+            config=parse_proxy_config(cmd.stdout)
+        )
+
+    # replaces the whole file, pveproxy has to be restarted to pick up the changes
+    async def SetNodeProxyConfig(self, request, context):
+        for key, value in request.config.items():
+            if not PROXY_CONFIG_KEY_RE.match(key) or any(c in value for c in '"\n'):
+                return cloud_pb2.SetNodeProxyConfigResponse(
+                    success=False, err_message=f"invalid pveproxy option {key}"
+                )
+
+        online_pve_host = get_online_pve_host(
+            request.target_pve, skip_py_cloud_check=True
+        )
+        async with asyncssh.connect(
+            online_pve_host, username="root", known_hosts=None
+        ) as conn:
+            content = "".join(
+                f'{key}="{value}"\n' for key, value in sorted(request.config.items())
+            )
+            try:
+                await conn.run(
+                    node_command(
+                        request.node,
+                        "cat > /etc/default/pveproxy.tmp"
+                        " && mv /etc/default/pveproxy.tmp /etc/default/pveproxy",
+                    ),
+                    input=content,
+                    check=True,
+                )
+            except asyncssh.ProcessError as e:
+                return cloud_pb2.SetNodeProxyConfigResponse(
+                    success=False, err_message=f"Exit code {e.exit_status} - {e.stderr}"
+                )
+
+        return cloud_pb2.SetNodeProxyConfigResponse(success=True)
 
     async def GetProxmoxHost(self, request, context):
         target_pve = request.target_pve
@@ -343,6 +757,110 @@ class CloudServiceServicer(cloud_pb2_grpc.CloudServiceServicer):
         )
 
 
+PVE_UPID_RE = re.compile(
+    r"^UPID:[^:]+:[0-9A-F]+:[0-9A-F]+:[0-9A-F]+:[^:]+:[^:]*:[^:]+:$"
+)
+
+
+# array parameters of the pve api, the provider joins their values with newlines. Other
+# values are passed as is, certificates, keys and comments can span lines.
+PVESH_ARRAY_ARGS = {"--dhcp-range", "--map"}
+
+
+def pvesh_args(args):
+    """Joins the args of a pvesh call, array parameters are passed once per value."""
+    return " ".join(
+        f"{k} {shlex.quote(value)}"
+        for k, v in args.items()
+        for value in (v.split("\n") if k in PVESH_ARRAY_ARGS else [v])
+    )
+
+
+def rfc3339(ts):
+    """Formats a database timestamp for the provider, naive timestamps are utc."""
+    if ts is None:
+        return ""
+    if ts.tzinfo is None:
+        ts = ts.replace(tzinfo=timezone.utc)
+    return ts.isoformat()
+
+
+def parse_rfc3339(value):
+    """Parses an optional timestamp sent by the provider, empty is None."""
+    if not value:
+        return None
+    # fromisoformat only accepts the Z suffix from python 3.11 on
+    return datetime.fromisoformat(value.replace("Z", "+00:00"))
+
+
+def secret_namespace(namespace):
+    """Filters cloud secrets on their namespace, rows written before namespaces
+    existed are NULL and belong to the default empty namespace."""
+    if not namespace:
+        return or_(
+            ProxmoxCloudSecrets.namespace.is_(None), ProxmoxCloudSecrets.namespace == ""
+        )
+    return ProxmoxCloudSecrets.namespace == namespace
+
+
+def secret_not_expired():
+    """Filters out expired cloud secrets, reads never delete them."""
+    return or_(
+        ProxmoxCloudSecrets.expires_at.is_(None),
+        ProxmoxCloudSecrets.expires_at > func.now(),
+    )
+
+
+def purge_expired_secrets(session, cloud_domain):
+    """Deletes the expired cloud secrets of the cloud domain, called by the write rpcs
+    before their own statement and committed with it."""
+    session.execute(
+        delete(ProxmoxCloudSecrets).where(
+            ProxmoxCloudSecrets.cloud_domain == cloud_domain,
+            ProxmoxCloudSecrets.expires_at <= func.now(),
+        )
+    )
+
+
+def node_command(node, command):
+    """Wraps command to run on node.
+
+    The node is reached via ssh from the connected host, pve nodes trust each
+    other's root keys."""
+    return shlex.join(["ssh", "-o", "BatchMode=yes", f"root@{node}", command])
+
+
+def storage_file_command(node, volume_id, command):
+    """Resolves the path of volume_id with pvesm on node, command gets it as $p."""
+    return node_command(
+        node, f"p=$(pvesm path {shlex.quote(volume_id)}) && {command}"
+    )
+
+
+PROXY_CONFIG_KEY_RE = re.compile(r"^[A-Z][A-Z0-9_]*$")
+
+
+def parse_proxy_config(content):
+    """Parses the KEY="value" lines of /etc/default/pveproxy, comments are skipped."""
+    config = {}
+    for line in content.splitlines():
+        line = line.strip()
+        if not line or line.startswith("#"):
+            continue
+
+        key, sep, value = line.partition("=")
+        key = key.strip()
+        if not sep or not PROXY_CONFIG_KEY_RE.match(key):
+            continue
+
+        value = value.strip()
+        if len(value) >= 2 and value[0] == value[-1] and value[0] in "\"'":
+            value = value[1:-1]
+        config[key] = value
+
+    return config
+
+
 def is_port_bound(port, host="0.0.0.0"):
     with socket.socket(socket.AF_INET, socket.SOCK_STREAM) as s:
         s.setsockopt(socket.SOL_SOCKET, socket.SO_REUSEADDR, 1)
@@ -353,19 +871,142 @@ def is_port_bound(port, host="0.0.0.0"):
             return True  # bound
 
 
+class TokenAuthInterceptor(grpc.aio.ServerInterceptor):
+    """Rejects calls that don't carry the session token of the provider."""
+
+    def __init__(self, token):
+        self._expected = f"Bearer {token}"
+
+        async def deny(request, context):
+            await context.abort(grpc.StatusCode.UNAUTHENTICATED, "invalid rpc token")
+
+        self._deny = grpc.unary_unary_rpc_method_handler(deny)
+
+    async def intercept_service(self, continuation, handler_call_details):
+        metadata = dict(handler_call_details.invocation_metadata or ())
+        if hmac.compare_digest(metadata.get("authorization", ""), self._expected):
+            return await continuation(handler_call_details)
+
+        return self._deny
+
+
+# grpc status sent along with each error code
+ERROR_CODE_STATUS = {
+    cloud_pb2.NOT_FOUND: grpc.StatusCode.NOT_FOUND,
+    cloud_pb2.CONFLICT: grpc.StatusCode.ALREADY_EXISTS,
+    cloud_pb2.UNREACHABLE: grpc.StatusCode.UNAVAILABLE,
+    cloud_pb2.AUTH: grpc.StatusCode.PERMISSION_DENIED,
+}
+
+
+def classify_error(e):
+    """Maps exceptions of the handlers to an ErrorCode, None if unknown."""
+    # PermissionDenied is a DisconnectError, check it first
+    if isinstance(e, asyncssh.PermissionDenied):
+        return cloud_pb2.AUTH
+    if isinstance(e, (OSError, asyncssh.DisconnectError, asyncio.TimeoutError)):
+        return cloud_pb2.UNREACHABLE
+    if isinstance(e, OperationalError):
+        return cloud_pb2.UNREACHABLE
+    if isinstance(e, IntegrityError):
+        return cloud_pb2.CONFLICT
+    if isinstance(e, asyncssh.ProcessError):
+        stderr = str(e.stderr or "")
+        if "already exists" in stderr:
+            return cloud_pb2.CONFLICT
+        if any(
+            msg in stderr
+            for msg in ("does not exist", "not found", "No such file", "ENOENT")
+        ):
+            return cloud_pb2.NOT_FOUND
+
+    return None
+
+
+class ErrorCodeInterceptor(grpc.aio.ServerInterceptor):
+    """Turns known handler exceptions into grpc errors carrying an ErrorCode."""
+
+    async def intercept_service(self, continuation, handler_call_details):
+        handler = await continuation(handler_call_details)
+        if handler is None or handler.unary_unary is None:
+            return handler
+
+        behavior = handler.unary_unary
+
+        async def classified(request, context):
+            try:
+                return await behavior(request, context)
+            except grpc.aio.AbortError:
+                raise
+            except Exception as e:
+                code = classify_error(e)
+                if code is None:
+                    raise
+
+                await context.abort(
+                    ERROR_CODE_STATUS[code],
+                    f"{type(e).__name__}: {getattr(e, 'stderr', None) or e}",
+                    trailing_metadata=(
+                        ("pxc-error-code", cloud_pb2.ErrorCode.Name(code)),
+                    ),
+                )
+
+        return grpc.unary_unary_rpc_method_handler(
+            classified,
+            request_deserializer=handler.request_deserializer,
+            response_serializer=handler.response_serializer,
+        )
+
+
+async def watch_provider(server, pid):
+    """Stops the server once the provider process is gone, a crashed provider can't kill us."""
+    while True:
+        await asyncio.sleep(5)
+        try:
+            os.kill(pid, 0)
+        except ProcessLookupError:
+            print(f"provider process {pid} is gone, shutting down.")
+            await server.stop(grace=0)
+            return
+        except PermissionError:
+            pass  # pid exists but belongs to another user
+
+
 async def serve():
-    server = grpc.aio.server()
+    # the provider passes a fresh token per session via env, standalone daemons
+    # without a token accept all calls
+    token = os.environ.get("PXC_RPC_TOKEN")
+    interceptors = [TokenAuthInterceptor(token)] if token else []
+    interceptors.append(ErrorCodeInterceptor())
+
+    server = grpc.aio.server(interceptors=interceptors)
     cloud_pb2_grpc.add_CloudServiceServicer_to_server(CloudServiceServicer(), server)
 
     health_servicer = HealthServicer()
     health_pb2_grpc.add_HealthServicer_to_server(health_servicer, server)
 
-    socket_file = f"/tmp/pc-rpc-{sys.argv[1]}.sock"
+    # the provider passes the address to listen on, defaults to the pid socket in /tmp.
+    # standalone daemons can listen on tcp, e.g. pcrpc 0 0.0.0.0:50052
+    address = (
+        sys.argv[2] if len(sys.argv) > 2 else f"unix:///tmp/pc-rpc-{sys.argv[1]}.sock"
+    )
+    socket_file = address[len("unix://") :] if address.startswith("unix://") else None
 
-    server.add_insecure_port(f"unix://{socket_file}")
+    server.add_insecure_port(address)
     await server.start()
 
-    print(f"gRPC AsyncIO server running on {socket_file}")
+    # only the user running the provider may connect
+    if socket_file:
+        os.chmod(socket_file, 0o600)
+
+    print(f"gRPC AsyncIO server running on {address}")
+
+    # standalone daemons are started with pid 0
+    provider_pid = int(sys.argv[1])
+    if provider_pid > 0:
+        # keep a reference, the loop only holds weak ones to tasks
+        watcher = asyncio.create_task(watch_provider(server, provider_pid))
+
     try:
         await server.wait_for_termination()
     finally:
@@ -374,7 +1015,7 @@ async def serve():
         print("gRPC server stopped and port released.")
 
         # delete unix socket file
-        if os.path.exists(socket_file):
+        if socket_file and os.path.exists(socket_file):
             os.remove(socket_file)
 
 